@@ -15,6 +15,7 @@ import (
 	"golang.org/x/net/http2/h2c"
 
 	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/domain/ports"
 	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/handlers"
 	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/infrastructure"
 	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/infrastructure/observability"
@@ -50,12 +51,27 @@ func main() {
 
 	marketDataService := services.NewMarketDataService(cfg, logger)
 
+	// One ticker advances the shared market whether or not anyone is streaming
+	engineCtx, stopEngine := context.WithCancel(ctx)
+	defer stopEngine()
+	go marketDataService.Run(engineCtx)
+
+	// Initialize observability (Clean Architecture: port + adapter)
+	constantLabels := map[string]string{
+		"service":  cfg.ServiceName,
+		"instance": cfg.ServiceInstanceName,
+		"version":  cfg.ServiceVersion,
+	}
+	metricsPort := observability.NewPrometheusMetricsAdapter(constantLabels)
+
+	// One handler serves gRPC and Connect, so both share its stream sessions
+	marketDataHandler := handlers.NewMarketDataGRPCHandlerWithMetrics(cfg, marketDataService, metricsPort, logger)
+
 	// Create enhanced gRPC server with market data service
 	grpcServer := infrastructure.NewMarketDataGRPCServer(cfg, marketDataService, logger)
-	marketDataHandler := handlers.NewMarketDataGRPCHandler(cfg, marketDataService, logger)
 	proto.RegisterMarketDataServiceServer(grpcServer.GetGRPCServer(), marketDataHandler)
 
-	httpServer := setupHTTPServer(cfg, marketDataService, logger)
+	httpServer := setupHTTPServer(cfg, marketDataHandler, metricsPort, logger)
 
 	go func() {
 		logger.WithField("port", cfg.GRPCPort).Info("Starting enhanced gRPC server")
@@ -94,8 +110,7 @@ func main() {
 	logger.Info("Servers shutdown complete")
 }
 
-
-func setupHTTPServer(cfg *config.Config, marketDataService *services.MarketDataService, logger *logrus.Logger) *http.Server {
+func setupHTTPServer(cfg *config.Config, marketDataHandler *handlers.MarketDataGRPCHandler, metricsPort ports.MetricsPort, logger *logrus.Logger) *http.Server {
 	router := gin.New()
 	router.Use(gin.Recovery())
 
@@ -114,21 +129,12 @@ func setupHTTPServer(cfg *config.Config, marketDataService *services.MarketDataS
 		c.Next()
 	})
 
-	// Initialize observability (Clean Architecture: port + adapter)
-	constantLabels := map[string]string{
-		"service":  cfg.ServiceName,
-		"instance": cfg.ServiceInstanceName,
-		"version":  cfg.ServiceVersion,
-	}
-	metricsPort := observability.NewPrometheusMetricsAdapter(constantLabels)
-
 	// Add RED metrics middleware (Rate, Errors, Duration)
 	router.Use(observability.REDMetricsMiddleware(metricsPort))
 
 	// Initialize handlers
 	healthHandler := handlers.NewHealthHandlerWithConfig(cfg, logger)
 	metricsHandler := handlers.NewMetricsHandler(metricsPort)
-	marketDataHandler := handlers.NewMarketDataGRPCHandler(cfg, marketDataService, logger)
 
 	// Register Connect protocol handlers
 	registerConnectHandlers(router, marketDataHandler, logger)
@@ -158,8 +164,7 @@ func registerConnectHandlers(router *gin.Engine, marketDataHandler *handlers.Mar
 	path, handler := protoconnect.NewMarketDataServiceHandler(connectAdapter)
 
 	// Register with Gin router (handle all methods under the path)
-	router.Any(path+"*method", gin.WrapH(handler))
+	router.Any(path+"*method", gin.WrapH(connectpresentation.WithBatchedFlush(handler)))
 
 	logger.WithField("path", path).Info("Registered Connect protocol handlers for MarketDataService")
 }
-
@@ -6,7 +6,9 @@ import (
 	"os"
 	"regexp"
 	"strconv"
+	"strings"
 	"time"
+	_ "time/tzdata" // Trading schedules name exchange time zones; don't depend on the host's zoneinfo
 
 	"github.com/joho/godotenv"
 	"github.com/quantfidential/trading-ecosystem/market-data-adapter-go/pkg/adapters"
@@ -33,28 +35,299 @@ type Config struct {
 	CacheTTL                time.Duration
 	HealthCheckInterval     time.Duration
 
+	// Streaming
+	ReplayBufferSize      int           // Updates retained per stream for gap recovery
+	StreamRetentionWindow time.Duration // How long a dropped stream can be resumed
+	StreamQueueSize       int           // Outbound updates buffered per stream before overflow
+	StreamOverflowPolicy  string        // drop_oldest, conflate or disconnect
+
+	// High-Frequency Streaming
+	HighFrequencyEnabled     bool          // Allow intervals below the 100ms floor
+	HighFrequencyMinInterval time.Duration // Fastest tick interval in high-frequency mode
+	HighFrequencyClients     []string      // x-client values allowed to use it, "*" for any; self-declared, so a toggle rather than access control
+
+	// Market Impact (square-root model, see services.ImpactModel)
+	ImpactVolatility           float64       // Daily volatility used to scale impact
+	ImpactDailyVolume          float64       // Reference daily volume per symbol
+	ImpactTemporaryCoefficient float64       // Temporary impact coefficient
+	ImpactPermanentCoefficient float64       // Permanent impact coefficient
+	ImpactDecayHalfLife        time.Duration // Half-life of temporary impact
+
+	// Circuit Breakers
+	PriceBands []PriceBandRule // First matching rule applies to a symbol
+
+	// Trading Sessions (symbols without a schedule trade 24/7)
+	TradingSchedules []TradingSchedule
+
+	// Venues (every symbol is quoted on each venue around a common fair value)
+	Venues                 []Venue
+	VenueMaxDislocationBps float64 // Arbitrage bound on the gap between any two venue mids
+	MarkOutlierBps         float64 // Venue mids further than this from the median are left out of the mark price
+
+	// Instrument Reference Data (symbols in Symbols without an entry get inferred defaults)
+	Instruments []Instrument
+
+	// Symbol Universe
+	Symbols          []string            // Symbols known at startup
+	SymbolGroups     map[string][]string // Named groups, members may be patterns
+	CompositeSymbols []CompositeSymbol   // Synthetic symbols priced from constituents
+
+	// Perpetual Swaps (price = spot index plus a mean-reverting basis)
+	Perpetuals             []Perpetual
+	PerpetualBasisBps      float64       // Standard deviation of the basis
+	PerpetualBasisHalfLife time.Duration // How fast the basis reverts to zero
+	PerpetualInterestRate  float64       // Interest component of funding, per funding interval
+	PerpetualFundingCap    float64       // Largest funding rate, either way, per funding interval
+
+	// Dated Futures (price = underlying carried along a basis curve to expiry)
+	Futures             []FutureSeries
+	FutureBasisNoiseBps float64 // Noise around the curve, fading out towards expiry
+
+	// Listed Options (priced off the underlying with Black-Scholes)
+	Options            []OptionSeries
+	VolSurface         VolSurface
+	OptionInterestRate float64 // Annual, continuously compounded
+	OptionVolSpread    float64 // Bid/ask width in volatility, e.g. 0.01 is one vol point
+
+	// FX Crosses (priced from pairs against a pivot currency, so triangles close)
+	FXPivot               string
+	FXFactors             []string      // Pairs against the pivot that move independently, e.g. EUR-USD, USD-JPY
+	FXCrosses             []string      // Pairs priced from the factors, e.g. EUR-JPY
+	FXDislocationBps      float64       // Standard deviation of a cross's gap to its triangulated rate; 0 keeps triangles exact
+	FXDislocationHalfLife time.Duration // How fast that gap closes
+
+	// Rates (a Nelson-Siegel yield curve and constant-maturity bonds priced off it)
+	YieldCurve YieldCurve
+	Bonds      []Bond
+
 	// Data Adapter
 	dataAdapter adapters.DataAdapter
 }
 
+// PriceBandRule limits how far a symbol may move within a rolling window.
+// A breach either halts trading for HaltDuration or holds the price at the band.
+type PriceBandRule struct {
+	Symbol       string // Symbol or wildcard pattern
+	MovePercent  float64
+	Window       time.Duration
+	Halt         bool
+	HaltDuration time.Duration
+}
+
+const defaultHaltDuration = 5 * time.Minute
+
+// TradingSchedule is the daily session of a non-24/7 instrument. Phase start
+// times are offsets from midnight in Location; weekends are closed.
+type TradingSchedule struct {
+	Symbol         string // Symbol or wildcard pattern
+	Location       *time.Location
+	PreOpen        time.Duration
+	OpeningAuction time.Duration
+	Continuous     time.Duration
+	ClosingAuction time.Duration
+	Close          time.Duration
+}
+
+// Venue is a simulated trading venue. Its quotes follow the fair value
+// Latency late, with NoiseBps of venue-specific noise, SpreadBps wide.
+type Venue struct {
+	Name      string
+	NoiseBps  float64
+	Latency   time.Duration
+	SpreadBps float64
+}
+
+// Instrument is the reference data of a tradable symbol. Trading hours come
+// from the matching TradingSchedule.
+type Instrument struct {
+	Symbol         string
+	AssetClass     string // crypto, fx, equity, composite, ...
+	BaseCurrency   string
+	QuoteCurrency  string
+	TickSize       float64
+	LotSize        float64
+	PricePrecision int
+}
+
+// Composite symbol kinds
+const (
+	CompositeBasket = "basket" // Sum of weight × price over the legs
+	CompositeSpread = "spread" // A − B
+	CompositeRatio  = "ratio"  // A / B
+	CompositeFX     = "fx"     // A converted at rate B, i.e. A × B
+)
+
+// CompositeSymbol is a synthetic symbol priced from its legs. Legs are
+// regular symbols, each scaled by its weight.
+type CompositeSymbol struct {
+	Symbol string
+	Kind   string
+	Legs   []CompositeLeg
+}
+
+type CompositeLeg struct {
+	Symbol string
+	Weight float64
+}
+
+// Perpetual is a perpetual swap on a regular spot symbol. Funding settles
+// every FundingInterval, on multiples of the interval from midnight UTC.
+type Perpetual struct {
+	Symbol          string
+	Index           string
+	FundingInterval time.Duration
+}
+
+const defaultFundingInterval = 8 * time.Hour
+
+// Future expiry cycles; contracts expire on the last Friday of the month at
+// 08:00 UTC
+const (
+	FutureCycleMonthly   = "monthly"
+	FutureCycleQuarterly = "quarterly" // March, June, September and December
+)
+
+// FutureSeries lists dated futures on an underlying. The Listed nearest
+// expiries of the cycle trade at any time, as "<Root>-YYYYMMDD"; when one
+// expires the next expiry is listed.
+type FutureSeries struct {
+	Root       string
+	Underlying string
+	Cycle      string
+	Listed     int
+	Curve      []CurvePoint // By tenor; empty prices every contract at the underlying
+}
+
+// OptionSeries lists calls and puts on an underlying for the Expiries
+// nearest expiries of the cycle, named "<Root>-YYYYMMDD-<strike>-C|P".
+// Strikes are spaced StrikeStepPercent of the underlying apart, rounded to
+// a 1, 2, 2.5 or 5 multiple of a power of ten, with Strikes either side of
+// the at-the-money strike.
+type OptionSeries struct {
+	Root              string
+	Underlying        string
+	Cycle             string
+	Expiries          int
+	Strikes           int
+	StrikeStepPercent float64
+}
+
+// VolSurface parameterizes implied volatility by expiry and moneyness. The
+// at-the-money level is ATM at 30 days and moves by TermSlope per doubling
+// of the time to expiry; across strikes Skew and Smile add a linear and a
+// quadratic term in log-moneyness scaled by the square root of time, so the
+// smile flattens for longer expiries.
+type VolSurface struct {
+	ATM       float64
+	TermSlope float64
+	Skew      float64
+	Smile     float64
+}
+
+// YieldCurve is a Nelson-Siegel curve of continuously compounded zero
+// rates: Level is the long end, Level + Slope the short end, and Curvature
+// adds a hump around Lambda years. Each factor mean-reverts to its
+// configured value with VolBps of noise; ShocksPerDay adds parallel jumps of
+// ShockBps, either way, that revert the same way.
+type YieldCurve struct {
+	Currency     string
+	Level        float64
+	Slope        float64
+	Curvature    float64
+	Lambda       float64 // Years
+	VolBps       float64
+	HalfLife     time.Duration
+	ShockBps     float64
+	ShocksPerDay float64 // 0 disables shocks
+}
+
+// Bond is a constant-maturity benchmark bond paying a semi-annual coupon,
+// quoted per 100 face value
+type Bond struct {
+	Symbol        string
+	MaturityYears float64
+	CouponPercent float64
+}
+
+// CurvePoint is the annualized basis of a future at a tenor, positive in
+// contango and negative in backwardation
+type CurvePoint struct {
+	Tenor        time.Duration
+	BasisPercent float64
+}
+
 func Load() *Config {
 	// Try to load .env file (ignore errors if not found)
 	_ = godotenv.Load()
 
 	cfg := &Config{
-		ServiceName:             getEnv("SERVICE_NAME", "market-data-simulator"),
-		ServiceInstanceName:     getEnv("SERVICE_INSTANCE_NAME", ""),
-		ServiceVersion:          getEnv("SERVICE_VERSION", "1.0.0"),
-		Environment:             getEnv("ENVIRONMENT", "development"),
-		HTTPPort:                getEnvAsInt("HTTP_PORT", 8080),
-		GRPCPort:                getEnvAsInt("GRPC_PORT", 50051),
-		LogLevel:                getEnv("LOG_LEVEL", "info"),
-		PostgresURL:             getEnv("POSTGRES_URL", ""),
-		RedisURL:                getEnv("REDIS_URL", "redis://localhost:6379"),
-		ConfigurationServiceURL: getEnv("CONFIG_SERVICE_URL", "http://localhost:8090"),
-		RequestTimeout:          getEnvAsDuration("REQUEST_TIMEOUT", 5*time.Second),
-		CacheTTL:                getEnvAsDuration("CACHE_TTL", 5*time.Minute),
-		HealthCheckInterval:     getEnvAsDuration("HEALTH_CHECK_INTERVAL", 30*time.Second),
+		ServiceName:                getEnv("SERVICE_NAME", "market-data-simulator"),
+		ServiceInstanceName:        getEnv("SERVICE_INSTANCE_NAME", ""),
+		ServiceVersion:             getEnv("SERVICE_VERSION", "1.0.0"),
+		Environment:                getEnv("ENVIRONMENT", "development"),
+		HTTPPort:                   getEnvAsInt("HTTP_PORT", 8080),
+		GRPCPort:                   getEnvAsInt("GRPC_PORT", 50051),
+		LogLevel:                   getEnv("LOG_LEVEL", "info"),
+		PostgresURL:                getEnv("POSTGRES_URL", ""),
+		RedisURL:                   getEnv("REDIS_URL", "redis://localhost:6379"),
+		ConfigurationServiceURL:    getEnv("CONFIG_SERVICE_URL", "http://localhost:8090"),
+		RequestTimeout:             getEnvAsDuration("REQUEST_TIMEOUT", 5*time.Second),
+		CacheTTL:                   getEnvAsDuration("CACHE_TTL", 5*time.Minute),
+		HealthCheckInterval:        getEnvAsDuration("HEALTH_CHECK_INTERVAL", 30*time.Second),
+		ReplayBufferSize:           getEnvAsInt("REPLAY_BUFFER_SIZE", 1000),
+		StreamRetentionWindow:      getEnvAsDuration("STREAM_RETENTION_WINDOW", 5*time.Minute),
+		StreamQueueSize:            getEnvAsInt("STREAM_QUEUE_SIZE", 256),
+		StreamOverflowPolicy:       getEnv("STREAM_OVERFLOW_POLICY", "drop_oldest"),
+		HighFrequencyEnabled:       getEnvAsBool("HIGH_FREQUENCY_ENABLED", false),
+		HighFrequencyMinInterval:   getEnvAsDuration("HIGH_FREQUENCY_MIN_INTERVAL", 100*time.Microsecond),
+		HighFrequencyClients:       getEnvAsSlice("HIGH_FREQUENCY_CLIENTS", nil),
+		ImpactVolatility:           getEnvAsFloat("IMPACT_VOLATILITY", 0.02),
+		ImpactDailyVolume:          getEnvAsFloat("IMPACT_DAILY_VOLUME", 1000000),
+		ImpactTemporaryCoefficient: getEnvAsFloat("IMPACT_TEMPORARY_COEFFICIENT", 0.5),
+		ImpactPermanentCoefficient: getEnvAsFloat("IMPACT_PERMANENT_COEFFICIENT", 0.1),
+		ImpactDecayHalfLife:        getEnvAsDuration("IMPACT_DECAY_HALF_LIFE", 30*time.Second),
+		PriceBands:                 getEnvAsPriceBands("PRICE_BANDS", ""),
+		TradingSchedules:           getEnvAsSchedules("TRADING_SCHEDULES", ""),
+		Venues:                     getEnvAsVenues("VENUES", "binance=1.5/20ms/2;coinbase=2/60ms/3;kraken=3/120ms/4"),
+		VenueMaxDislocationBps:     getEnvAsNonNegativeFloat("VENUE_MAX_DISLOCATION_BPS", 25),
+		MarkOutlierBps:             getEnvAsPositiveFloat("MARK_OUTLIER_BPS", 10),
+		Instruments:                getEnvAsInstruments("INSTRUMENTS", defaultInstruments),
+		Symbols:                    getEnvAsSlice("SYMBOLS", []string{"BTC-USD", "ETH-USD", "SOL-USD", "ADA-USD", "ETH-BTC", "BTC-EUR"}),
+		SymbolGroups:               getEnvAsGroups("SYMBOL_GROUPS", "majors:BTC-USD,ETH-USD;usd:*-USD"),
+		CompositeSymbols:           getEnvAsComposites("COMPOSITE_SYMBOLS", ""),
+		Perpetuals:                 getEnvAsPerpetuals("PERPETUALS", ""),
+		PerpetualBasisBps:          getEnvAsFloat("PERPETUAL_BASIS_BPS", 10),
+		PerpetualBasisHalfLife:     getEnvAsPositiveDuration("PERPETUAL_BASIS_HALF_LIFE", 10*time.Minute),
+		PerpetualInterestRate:      getEnvAsFloat("PERPETUAL_INTEREST_RATE", 0.0001),
+		PerpetualFundingCap:        getEnvAsPositiveFloat("PERPETUAL_FUNDING_CAP", 0.0075),
+		Futures:                    getEnvAsFutures("FUTURES", ""),
+		FutureBasisNoiseBps:        getEnvAsFloat("FUTURE_BASIS_NOISE_BPS", 5),
+		Options:                    getEnvAsOptions("OPTIONS", ""),
+		VolSurface: VolSurface{
+			ATM:       getEnvAsPositiveFloat("VOL_SURFACE_ATM", 0.6),
+			TermSlope: getEnvAsFloat("VOL_SURFACE_TERM_SLOPE", -0.02),
+			Skew:      getEnvAsFloat("VOL_SURFACE_SKEW", -0.1),
+			Smile:     getEnvAsFloat("VOL_SURFACE_SMILE", 0.3),
+		},
+		OptionInterestRate:    getEnvAsFloat("OPTION_INTEREST_RATE", 0.05),
+		OptionVolSpread:       getEnvAsNonNegativeFloat("OPTION_VOL_SPREAD", 0.01),
+		FXPivot:               getEnv("FX_PIVOT", "USD"),
+		FXFactors:             getEnvAsSlice("FX_FACTORS", nil),
+		FXCrosses:             getEnvAsSlice("FX_CROSSES", nil),
+		FXDislocationBps:      getEnvAsFloat("FX_DISLOCATION_BPS", 0),
+		FXDislocationHalfLife: getEnvAsPositiveDuration("FX_DISLOCATION_HALF_LIFE", 30*time.Second),
+		YieldCurve: YieldCurve{
+			Currency:     getEnv("YIELD_CURVE_CURRENCY", "USD"),
+			Level:        getEnvAsFloat("YIELD_CURVE_LEVEL", 0.045),
+			Slope:        getEnvAsFloat("YIELD_CURVE_SLOPE", -0.01),
+			Curvature:    getEnvAsFloat("YIELD_CURVE_CURVATURE", 0.01),
+			Lambda:       getEnvAsPositiveFloat("YIELD_CURVE_LAMBDA", 2),
+			VolBps:       getEnvAsFloat("YIELD_CURVE_VOL_BPS", 10),
+			HalfLife:     getEnvAsPositiveDuration("YIELD_CURVE_HALF_LIFE", 10*time.Minute),
+			ShockBps:     getEnvAsFloat("YIELD_CURVE_SHOCK_BPS", 25),
+			ShocksPerDay: getEnvAsFloat("YIELD_CURVE_SHOCKS_PER_DAY", 0),
+		},
+		Bonds: getEnvAsBonds("BONDS", ""),
 	}
 
 	// Backward compatibility: Default ServiceInstanceName to ServiceName
@@ -137,6 +410,31 @@ func getEnvAsInt(key string, defaultValue int) int {
 	return defaultValue
 }
 
+func getEnvAsFloat(key string, defaultValue float64) float64 {
+	if value := os.Getenv(key); value != "" {
+		if floatValue, err := strconv.ParseFloat(value, 64); err == nil {
+			return floatValue
+		}
+	}
+	return defaultValue
+}
+
+// getEnvAsNonNegativeFloat rejects negative values in favour of the default
+func getEnvAsNonNegativeFloat(key string, defaultValue float64) float64 {
+	if value := getEnvAsFloat(key, defaultValue); value >= 0 {
+		return value
+	}
+	return defaultValue
+}
+
+// getEnvAsPositiveFloat rejects zero and negative values in favour of the default
+func getEnvAsPositiveFloat(key string, defaultValue float64) float64 {
+	if value := getEnvAsFloat(key, defaultValue); value > 0 {
+		return value
+	}
+	return defaultValue
+}
+
 func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
 	if value := os.Getenv(key); value != "" {
 		if duration, err := time.ParseDuration(value); err == nil {
@@ -144,4 +442,433 @@ func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
 		}
 	}
 	return defaultValue
-}
\ No newline at end of file
+}
+
+// getEnvAsPositiveDuration rejects zero and negative durations in favour of
+// the default
+func getEnvAsPositiveDuration(key string, defaultValue time.Duration) time.Duration {
+	if value := getEnvAsDuration(key, defaultValue); value > 0 {
+		return value
+	}
+	return defaultValue
+}
+
+func getEnvAsBool(key string, defaultValue bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if boolValue, err := strconv.ParseBool(value); err == nil {
+			return boolValue
+		}
+	}
+	return defaultValue
+}
+
+func getEnvAsSlice(key string, defaultValue []string) []string {
+	if value := os.Getenv(key); value != "" {
+		return splitList(value, ",")
+	}
+	return defaultValue
+}
+
+// getEnvAsPriceBands parses "BTC-USD=10/5m/halt:5m;*-USD=7/1m/clamp", i.e.
+// symbol=percent/window/action, into price band rules. Malformed rules are skipped.
+func getEnvAsPriceBands(key, defaultValue string) []PriceBandRule {
+	var rules []PriceBandRule
+	for _, entry := range splitList(getEnv(key, defaultValue), ";") {
+		symbol, spec, found := strings.Cut(entry, "=")
+		fields := strings.Split(spec, "/")
+		if !found || strings.TrimSpace(symbol) == "" || len(fields) != 3 {
+			continue
+		}
+
+		percent, err := strconv.ParseFloat(strings.TrimSpace(fields[0]), 64)
+		if err != nil || percent <= 0 {
+			continue
+		}
+		window, err := time.ParseDuration(strings.TrimSpace(fields[1]))
+		if err != nil || window <= 0 {
+			continue
+		}
+
+		rule := PriceBandRule{Symbol: strings.TrimSpace(symbol), MovePercent: percent, Window: window}
+		action, duration, _ := strings.Cut(strings.TrimSpace(fields[2]), ":")
+		switch action {
+		case "clamp":
+		case "halt":
+			rule.Halt = true
+			rule.HaltDuration = defaultHaltDuration
+			if duration != "" {
+				if rule.HaltDuration, err = time.ParseDuration(duration); err != nil || rule.HaltDuration <= 0 {
+					continue
+				}
+			}
+		default:
+			continue
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+// getEnvAsSchedules parses "BTC-EUR=Europe/Berlin,07:30,07:50,08:00,16:30,16:35",
+// i.e. symbol=zone,pre-open,opening auction,continuous,closing auction,close.
+// Malformed schedules and schedules whose times are out of order are skipped.
+func getEnvAsSchedules(key, defaultValue string) []TradingSchedule {
+	var schedules []TradingSchedule
+	for _, entry := range splitList(getEnv(key, defaultValue), ";") {
+		symbol, spec, found := strings.Cut(entry, "=")
+		fields := splitList(spec, ",")
+		if !found || strings.TrimSpace(symbol) == "" || len(fields) != 6 {
+			continue
+		}
+
+		location, err := time.LoadLocation(fields[0])
+		if err != nil {
+			continue
+		}
+
+		var times [5]time.Duration
+		valid := true
+		for i, field := range fields[1:] {
+			clock, err := time.Parse("15:04", field)
+			if err != nil {
+				valid = false
+				break
+			}
+			times[i] = time.Duration(clock.Hour())*time.Hour + time.Duration(clock.Minute())*time.Minute
+			if i > 0 && times[i] <= times[i-1] {
+				valid = false
+				break
+			}
+		}
+		if !valid {
+			continue
+		}
+
+		schedules = append(schedules, TradingSchedule{
+			Symbol:         strings.TrimSpace(symbol),
+			Location:       location,
+			PreOpen:        times[0],
+			OpeningAuction: times[1],
+			Continuous:     times[2],
+			ClosingAuction: times[3],
+			Close:          times[4],
+		})
+	}
+	return schedules
+}
+
+// getEnvAsGroups parses "name:a,b;other:c" into named symbol groups
+func getEnvAsGroups(key, defaultValue string) map[string][]string {
+	groups := make(map[string][]string)
+	for _, entry := range splitList(getEnv(key, defaultValue), ";") {
+		name, members, found := strings.Cut(entry, ":")
+		if !found {
+			continue
+		}
+		groups[strings.TrimSpace(name)] = splitList(members, ",")
+	}
+	return groups
+}
+
+// defaultInstruments covers the default SYMBOLS
+const defaultInstruments = "BTC-USD=crypto,BTC,USD,0.01,0.00001,2;ETH-USD=crypto,ETH,USD,0.01,0.0001,2;" +
+	"SOL-USD=crypto,SOL,USD,0.001,0.01,3;ADA-USD=crypto,ADA,USD,0.0001,1,4;" +
+	"ETH-BTC=crypto,ETH,BTC,0.00001,0.001,5;BTC-EUR=crypto,BTC,EUR,0.01,0.00001,2"
+
+// getEnvAsInstruments parses "EUR-USD=fx,EUR,USD,0.00001,1000,5", i.e.
+// symbol=asset class,base,quote,tick size,lot size,price precision.
+// Malformed and duplicate instruments are skipped.
+func getEnvAsInstruments(key, defaultValue string) []Instrument {
+	var instruments []Instrument
+	seen := make(map[string]bool)
+	for _, entry := range splitList(getEnv(key, defaultValue), ";") {
+		symbol, spec, found := strings.Cut(entry, "=")
+		symbol = strings.TrimSpace(symbol)
+		fields := splitList(spec, ",")
+		if !found || symbol == "" || seen[symbol] || len(fields) != 6 {
+			continue
+		}
+
+		tickSize, err := strconv.ParseFloat(fields[3], 64)
+		if err != nil || tickSize <= 0 {
+			continue
+		}
+		lotSize, err := strconv.ParseFloat(fields[4], 64)
+		if err != nil || lotSize <= 0 {
+			continue
+		}
+		precision, err := strconv.Atoi(fields[5])
+		if err != nil || precision < 0 {
+			continue
+		}
+
+		seen[symbol] = true
+		instruments = append(instruments, Instrument{
+			Symbol:         symbol,
+			AssetClass:     fields[0],
+			BaseCurrency:   fields[1],
+			QuoteCurrency:  fields[2],
+			TickSize:       tickSize,
+			LotSize:        lotSize,
+			PricePrecision: precision,
+		})
+	}
+	return instruments
+}
+
+// getEnvAsComposites parses "IDX=basket:BTC-USD*0.6,ETH-USD*0.4;BTC-ETH=spread:BTC-USD,ETH-USD*15",
+// i.e. symbol=kind:leg*weight,... with weights defaulting to 1. Spreads, ratios
+// and fx conversions take exactly two legs. Malformed composites and
+// composites built on other composites are skipped.
+func getEnvAsComposites(key, defaultValue string) []CompositeSymbol {
+	var composites []CompositeSymbol
+	for _, entry := range splitList(getEnv(key, defaultValue), ";") {
+		symbol, spec, found := strings.Cut(entry, "=")
+		kind, legSpec, hasLegs := strings.Cut(spec, ":")
+		symbol, kind = strings.TrimSpace(symbol), strings.TrimSpace(kind)
+		if !found || !hasLegs || symbol == "" {
+			continue
+		}
+
+		composite := CompositeSymbol{Symbol: symbol, Kind: kind}
+		valid := true
+		for _, field := range splitList(legSpec, ",") {
+			leg := CompositeLeg{Weight: 1}
+			name, weight, weighted := strings.Cut(field, "*")
+			leg.Symbol = strings.TrimSpace(name)
+			if weighted {
+				var err error
+				if leg.Weight, err = strconv.ParseFloat(strings.TrimSpace(weight), 64); err != nil || leg.Weight == 0 {
+					valid = false
+				}
+			}
+			if leg.Symbol == "" || leg.Symbol == symbol {
+				valid = false
+			}
+			composite.Legs = append(composite.Legs, leg)
+		}
+
+		switch kind {
+		case CompositeBasket:
+			valid = valid && len(composite.Legs) > 0
+		case CompositeSpread, CompositeRatio, CompositeFX:
+			valid = valid && len(composite.Legs) == 2
+		default:
+			valid = false
+		}
+		if valid {
+			composites = append(composites, composite)
+		}
+	}
+
+	names := make(map[string]bool, len(composites))
+	for _, composite := range composites {
+		names[composite.Symbol] = true
+	}
+	var flat []CompositeSymbol
+	for _, composite := range composites {
+		nested := false
+		for _, leg := range composite.Legs {
+			nested = nested || names[leg.Symbol]
+		}
+		if !nested {
+			flat = append(flat, composite)
+		}
+	}
+	return flat
+}
+
+// getEnvAsPerpetuals parses "BTC-PERP=BTC-USD/8h;ETH-PERP=ETH-USD", i.e.
+// symbol=index/funding interval, with the interval defaulting to 8h.
+// Malformed and duplicate perpetuals and perpetuals on other perpetuals are skipped.
+func getEnvAsPerpetuals(key, defaultValue string) []Perpetual {
+	var perpetuals []Perpetual
+	seen := make(map[string]bool)
+	for _, entry := range splitList(getEnv(key, defaultValue), ";") {
+		symbol, spec, found := strings.Cut(entry, "=")
+		index, interval, timed := strings.Cut(spec, "/")
+		symbol, index = strings.TrimSpace(symbol), strings.TrimSpace(index)
+		if !found || symbol == "" || index == "" || index == symbol || seen[symbol] {
+			continue
+		}
+
+		perpetual := Perpetual{Symbol: symbol, Index: index, FundingInterval: defaultFundingInterval}
+		if timed {
+			var err error
+			if perpetual.FundingInterval, err = time.ParseDuration(strings.TrimSpace(interval)); err != nil || perpetual.FundingInterval <= 0 {
+				continue
+			}
+		}
+		seen[symbol] = true
+		perpetuals = append(perpetuals, perpetual)
+	}
+
+	var flat []Perpetual
+	for _, perpetual := range perpetuals {
+		if !seen[perpetual.Index] {
+			flat = append(flat, perpetual)
+		}
+	}
+	return flat
+}
+
+// getEnvAsFutures parses "BTC-FUT=BTC-USD/quarterly/3/30:5,90:7,180:9", i.e.
+// root=underlying/cycle/listed contracts/curve, where the curve lists
+// days:annualized basis percent in increasing tenor order and may be empty.
+// Malformed and duplicate series are skipped.
+func getEnvAsFutures(key, defaultValue string) []FutureSeries {
+	var series []FutureSeries
+	seen := make(map[string]bool)
+	for _, entry := range splitList(getEnv(key, defaultValue), ";") {
+		root, spec, found := strings.Cut(entry, "=")
+		root = strings.TrimSpace(root)
+		fields := strings.Split(spec, "/")
+		if !found || root == "" || seen[root] || len(fields) != 4 {
+			continue
+		}
+
+		future := FutureSeries{Root: root, Underlying: strings.TrimSpace(fields[0]), Cycle: strings.TrimSpace(fields[1])}
+		if future.Underlying == "" || (future.Cycle != FutureCycleMonthly && future.Cycle != FutureCycleQuarterly) {
+			continue
+		}
+		listed, err := strconv.Atoi(strings.TrimSpace(fields[2]))
+		if err != nil || listed <= 0 {
+			continue
+		}
+		future.Listed = listed
+
+		valid := true
+		for _, point := range splitList(fields[3], ",") {
+			days, percent, found := strings.Cut(point, ":")
+			tenor, err := strconv.Atoi(strings.TrimSpace(days))
+			if !found || err != nil || tenor <= 0 {
+				valid = false
+				break
+			}
+			basis, err := strconv.ParseFloat(strings.TrimSpace(percent), 64)
+			if err != nil {
+				valid = false
+				break
+			}
+			curvePoint := CurvePoint{Tenor: time.Duration(tenor) * 24 * time.Hour, BasisPercent: basis}
+			if n := len(future.Curve); n > 0 && curvePoint.Tenor <= future.Curve[n-1].Tenor {
+				valid = false
+				break
+			}
+			future.Curve = append(future.Curve, curvePoint)
+		}
+		if !valid {
+			continue
+		}
+
+		seen[root] = true
+		series = append(series, future)
+	}
+	return series
+}
+
+// getEnvAsOptions parses "BTC-OPT=BTC-USD/monthly/3/5/5", i.e.
+// root=underlying/cycle/expiries/strikes per side/strike step percent.
+// Malformed and duplicate series are skipped.
+func getEnvAsOptions(key, defaultValue string) []OptionSeries {
+	var series []OptionSeries
+	seen := make(map[string]bool)
+	for _, entry := range splitList(getEnv(key, defaultValue), ";") {
+		root, spec, found := strings.Cut(entry, "=")
+		root = strings.TrimSpace(root)
+		fields := splitList(spec, "/")
+		if !found || root == "" || seen[root] || len(fields) != 5 {
+			continue
+		}
+
+		option := OptionSeries{Root: root, Underlying: fields[0], Cycle: fields[1]}
+		if option.Cycle != FutureCycleMonthly && option.Cycle != FutureCycleQuarterly {
+			continue
+		}
+		var err error
+		if option.Expiries, err = strconv.Atoi(fields[2]); err != nil || option.Expiries <= 0 {
+			continue
+		}
+		if option.Strikes, err = strconv.Atoi(fields[3]); err != nil || option.Strikes < 0 {
+			continue
+		}
+		if option.StrikeStepPercent, err = strconv.ParseFloat(fields[4], 64); err != nil || option.StrikeStepPercent <= 0 {
+			continue
+		}
+
+		seen[root] = true
+		series = append(series, option)
+	}
+	return series
+}
+
+// getEnvAsBonds parses "UST-2Y=2/4.5;UST-10Y=10/4.25", i.e.
+// symbol=maturity in years/coupon percent. Malformed and duplicate bonds
+// are skipped.
+func getEnvAsBonds(key, defaultValue string) []Bond {
+	var bonds []Bond
+	seen := make(map[string]bool)
+	for _, entry := range splitList(getEnv(key, defaultValue), ";") {
+		symbol, spec, found := strings.Cut(entry, "=")
+		symbol = strings.TrimSpace(symbol)
+		fields := splitList(spec, "/")
+		if !found || symbol == "" || seen[symbol] || len(fields) != 2 {
+			continue
+		}
+
+		bond := Bond{Symbol: symbol}
+		var err error
+		if bond.MaturityYears, err = strconv.ParseFloat(fields[0], 64); err != nil || bond.MaturityYears <= 0 {
+			continue
+		}
+		if bond.CouponPercent, err = strconv.ParseFloat(fields[1], 64); err != nil || bond.CouponPercent < 0 {
+			continue
+		}
+
+		seen[symbol] = true
+		bonds = append(bonds, bond)
+	}
+	return bonds
+}
+
+// getEnvAsVenues parses "binance=1.5/20ms/2;kraken=3/120ms/4", i.e.
+// name=noise bps/latency/spread bps. Malformed and duplicate venues are skipped.
+func getEnvAsVenues(key, defaultValue string) []Venue {
+	var venues []Venue
+	seen := make(map[string]bool)
+	for _, entry := range splitList(getEnv(key, defaultValue), ";") {
+		name, spec, found := strings.Cut(entry, "=")
+		name = strings.TrimSpace(name)
+		fields := splitList(spec, "/")
+		if !found || name == "" || seen[name] || len(fields) != 3 {
+			continue
+		}
+
+		noise, err := strconv.ParseFloat(fields[0], 64)
+		if err != nil || noise < 0 {
+			continue
+		}
+		latency, err := time.ParseDuration(fields[1])
+		if err != nil || latency < 0 {
+			continue
+		}
+		spread, err := strconv.ParseFloat(fields[2], 64)
+		if err != nil || spread <= 0 {
+			continue
+		}
+
+		seen[name] = true
+		venues = append(venues, Venue{Name: name, NoiseBps: noise, Latency: latency, SpreadBps: spread})
+	}
+	return venues
+}
+
+func splitList(value, sep string) []string {
+	var items []string
+	for _, item := range strings.Split(value, sep) {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
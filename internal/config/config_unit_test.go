@@ -5,6 +5,7 @@ package config
 import (
 	"os"
 	"testing"
+	"time"
 )
 
 // TestConfig_Load tests the configuration loading
@@ -51,6 +52,119 @@ func TestConfig_Load(t *testing.T) {
 	})
 }
 
+// TestConfig_SymbolUniverse tests parsing of the symbol universe settings
+func TestConfig_SymbolUniverse(t *testing.T) {
+	t.Run("parse_symbols_and_groups", func(t *testing.T) {
+		// Given: Symbols and groups set through the environment
+		os.Setenv("SYMBOLS", "BTC-USD, ETH-USD,,SOL-USD")
+		os.Setenv("SYMBOL_GROUPS", "majors:BTC-USD,ETH-USD; usd:*-USD;malformed")
+		defer os.Clearenv()
+
+		// When: Loading config
+		cfg := Load()
+
+		// Then: Lists should be trimmed and empty entries dropped
+		if len(cfg.Symbols) != 3 || cfg.Symbols[1] != "ETH-USD" {
+			t.Errorf("Expected 3 trimmed symbols, got %v", cfg.Symbols)
+		}
+		if len(cfg.SymbolGroups) != 2 {
+			t.Errorf("Expected 2 symbol groups, got %v", cfg.SymbolGroups)
+		}
+		if members := cfg.SymbolGroups["usd"]; len(members) != 1 || members[0] != "*-USD" {
+			t.Errorf("Expected usd group to hold the *-USD pattern, got %v", members)
+		}
+	})
+}
+
+// TestConfig_HighFrequency tests the high-frequency streaming settings
+func TestConfig_HighFrequency(t *testing.T) {
+	t.Run("disabled_by_default", func(t *testing.T) {
+		os.Clearenv()
+
+		cfg := Load()
+
+		if cfg.HighFrequencyEnabled {
+			t.Error("Expected high-frequency mode to be disabled by default")
+		}
+		if len(cfg.HighFrequencyClients) != 0 {
+			t.Errorf("Expected no high-frequency clients by default, got %v", cfg.HighFrequencyClients)
+		}
+	})
+
+	t.Run("enabled_with_clients", func(t *testing.T) {
+		// Given: High-frequency mode enabled for two clients
+		os.Setenv("HIGH_FREQUENCY_ENABLED", "true")
+		os.Setenv("HIGH_FREQUENCY_MIN_INTERVAL", "250us")
+		os.Setenv("HIGH_FREQUENCY_CLIENTS", "latency-tests, strategy-lab")
+		defer os.Clearenv()
+
+		// When: Loading config
+		cfg := Load()
+
+		// Then: Settings should be parsed
+		if !cfg.HighFrequencyEnabled {
+			t.Error("Expected high-frequency mode to be enabled")
+		}
+		if cfg.HighFrequencyMinInterval != 250*time.Microsecond {
+			t.Errorf("Expected 250us minimum interval, got %v", cfg.HighFrequencyMinInterval)
+		}
+		if len(cfg.HighFrequencyClients) != 2 || cfg.HighFrequencyClients[1] != "strategy-lab" {
+			t.Errorf("Expected 2 high-frequency clients, got %v", cfg.HighFrequencyClients)
+		}
+	})
+}
+
+// TestConfig_PriceBands tests parsing of price band rules
+func TestConfig_PriceBands(t *testing.T) {
+	t.Run("parse_rules", func(t *testing.T) {
+		// Given: Halt and clamp rules plus malformed entries
+		os.Setenv("PRICE_BANDS", "BTC-USD=10/5m/halt:2m; *-USD=7/1m/clamp;ETH-USD=5/1m/halt;bad;SOL-USD=x/1m/clamp;ADA-USD=5/1m/pause")
+		defer os.Clearenv()
+
+		// When: Loading config
+		cfg := Load()
+
+		// Then: Valid rules are kept in order
+		if len(cfg.PriceBands) != 3 {
+			t.Fatalf("Expected 3 price band rules, got %v", cfg.PriceBands)
+		}
+		halt := cfg.PriceBands[0]
+		if halt.Symbol != "BTC-USD" || halt.MovePercent != 10 || halt.Window != 5*time.Minute || !halt.Halt || halt.HaltDuration != 2*time.Minute {
+			t.Errorf("Unexpected halt rule %+v", halt)
+		}
+		if clamp := cfg.PriceBands[1]; clamp.Symbol != "*-USD" || clamp.Halt {
+			t.Errorf("Unexpected clamp rule %+v", clamp)
+		}
+		if cfg.PriceBands[2].HaltDuration != defaultHaltDuration {
+			t.Errorf("Expected default halt duration, got %v", cfg.PriceBands[2].HaltDuration)
+		}
+	})
+}
+
+// TestConfig_TradingSchedules tests parsing of trading session schedules
+func TestConfig_TradingSchedules(t *testing.T) {
+	t.Run("parse_schedules", func(t *testing.T) {
+		// Given: One valid schedule, one out of order and one with an unknown zone
+		os.Setenv("TRADING_SCHEDULES", "*-EUR=Europe/Berlin,07:30,07:50,08:00,16:30,16:35;X=UTC,09:00,08:00,10:00,16:00,16:05;Y=Nowhere/City,07:30,07:50,08:00,16:30,16:35")
+		defer os.Clearenv()
+
+		// When: Loading config
+		cfg := Load()
+
+		// Then: Only the valid schedule is kept
+		if len(cfg.TradingSchedules) != 1 {
+			t.Fatalf("Expected 1 trading schedule, got %d", len(cfg.TradingSchedules))
+		}
+		schedule := cfg.TradingSchedules[0]
+		if schedule.Symbol != "*-EUR" || schedule.Location.String() != "Europe/Berlin" {
+			t.Errorf("Unexpected schedule %+v", schedule)
+		}
+		if schedule.OpeningAuction != 7*time.Hour+50*time.Minute || schedule.Close != 16*time.Hour+35*time.Minute {
+			t.Errorf("Unexpected schedule times %+v", schedule)
+		}
+	})
+}
+
 // TestConfig_GetDataAdapter tests the GetDataAdapter method
 func TestConfig_GetDataAdapter(t *testing.T) {
 	t.Run("get_data_adapter_before_initialization", func(t *testing.T) {
@@ -66,3 +180,348 @@ func TestConfig_GetDataAdapter(t *testing.T) {
 		}
 	})
 }
+
+// TestConfig_Venues tests parsing of simulated venues
+func TestConfig_Venues(t *testing.T) {
+	t.Run("default_venues", func(t *testing.T) {
+		os.Clearenv()
+
+		cfg := Load()
+
+		if len(cfg.Venues) != 3 || cfg.Venues[0].Name != "binance" {
+			t.Errorf("Expected the three default venues, got %+v", cfg.Venues)
+		}
+		if cfg.VenueMaxDislocationBps != 25 {
+			t.Errorf("Expected default max dislocation of 25bps, got %v", cfg.VenueMaxDislocationBps)
+		}
+		if cfg.MarkOutlierBps != 10 {
+			t.Errorf("Expected default mark outlier threshold of 10bps, got %v", cfg.MarkOutlierBps)
+		}
+	})
+
+	t.Run("parse_venues", func(t *testing.T) {
+		// Given: Valid venues plus malformed and duplicate entries
+		os.Setenv("VENUES", "lse=1/5ms/2; cboe=2.5/40ms/3;cboe=1/1ms/1;bad;x=1/soon/2;y=1/1ms/0")
+		defer os.Clearenv()
+
+		// When: Loading config
+		cfg := Load()
+
+		// Then: Valid venues are kept in order
+		if len(cfg.Venues) != 2 {
+			t.Fatalf("Expected 2 venues, got %+v", cfg.Venues)
+		}
+		if venue := cfg.Venues[1]; venue.Name != "cboe" || venue.NoiseBps != 2.5 || venue.Latency != 40*time.Millisecond || venue.SpreadBps != 3 {
+			t.Errorf("Unexpected venue %+v", venue)
+		}
+	})
+
+	t.Run("negative_dislocation_rejected", func(t *testing.T) {
+		// Given: A negative arbitrage bound, which would invert the clamp
+		os.Setenv("VENUE_MAX_DISLOCATION_BPS", "-5")
+		defer os.Clearenv()
+
+		// When: Loading config
+		cfg := Load()
+
+		// Then: The default applies
+		if cfg.VenueMaxDislocationBps != 25 {
+			t.Errorf("Expected the default max dislocation of 25bps, got %v", cfg.VenueMaxDislocationBps)
+		}
+	})
+
+	t.Run("non_positive_outlier_threshold_rejected", func(t *testing.T) {
+		// Given: A zero outlier threshold, which would exclude every venue from the mark
+		os.Setenv("MARK_OUTLIER_BPS", "0")
+		defer os.Clearenv()
+
+		// When: Loading config
+		cfg := Load()
+
+		// Then: The default applies
+		if cfg.MarkOutlierBps != 10 {
+			t.Errorf("Expected the default outlier threshold of 10bps, got %v", cfg.MarkOutlierBps)
+		}
+	})
+}
+
+// TestConfig_CompositeSymbols tests parsing of composite symbol definitions
+func TestConfig_CompositeSymbols(t *testing.T) {
+	t.Run("parse_composites", func(t *testing.T) {
+		// Given: One composite of each kind plus malformed and nested entries
+		os.Setenv("COMPOSITE_SYMBOLS", "IDX=basket:BTC-USD*0.6, ETH-USD*0.4;BE=spread:BTC-USD,ETH-USD*15;R=ratio:ETH-USD,BTC-USD;BTC-GBP=fx:BTC-USD,USD-GBP;"+
+			"X=spread:BTC-USD;Y=sum:BTC-USD;Z=basket:BTC-USD*zero;N=basket:IDX,BTC-USD;S=basket:S")
+		defer os.Clearenv()
+
+		// When: Loading config
+		cfg := Load()
+
+		// Then: Valid composites are kept in order
+		if len(cfg.CompositeSymbols) != 4 {
+			t.Fatalf("Expected 4 composite symbols, got %+v", cfg.CompositeSymbols)
+		}
+		basket := cfg.CompositeSymbols[0]
+		if basket.Symbol != "IDX" || basket.Kind != CompositeBasket || len(basket.Legs) != 2 || basket.Legs[1] != (CompositeLeg{Symbol: "ETH-USD", Weight: 0.4}) {
+			t.Errorf("Unexpected basket %+v", basket)
+		}
+		spread := cfg.CompositeSymbols[1]
+		if spread.Legs[0].Weight != 1 || spread.Legs[1].Weight != 15 {
+			t.Errorf("Unexpected spread weights %+v", spread.Legs)
+		}
+		if fx := cfg.CompositeSymbols[3]; fx.Kind != CompositeFX || fx.Legs[1].Symbol != "USD-GBP" {
+			t.Errorf("Unexpected fx composite %+v", fx)
+		}
+	})
+}
+
+// TestConfig_Instruments tests parsing of instrument reference data
+func TestConfig_Instruments(t *testing.T) {
+	t.Run("default_instruments", func(t *testing.T) {
+		os.Clearenv()
+
+		cfg := Load()
+
+		// Every default symbol has reference data
+		registered := make(map[string]bool)
+		for _, instrument := range cfg.Instruments {
+			registered[instrument.Symbol] = true
+		}
+		for _, symbol := range cfg.Symbols {
+			if !registered[symbol] {
+				t.Errorf("Expected reference data for default symbol %s", symbol)
+			}
+		}
+	})
+
+	t.Run("parse_instruments", func(t *testing.T) {
+		// Given: Valid instruments plus malformed and duplicate entries
+		os.Setenv("INSTRUMENTS", "EUR-USD=fx,EUR,USD,0.00001,1000,5; AAPL=equity,AAPL,USD,0.01,1,2;AAPL=equity,AAPL,USD,1,1,0;bad;X=fx,X,USD,0,1,2;Y=fx,Y,USD,0.1,1,-1")
+		defer os.Clearenv()
+
+		// When: Loading config
+		cfg := Load()
+
+		// Then: Valid instruments are kept in order
+		if len(cfg.Instruments) != 2 {
+			t.Fatalf("Expected 2 instruments, got %+v", cfg.Instruments)
+		}
+		expected := Instrument{Symbol: "EUR-USD", AssetClass: "fx", BaseCurrency: "EUR", QuoteCurrency: "USD", TickSize: 0.00001, LotSize: 1000, PricePrecision: 5}
+		if cfg.Instruments[0] != expected {
+			t.Errorf("Unexpected instrument %+v", cfg.Instruments[0])
+		}
+		if cfg.Instruments[1].TickSize != 0.01 {
+			t.Errorf("Expected the first AAPL entry to win, got %+v", cfg.Instruments[1])
+		}
+	})
+}
+
+// TestConfig_Perpetuals tests parsing of perpetual swaps
+func TestConfig_Perpetuals(t *testing.T) {
+	t.Run("parse_perpetuals", func(t *testing.T) {
+		// Given: Perpetuals with and without an interval plus malformed entries
+		os.Setenv("PERPETUALS", "BTC-PERP=BTC-USD/1h; ETH-PERP=ETH-USD;X=BTC-USD/never;Y=BTC-USD/-1h;Z=;BTC-PERP=SOL-USD;P2=BTC-PERP")
+		defer os.Clearenv()
+
+		// When: Loading config
+		cfg := Load()
+
+		// Then: Valid perpetuals are kept in order
+		expected := []Perpetual{
+			{Symbol: "BTC-PERP", Index: "BTC-USD", FundingInterval: time.Hour},
+			{Symbol: "ETH-PERP", Index: "ETH-USD", FundingInterval: 8 * time.Hour},
+		}
+		if len(cfg.Perpetuals) != len(expected) {
+			t.Fatalf("Expected %d perpetuals, got %+v", len(expected), cfg.Perpetuals)
+		}
+		for i := range expected {
+			if cfg.Perpetuals[i] != expected[i] {
+				t.Errorf("Expected %+v, got %+v", expected[i], cfg.Perpetuals[i])
+			}
+		}
+		if cfg.PerpetualFundingCap != 0.0075 || cfg.PerpetualInterestRate != 0.0001 {
+			t.Errorf("Unexpected funding defaults: cap %v, interest %v", cfg.PerpetualFundingCap, cfg.PerpetualInterestRate)
+		}
+	})
+
+	t.Run("non_positive_basis_half_life_and_cap_rejected", func(t *testing.T) {
+		// Given: A zero half-life, which would divide by zero, and a negative cap
+		os.Setenv("PERPETUAL_BASIS_HALF_LIFE", "0s")
+		os.Setenv("PERPETUAL_FUNDING_CAP", "-0.01")
+		defer os.Clearenv()
+
+		// When: Loading config
+		cfg := Load()
+
+		// Then: The defaults apply
+		if cfg.PerpetualBasisHalfLife != 10*time.Minute || cfg.PerpetualFundingCap != 0.0075 {
+			t.Errorf("Expected the default half-life and cap, got %v and %v", cfg.PerpetualBasisHalfLife, cfg.PerpetualFundingCap)
+		}
+	})
+}
+
+// TestConfig_Futures tests parsing of dated future series
+func TestConfig_Futures(t *testing.T) {
+	t.Run("parse_futures", func(t *testing.T) {
+		// Given: A contango and a backwardated series plus malformed entries
+		os.Setenv("FUTURES", "BTC-FUT=BTC-USD/quarterly/3/30:5, 90:7,180:9;ETH-FUT=ETH-USD/monthly/2/30:-4;"+
+			"X=BTC-USD/weekly/2/;Y=BTC-USD/monthly/0/;Z=BTC-USD/monthly/2/90:5,30:4;W=BTC-USD/monthly/2/30:abc;BTC-FUT=SOL-USD/monthly/1/")
+		defer os.Clearenv()
+
+		// When: Loading config
+		cfg := Load()
+
+		// Then: Valid series are kept in order
+		if len(cfg.Futures) != 2 {
+			t.Fatalf("Expected 2 future series, got %+v", cfg.Futures)
+		}
+		btc := cfg.Futures[0]
+		if btc.Root != "BTC-FUT" || btc.Underlying != "BTC-USD" || btc.Cycle != FutureCycleQuarterly || btc.Listed != 3 || len(btc.Curve) != 3 {
+			t.Errorf("Unexpected series %+v", btc)
+		}
+		if btc.Curve[1] != (CurvePoint{Tenor: 90 * 24 * time.Hour, BasisPercent: 7}) {
+			t.Errorf("Unexpected curve point %+v", btc.Curve[1])
+		}
+		if eth := cfg.Futures[1]; len(eth.Curve) != 1 || eth.Curve[0].BasisPercent != -4 || eth.Listed != 2 {
+			t.Errorf("Unexpected series %+v", eth)
+		}
+	})
+}
+
+// TestConfig_Options tests parsing of option series and the vol surface
+func TestConfig_Options(t *testing.T) {
+	t.Run("parse_options", func(t *testing.T) {
+		// Given: A valid series plus malformed and duplicate entries
+		os.Setenv("OPTIONS", "BTC-OPT=BTC-USD/monthly/3/5/2.5;X=BTC-USD/weekly/3/5/5;Y=BTC-USD/monthly/0/5/5;Z=BTC-USD/monthly/3/5/0;W=BTC-USD/monthly/3/5;BTC-OPT=ETH-USD/monthly/1/1/1")
+		os.Setenv("VOL_SURFACE_ATM", "0.45")
+		defer os.Clearenv()
+
+		// When: Loading config
+		cfg := Load()
+
+		// Then: Only the first valid series is kept
+		expected := []OptionSeries{{Root: "BTC-OPT", Underlying: "BTC-USD", Cycle: FutureCycleMonthly, Expiries: 3, Strikes: 5, StrikeStepPercent: 2.5}}
+		if len(cfg.Options) != 1 || cfg.Options[0] != expected[0] {
+			t.Fatalf("Expected %+v, got %+v", expected, cfg.Options)
+		}
+		if cfg.VolSurface.ATM != 0.45 || cfg.VolSurface.Skew != -0.1 {
+			t.Errorf("Unexpected vol surface %+v", cfg.VolSurface)
+		}
+	})
+
+	t.Run("non_positive_atm_and_negative_spread_rejected", func(t *testing.T) {
+		// Given: A zero ATM vol, which would price every option at intrinsic, and a negative spread
+		os.Setenv("VOL_SURFACE_ATM", "0")
+		os.Setenv("OPTION_VOL_SPREAD", "-0.01")
+		defer os.Clearenv()
+
+		// When: Loading config
+		cfg := Load()
+
+		// Then: The defaults apply
+		if cfg.VolSurface.ATM != 0.6 || cfg.OptionVolSpread != 0.01 {
+			t.Errorf("Expected the default ATM vol and spread, got %v and %v", cfg.VolSurface.ATM, cfg.OptionVolSpread)
+		}
+	})
+}
+
+func TestConfig_FX(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		// Given: No FX environment
+		os.Clearenv()
+
+		// When: Loading config
+		cfg := Load()
+
+		// Then: No crosses are priced and triangles close exactly
+		if cfg.FXPivot != "USD" || len(cfg.FXFactors) != 0 || len(cfg.FXCrosses) != 0 || cfg.FXDislocationBps != 0 {
+			t.Errorf("Unexpected FX defaults: pivot %q, factors %v, crosses %v, dislocation %v", cfg.FXPivot, cfg.FXFactors, cfg.FXCrosses, cfg.FXDislocationBps)
+		}
+	})
+
+	t.Run("parse_fx", func(t *testing.T) {
+		// Given: Factors, crosses and a dislocation
+		os.Setenv("FX_FACTORS", "EUR-USD, USD-JPY")
+		os.Setenv("FX_CROSSES", "EUR-JPY")
+		os.Setenv("FX_DISLOCATION_BPS", "2")
+		os.Setenv("FX_DISLOCATION_HALF_LIFE", "10s")
+		defer os.Clearenv()
+
+		// When: Loading config
+		cfg := Load()
+
+		// Then: They are read as given
+		if len(cfg.FXFactors) != 2 || cfg.FXFactors[1] != "USD-JPY" || len(cfg.FXCrosses) != 1 || cfg.FXCrosses[0] != "EUR-JPY" {
+			t.Errorf("Unexpected factors %v and crosses %v", cfg.FXFactors, cfg.FXCrosses)
+		}
+		if cfg.FXDislocationBps != 2 || cfg.FXDislocationHalfLife != 10*time.Second {
+			t.Errorf("Unexpected dislocation %v with half-life %v", cfg.FXDislocationBps, cfg.FXDislocationHalfLife)
+		}
+	})
+
+	t.Run("non_positive_dislocation_half_life_rejected", func(t *testing.T) {
+		// Given: A zero half-life, which would divide by zero
+		os.Setenv("FX_DISLOCATION_HALF_LIFE", "0s")
+		defer os.Clearenv()
+
+		// When: Loading config
+		cfg := Load()
+
+		// Then: The default applies
+		if cfg.FXDislocationHalfLife != 30*time.Second {
+			t.Errorf("Expected the default half-life, got %v", cfg.FXDislocationHalfLife)
+		}
+	})
+}
+
+func TestConfig_Rates(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		// Given: No rates environment
+		os.Clearenv()
+
+		// When: Loading config
+		cfg := Load()
+
+		// Then: An upward sloping USD curve without shocks, and no bonds
+		curve := cfg.YieldCurve
+		if curve.Currency != "USD" || curve.Level != 0.045 || curve.Slope != -0.01 || curve.Lambda != 2 || curve.ShocksPerDay != 0 {
+			t.Errorf("Unexpected default curve %+v", curve)
+		}
+		if len(cfg.Bonds) != 0 {
+			t.Errorf("Expected no bonds, got %+v", cfg.Bonds)
+		}
+	})
+
+	t.Run("parse_bonds", func(t *testing.T) {
+		// Given: Valid bonds plus malformed and duplicate entries
+		os.Setenv("BONDS", "UST-2Y=2/4.5;UST-10Y=10/4.25;X=0/4;Y=5/-1;Z=5;UST-2Y=3/1")
+		os.Setenv("YIELD_CURVE_SHOCKS_PER_DAY", "4")
+		defer os.Clearenv()
+
+		// When: Loading config
+		cfg := Load()
+
+		// Then: Only the valid bonds are kept, first definition wins
+		expected := []Bond{{Symbol: "UST-2Y", MaturityYears: 2, CouponPercent: 4.5}, {Symbol: "UST-10Y", MaturityYears: 10, CouponPercent: 4.25}}
+		if len(cfg.Bonds) != 2 || cfg.Bonds[0] != expected[0] || cfg.Bonds[1] != expected[1] {
+			t.Errorf("Expected %+v, got %+v", expected, cfg.Bonds)
+		}
+		if cfg.YieldCurve.ShocksPerDay != 4 {
+			t.Errorf("Expected 4 shocks per day, got %v", cfg.YieldCurve.ShocksPerDay)
+		}
+	})
+
+	t.Run("non_positive_lambda_and_half_life_rejected", func(t *testing.T) {
+		// Given: A zero lambda and a negative half-life, both divisors
+		os.Setenv("YIELD_CURVE_LAMBDA", "0")
+		os.Setenv("YIELD_CURVE_HALF_LIFE", "-1m")
+		defer os.Clearenv()
+
+		// When: Loading config
+		cfg := Load()
+
+		// Then: The defaults apply
+		if cfg.YieldCurve.Lambda != 2 || cfg.YieldCurve.HalfLife != 10*time.Minute {
+			t.Errorf("Expected the default lambda and half-life, got %v and %v", cfg.YieldCurve.Lambda, cfg.YieldCurve.HalfLife)
+		}
+	})
+}
@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/services"
+)
+
+// orderDerived moves perpetuals, futures and FX crosses behind the regular
+// symbols and composites behind all of them, so what they are priced from
+// has moved by the time a tick prices them. It also returns the legs,
+// indices, underlyings and factors that are not subscribed themselves, which
+// the session reads unpublished, along with any unsubscribed hidden symbols.
+func (h *MarketDataGRPCHandler) orderDerived(symbols, hidden []string) ([]string, []string) {
+	subscribed := make(map[string]bool, len(symbols))
+	for _, symbol := range symbols {
+		subscribed[symbol] = true
+	}
+
+	var regular, derivatives, composites, legs []string
+	// hide reads an unsubscribed input, after what a derivative leg tracks
+	var hide func(symbol string)
+	hide = func(symbol string) {
+		if subscribed[symbol] {
+			return
+		}
+		subscribed[symbol] = true
+		inputs, _ := h.inputs(symbol)
+		for _, input := range inputs {
+			hide(input)
+		}
+		legs = append(legs, symbol)
+	}
+
+	for _, symbol := range symbols {
+		if inputs, exists := h.inputs(symbol); exists {
+			derivatives = append(derivatives, symbol)
+			for _, input := range inputs {
+				hide(input)
+			}
+			continue
+		}
+		composite, exists := h.marketDataService.Composite(symbol)
+		if !exists {
+			regular = append(regular, symbol)
+			continue
+		}
+		composites = append(composites, symbol)
+		for _, leg := range composite.Legs {
+			hide(leg.Symbol)
+		}
+	}
+	for _, symbol := range hidden {
+		hide(symbol)
+	}
+	return append(append(regular, derivatives...), composites...), legs
+}
+
+// inputs are the symbols a perpetual, future, FX cross or option is priced off
+func (h *MarketDataGRPCHandler) inputs(symbol string) ([]string, bool) {
+	if perpetual, exists := h.marketDataService.Perpetual(symbol); exists {
+		return []string{perpetual.Index}, true
+	}
+	if contract, exists := h.marketDataService.Future(symbol); exists {
+		return []string{contract.Series.Underlying}, true
+	}
+	if cross, exists := h.marketDataService.Cross(symbol); exists {
+		return cross.Factors(), true
+	}
+	if contract, exists := h.marketDataService.Option(symbol); exists {
+		return []string{contract.Series.Underlying}, true
+	}
+	return nil, false
+}
+
+// compositeUpdate reports a composite symbol priced off its legs in the
+// market snapshot
+func (h *MarketDataGRPCHandler) compositeUpdate(symbol string, composite config.CompositeSymbol, session *StreamSession, market services.MarketSnapshot, at time.Time) *proto.PriceUpdate {
+	info := &proto.CompositeInfo{Kind: composite.Kind}
+	for _, leg := range composite.Legs {
+		info.Legs = append(info.Legs, &proto.CompositeLeg{
+			Symbol: leg.Symbol,
+			Weight: leg.Weight,
+			Price:  market.Price(leg.Symbol),
+		})
+	}
+
+	return &proto.PriceUpdate{
+		Symbol:     symbol,
+		Price:      market.Price(symbol),
+		Timestamp:  timestamppb.New(at),
+		Source:     "market-data-simulator",
+		ChangeInfo: &proto.PriceChangeInfo{},
+		Composite:  info,
+	}
+}
@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
+)
+
+func setupCompositeHandler() *MarketDataGRPCHandler {
+	return setupHandlerWith(func(cfg *config.Config) {
+		cfg.CompositeSymbols = []config.CompositeSymbol{
+			{Symbol: "MAJORS-IDX", Kind: config.CompositeBasket, Legs: []config.CompositeLeg{{Symbol: "BTC-USD", Weight: 0.6}, {Symbol: "ETH-USD", Weight: 0.4}}},
+			{Symbol: "BTC-ETH-SPREAD", Kind: config.CompositeSpread, Legs: []config.CompositeLeg{{Symbol: "BTC-USD", Weight: 1}, {Symbol: "ETH-USD", Weight: 1}}},
+		}
+	})
+}
+
+func TestOrderComposites(t *testing.T) {
+	handler := setupCompositeHandler()
+
+	symbols, legs := handler.orderDerived([]string{"MAJORS-IDX", "BTC-USD", "BTC-ETH-SPREAD", "SOL-USD"}, nil)
+	assert.Equal(t, []string{"BTC-USD", "SOL-USD", "MAJORS-IDX", "BTC-ETH-SPREAD"}, symbols)
+	assert.Equal(t, []string{"ETH-USD"}, legs)
+}
+
+func TestPublishTick_Composites(t *testing.T) {
+	handler := setupCompositeHandler()
+
+	session, published := publishTicks(t, handler, []string{"MAJORS-IDX", "BTC-ETH-SPREAD", "BTC-USD"}, time.Now(), 5)
+	assert.Equal(t, []string{"ETH-USD"}, session.legs)
+	require.Len(t, published, 15, "legs that were not subscribed are not published")
+
+	// Composites are priced from the legs' prices of the same tick, before
+	// each is rounded to its own tick
+	tick := published[len(published)-3:]
+	btc, index, spread := tick[0], tick[1], tick[2]
+	assert.Equal(t, "BTC-USD", btc.Symbol)
+	eth := session.market.Price("ETH-USD")
+
+	assert.Equal(t, "MAJORS-IDX", index.Symbol)
+	assert.InDelta(t, 0.6*btc.Price+0.4*eth, index.Price, 0.01)
+	require.NotNil(t, index.Composite)
+	assert.Equal(t, config.CompositeBasket, index.Composite.Kind)
+	assert.Equal(t, btc.Price, index.Composite.Legs[0].Price)
+
+	assert.Equal(t, "BTC-ETH-SPREAD", spread.Symbol)
+	assert.InDelta(t, btc.Price-eth, spread.Price, 0.01)
+}
+
+func TestMarketDataGRPCHandler_StreamPrices_CompositePattern(t *testing.T) {
+	handler := setupCompositeHandler()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := newMockPriceStream(ctx)
+
+	// Composites are part of the universe, so patterns match them
+	go handler.StreamPrices(&proto.StreamPricesRequest{
+		Symbols:          []string{"*-IDX"},
+		UpdateIntervalMs: 100,
+	}, stream)
+
+	require.Eventually(t, func() bool { return len(stream.Updates()) >= 2 }, 2*time.Second, 10*time.Millisecond)
+	for _, update := range stream.Updates() {
+		assert.Equal(t, "MAJORS-IDX", update.Symbol)
+		assert.NotNil(t, update.Composite)
+	}
+}
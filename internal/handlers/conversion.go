@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/services"
+)
+
+// conversion finds how a symbol's prices convert into the quote currency.
+// Symbols already quoted in it, or quoted in no currency, need none.
+func (h *MarketDataGRPCHandler) conversion(symbol, quoteCurrency string) (*services.Conversion, error) {
+	if quoteCurrency == "" {
+		return nil, nil
+	}
+	instrument, exists := h.instrument(symbol)
+	if !exists || instrument.QuoteCurrency == "" || instrument.QuoteCurrency == quoteCurrency {
+		return nil, nil
+	}
+	conversion, exists := h.marketDataService.ConversionFor(instrument.QuoteCurrency, quoteCurrency)
+	if !exists {
+		return nil, status.Errorf(codes.InvalidArgument, "no simulated FX rate, direct or through the pivot, converts %s from %s to %s", symbol, instrument.QuoteCurrency, quoteCurrency)
+	}
+	return &conversion, nil
+}
+
+// convertUpdate restates an update's own prices in the session's quote
+// currency at the rate in the tick's market snapshot. Prices of what the update was
+// derived from stay in their own currencies.
+func (h *MarketDataGRPCHandler) convertUpdate(session *StreamSession, update *proto.PriceUpdate) {
+	conversion, exists := session.conversions[update.Symbol]
+	if !exists {
+		return
+	}
+	info := h.conversionInfo(conversion, session.market.Price, update.Symbol, update.Price)
+	rate := info.Rate
+
+	update.Price *= rate
+	if change := update.ChangeInfo; change != nil {
+		change.ChangeAmount *= rate
+		change.DailyHigh *= rate
+		change.DailyLow *= rate
+	}
+	if quote := update.Quote; quote != nil {
+		quote.Bid *= rate
+		quote.Ask *= rate
+	}
+	if book := update.OrderBook; book != nil {
+		for _, levels := range [][]*proto.OrderBookLevel{book.Bids, book.Asks} {
+			for _, level := range levels {
+				level.Price *= rate
+			}
+		}
+	}
+	if event := update.Event; event != nil {
+		event.ReferencePrice *= rate
+		event.BandLow *= rate
+		event.BandHigh *= rate
+	}
+	if auction := update.Auction; auction != nil {
+		auction.IndicativePrice *= rate
+	}
+	for _, reference := range update.ReferencePrices {
+		reference.Price *= rate
+	}
+	if perpetual := update.Perpetual; perpetual != nil {
+		perpetual.MarkPrice *= rate
+	}
+	if future := update.Future; future != nil {
+		future.SettlementPrice *= rate
+	}
+	if cross := update.Cross; cross != nil {
+		cross.TriangulatedPrice *= rate
+	}
+	update.Conversion = info
+}
+
+// conversionInfo states a conversion of the symbol's price and the grid
+// converted prices are rounded to. The rate comes from the rate symbols'
+// published, rounded prices, so clients can reproduce it.
+func (h *MarketDataGRPCHandler) conversionInfo(conversion services.Conversion, market func(symbol string) float64, symbol string, price float64) *proto.ConversionInfo {
+	rounded := func(rateSymbol string) float64 {
+		return h.roundPrice(rateSymbol, market(rateSymbol))
+	}
+	into := conversion.Legs[0]
+	instrument, _ := h.instrument(symbol)
+	converted := services.ConvertedInstrument(instrument, conversion)
+	info := &proto.ConversionInfo{
+		FromCurrency:    conversion.From,
+		ToCurrency:      conversion.To,
+		RateSymbol:      into.RateSymbol,
+		RateSymbolPrice: rounded(into.RateSymbol),
+		Rate:            conversion.Rate(rounded),
+		OriginalPrice:   h.roundPrice(symbol, price),
+		TickSize:        converted.TickSize,
+		PricePrecision:  int32(converted.PricePrecision),
+	}
+	if conversion.Pivot != "" {
+		out := conversion.Legs[1]
+		info.PivotCurrency = conversion.Pivot
+		info.PivotRateSymbol = out.RateSymbol
+		info.PivotRateSymbolPrice = rounded(out.RateSymbol)
+	}
+	return info
+}
@@ -0,0 +1,138 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
+)
+
+func TestMarketDataGRPCHandler_GetPrice_QuoteCurrency(t *testing.T) {
+	handler := setupFXHandler(0)
+
+	resp, err := handler.GetPrice(context.Background(), &proto.GetPriceRequest{
+		Symbol:        "BTC-USD",
+		QuoteCurrency: "EUR",
+		ApiVersion:    proto.ApiVersion_API_V2,
+	})
+	require.NoError(t, err)
+
+	conversion := resp.Conversion
+	require.NotNil(t, conversion)
+	assert.Equal(t, "USD", conversion.FromCurrency)
+	assert.Equal(t, "EUR", conversion.ToCurrency)
+	assert.Equal(t, "EUR-USD", conversion.RateSymbol)
+	assert.InDelta(t, 1/conversion.RateSymbolPrice, conversion.Rate, 1e-15)
+	assert.InDelta(t, conversion.OriginalPrice*conversion.Rate, resp.Price, 0.005)
+	assert.Equal(t, toDecimal(resp.Price, 2), resp.PriceDecimal)
+
+	// Symbols already quoted in the currency are not converted
+	resp, err = handler.GetPrice(context.Background(), &proto.GetPriceRequest{Symbol: "BTC-EUR", QuoteCurrency: "EUR"})
+	require.NoError(t, err)
+	assert.Nil(t, resp.Conversion)
+}
+
+func TestMarketDataGRPCHandler_GetPrice_PivotConversion(t *testing.T) {
+	handler := setupFXHandler(0)
+
+	// No pair converts BTC into JPY, so the rate runs through the USD pivot
+	resp, err := handler.GetPrice(context.Background(), &proto.GetPriceRequest{Symbol: "ETH-BTC", QuoteCurrency: "JPY"})
+	require.NoError(t, err)
+
+	conversion := resp.Conversion
+	require.NotNil(t, conversion)
+	assert.Equal(t, "BTC", conversion.FromCurrency)
+	assert.Equal(t, "JPY", conversion.ToCurrency)
+	assert.Equal(t, "USD", conversion.PivotCurrency)
+	assert.Equal(t, "BTC-USD", conversion.RateSymbol)
+	assert.Equal(t, "USD-JPY", conversion.PivotRateSymbol)
+	assert.InDelta(t, conversion.RateSymbolPrice*conversion.PivotRateSymbolPrice, conversion.Rate, 1e-6)
+	assert.InDelta(t, conversion.OriginalPrice*conversion.Rate, resp.Price, conversion.TickSize/2+conversion.Rate*0.005)
+}
+
+func TestMarketDataGRPCHandler_GetPrice_HighValueQuoteCurrency(t *testing.T) {
+	handler := setupFXHandler(0)
+
+	resp, err := handler.GetPrice(context.Background(), &proto.GetPriceRequest{
+		Symbol:        "SOL-USD",
+		QuoteCurrency: "BTC",
+		ApiVersion:    proto.ApiVersion_API_V2,
+	})
+	require.NoError(t, err)
+
+	// A USD cent is a hundredth of a hundredth of BTC, so the converted grid
+	// is two decimals finer than the USD one
+	conversion := resp.Conversion
+	require.NotNil(t, conversion)
+	assert.Equal(t, "BTC-USD", conversion.RateSymbol)
+	assert.Equal(t, 0.0001, conversion.TickSize)
+	assert.Equal(t, int32(4), conversion.PricePrecision)
+	assert.InDelta(t, conversion.OriginalPrice*conversion.Rate, resp.Price, conversion.TickSize)
+	assert.Equal(t, toDecimal(resp.Price, 4), resp.PriceDecimal)
+}
+
+func TestMarketDataGRPCHandler_GetPrice_UnknownQuoteCurrency(t *testing.T) {
+	handler := setupFXHandler(0)
+
+	_, err := handler.GetPrice(context.Background(), &proto.GetPriceRequest{Symbol: "BTC-USD", QuoteCurrency: "CHF"})
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestPublishTick_QuoteCurrency(t *testing.T) {
+	handler := setupFXHandler(0)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	session := handler.newStreamSession(ctx, cancel, "conversion_test", []string{"BTC-USD", "ETH-USD"}, time.Second)
+	session.quoteCurrency = "JPY"
+	require.NoError(t, handler.resolveSymbols(session))
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		require.NoError(t, handler.publishTick(session, start.Add(time.Duration(i)*time.Second)))
+	}
+	session.out.flush()
+
+	last := make(map[string]*proto.PriceUpdate)
+	for _, batch := range session.out.drain() {
+		for _, update := range batch {
+			last[update.Symbol] = update
+		}
+	}
+
+	assert.Equal(t, []string{"USD-JPY"}, session.legs, "the rate walks unpublished")
+	require.Len(t, last, 2)
+	for _, update := range last {
+		conversion := update.Conversion
+		require.NotNil(t, conversion, update.Symbol)
+		assert.Equal(t, "USD-JPY", conversion.RateSymbol)
+		assert.Equal(t, conversion.RateSymbolPrice, conversion.Rate)
+		assert.Equal(t, handler.roundPrice("USD-JPY", session.market.Price("USD-JPY")), conversion.RateSymbolPrice, "the tick's rate")
+		assert.Equal(t, handler.roundPrice(update.Symbol, session.market.Price(update.Symbol)), conversion.OriginalPrice)
+		assert.InDelta(t, conversion.OriginalPrice*conversion.Rate, update.Price, conversion.Rate*0.01, "within a tick of the original")
+		assert.InDelta(t, update.Price, (update.Quote.Bid+update.Quote.Ask)/2, update.Price*0.01, "the quote is converted too")
+	}
+
+	// A currency no simulated pair reaches fails an explicit subscription
+	session = handler.newStreamSession(ctx, cancel, "conversion_test", []string{"BTC-USD"}, time.Second)
+	session.quoteCurrency = "CHF"
+	assert.Equal(t, codes.InvalidArgument, status.Code(handler.resolveSymbols(session)))
+
+	// but leaves what a pattern matches unconverted
+	session = handler.newStreamSession(ctx, cancel, "conversion_test", []string{"*-USD"}, time.Second)
+	session.quoteCurrency = "CHF"
+	require.NoError(t, handler.resolveSymbols(session))
+	require.NoError(t, handler.publishTick(session, start))
+	session.out.flush()
+	for _, batch := range session.out.drain() {
+		for _, update := range batch {
+			assert.Nil(t, update.Conversion, update.Symbol)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"math"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/services"
+)
+
+// checkAPIVersion rejects versions this server does not know
+func checkAPIVersion(version proto.ApiVersion) error {
+	if _, known := proto.ApiVersion_name[int32(version)]; !known {
+		return status.Errorf(codes.InvalidArgument, "unsupported api_version %d", version)
+	}
+	return nil
+}
+
+// toDecimal converts a rounded value to an exact decimal with the given
+// number of decimal places. The value is already on a tick or lot, so the
+// scaled float is within float error of an integer.
+func toDecimal(value float64, scale int) *proto.Decimal {
+	return &proto.Decimal{
+		Units: int64(math.Round(value * math.Pow10(scale))),
+		Scale: int32(scale),
+	}
+}
+
+// attachDecimals fills an update's API_V2 decimal fields from its rounded
+// doubles. Symbols without reference data have no scale and get none.
+func (h *MarketDataGRPCHandler) attachDecimals(update *proto.PriceUpdate) {
+	instrument, exists := h.priceInstrument(update.Symbol, update.Conversion)
+	if !exists {
+		return
+	}
+	price, size := services.PriceScale(instrument), services.SizeScale(instrument)
+
+	update.PriceDecimal = toDecimal(update.Price, price)
+	update.VolumeDecimal = toDecimal(update.Volume, size)
+	if change := update.ChangeInfo; change != nil {
+		change.ChangeAmountDecimal = toDecimal(change.ChangeAmount, price)
+		change.DailyHighDecimal = toDecimal(change.DailyHigh, price)
+		change.DailyLowDecimal = toDecimal(change.DailyLow, price)
+		change.DailyVolumeDecimal = toDecimal(change.DailyVolume, size)
+	}
+	// Only band events carry band prices
+	if event := update.Event; event != nil && event.BandHigh != 0 {
+		event.ReferencePriceDecimal = toDecimal(event.ReferencePrice, price)
+		event.BandLowDecimal = toDecimal(event.BandLow, price)
+		event.BandHighDecimal = toDecimal(event.BandHigh, price)
+	}
+	if quote := update.Quote; quote != nil {
+		quote.BidDecimal = toDecimal(quote.Bid, price)
+		quote.AskDecimal = toDecimal(quote.Ask, price)
+		quote.BidSizeDecimal = toDecimal(quote.BidSize, size)
+		quote.AskSizeDecimal = toDecimal(quote.AskSize, size)
+	}
+	if book := update.OrderBook; book != nil {
+		for _, levels := range [][]*proto.OrderBookLevel{book.Bids, book.Asks} {
+			for _, level := range levels {
+				level.PriceDecimal = toDecimal(level.Price, price)
+				level.SizeDecimal = toDecimal(level.Size, size)
+			}
+		}
+	}
+	if auction := update.Auction; auction != nil {
+		auction.IndicativePriceDecimal = toDecimal(auction.IndicativePrice, price)
+		auction.IndicativeVolumeDecimal = toDecimal(auction.IndicativeVolume, size)
+	}
+	for _, reference := range update.ReferencePrices {
+		reference.PriceDecimal = toDecimal(reference.Price, price)
+	}
+	if perpetual := update.Perpetual; perpetual != nil {
+		perpetual.MarkPriceDecimal = toDecimal(perpetual.MarkPrice, price)
+		perpetual.IndexPriceDecimal = h.priceDecimal(perpetual.IndexSymbol, perpetual.IndexPrice)
+	}
+	if future := update.Future; future != nil {
+		future.UnderlyingPriceDecimal = h.priceDecimal(future.UnderlyingSymbol, future.UnderlyingPrice)
+		if future.Settled {
+			future.SettlementPriceDecimal = toDecimal(future.SettlementPrice, price)
+		}
+	}
+	if option := update.Option; option != nil {
+		option.UnderlyingPriceDecimal = h.priceDecimal(option.UnderlyingSymbol, option.UnderlyingPrice)
+	}
+	if cross := update.Cross; cross != nil {
+		cross.TriangulatedPriceDecimal = toDecimal(cross.TriangulatedPrice, price)
+		cross.BaseFactorPriceDecimal = h.priceDecimal(cross.BaseFactorSymbol, cross.BaseFactorPrice)
+		cross.QuoteFactorPriceDecimal = h.priceDecimal(cross.QuoteFactorSymbol, cross.QuoteFactorPrice)
+	}
+	if update.Composite != nil {
+		for _, leg := range update.Composite.Legs {
+			leg.PriceDecimal = h.priceDecimal(leg.Symbol, leg.Price)
+		}
+	}
+}
+
+// attachPricePointDecimals fills simulated candles' API_V2 decimal fields
+func (h *MarketDataGRPCHandler) attachPricePointDecimals(symbol string, points []*proto.PricePoint) {
+	instrument, exists := h.instrument(symbol)
+	if !exists {
+		return
+	}
+	price, size := services.PriceScale(instrument), services.SizeScale(instrument)
+	for _, point := range points {
+		point.OpenDecimal = toDecimal(point.Open, price)
+		point.HighDecimal = toDecimal(point.High, price)
+		point.LowDecimal = toDecimal(point.Low, price)
+		point.CloseDecimal = toDecimal(point.Close, price)
+		point.VolumeDecimal = toDecimal(point.Volume, size)
+	}
+}
+
+// priceDecimal converts a price rounded for another symbol at that symbol's
+// scale, or returns nil when it has no reference data or no symbol
+func (h *MarketDataGRPCHandler) priceDecimal(symbol string, price float64) *proto.Decimal {
+	if symbol == "" {
+		return nil
+	}
+	if instrument, exists := h.instrument(symbol); exists {
+		return toDecimal(price, services.PriceScale(instrument))
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
+)
+
+func TestToDecimal(t *testing.T) {
+	assert.Equal(t, &proto.Decimal{Units: 30, Scale: 2}, toDecimal(0.1+0.2, 2))
+	assert.Equal(t, &proto.Decimal{Units: 5000001, Scale: 2}, toDecimal(50000.01, 2))
+	assert.Equal(t, &proto.Decimal{Units: 108543, Scale: 5}, toDecimal(1.08543, 5))
+	assert.Equal(t, &proto.Decimal{Units: -13, Scale: 1}, toDecimal(-1.3, 1))
+}
+
+func TestAttachDecimals(t *testing.T) {
+	handler := setupRoundingHandler()
+
+	update := &proto.PriceUpdate{
+		Symbol:     "BTC-USD",
+		Price:      100.3,
+		Volume:     12.34,
+		Quote:      &proto.Quote{Bid: 100.2, Ask: 100.4, BidSize: 1, AskSize: 2},
+		OrderBook:  &proto.OrderBook{Bids: []*proto.OrderBookLevel{{Price: 100.2, Size: 1}}},
+		ChangeInfo: &proto.PriceChangeInfo{ChangeAmount: -0.5, DailyHigh: 102.3, DailyLow: 98.3, DailyVolume: 1234},
+		Event:      &proto.MarketEvent{Type: proto.MarketEventType_LIMIT_REACHED, ReferencePrice: 100, BandLow: 95, BandHigh: 105},
+		Composite:  &proto.CompositeInfo{Legs: []*proto.CompositeLeg{{Symbol: "ETH-USD", Price: 3000.123}, {Symbol: "DOGE-USD", Price: 0.1}}},
+	}
+	handler.roundUpdate(update)
+	handler.attachDecimals(update)
+
+	assert.Equal(t, &proto.Decimal{Units: 1005, Scale: 1}, update.PriceDecimal)
+	assert.Equal(t, &proto.Decimal{Units: 123, Scale: 1}, update.VolumeDecimal)
+	assert.Equal(t, &proto.Decimal{Units: 1000, Scale: 1}, update.Quote.BidDecimal)
+	assert.Equal(t, &proto.Decimal{Units: 1005, Scale: 1}, update.Quote.AskDecimal)
+	assert.Equal(t, &proto.Decimal{Units: 10, Scale: 1}, update.Quote.BidSizeDecimal)
+	assert.Equal(t, &proto.Decimal{Units: 1000, Scale: 1}, update.OrderBook.Bids[0].PriceDecimal)
+
+	assert.Equal(t, &proto.Decimal{Units: -5, Scale: 1}, update.ChangeInfo.ChangeAmountDecimal)
+	assert.Equal(t, &proto.Decimal{Units: 1025, Scale: 1}, update.ChangeInfo.DailyHighDecimal)
+	assert.Equal(t, &proto.Decimal{Units: 985, Scale: 1}, update.ChangeInfo.DailyLowDecimal)
+	assert.Equal(t, &proto.Decimal{Units: 12340, Scale: 1}, update.ChangeInfo.DailyVolumeDecimal)
+	assert.Equal(t, &proto.Decimal{Units: 950, Scale: 1}, update.Event.BandLowDecimal)
+	assert.Equal(t, &proto.Decimal{Units: 1050, Scale: 1}, update.Event.BandHighDecimal)
+
+	// Legs take their own instrument's scale; legs without reference data get none
+	assert.Equal(t, &proto.Decimal{Units: 300012, Scale: 2}, update.Composite.Legs[0].PriceDecimal)
+	assert.Nil(t, update.Composite.Legs[1].PriceDecimal)
+}
+
+func TestMarketDataGRPCHandler_ReportTrade_APIVersion(t *testing.T) {
+	handler := setupRoundingHandler()
+	request := &proto.TradeReport{Symbol: "BTC-USD", Side: proto.TradeSide_BUY, Quantity: 10}
+
+	v1, err := handler.ReportTrade(context.Background(), request)
+	require.NoError(t, err)
+	assert.Nil(t, v1.PriceBeforeDecimal, "decimals are opt-in")
+
+	request.ApiVersion = proto.ApiVersion_API_V2
+	v2, err := handler.ReportTrade(context.Background(), request)
+	require.NoError(t, err)
+	assert.Equal(t, toDecimal(v2.PriceBefore, 1), v2.PriceBeforeDecimal)
+	assert.Equal(t, toDecimal(v2.PriceAfter, 1), v2.PriceAfterDecimal)
+}
+
+func TestMarketDataGRPCHandler_GetPrice_APIVersion(t *testing.T) {
+	handler := setupHandler()
+	ctx := context.Background()
+
+	v1, err := handler.GetPrice(ctx, &proto.GetPriceRequest{Symbol: "BTC-USD"})
+	require.NoError(t, err)
+	assert.Nil(t, v1.PriceDecimal, "decimals are opt-in")
+
+	v2, err := handler.GetPrice(ctx, &proto.GetPriceRequest{Symbol: "BTC-USD", ApiVersion: proto.ApiVersion_API_V2})
+	require.NoError(t, err)
+	require.NotNil(t, v2.PriceDecimal)
+	assert.Equal(t, int32(2), v2.PriceDecimal.Scale)
+	assert.Equal(t, toDecimal(v2.Price, 2).Units, v2.PriceDecimal.Units)
+
+	_, err = handler.GetPrice(ctx, &proto.GetPriceRequest{Symbol: "BTC-USD", ApiVersion: 9})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestMarketDataGRPCHandler_StreamPrices_APIVersion(t *testing.T) {
+	handler := setupHandler()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := newMockPriceStream(ctx)
+
+	go handler.StreamPrices(&proto.StreamPricesRequest{
+		Symbols:          []string{"BTC-USD"},
+		UpdateIntervalMs: 100,
+		ApiVersion:       proto.ApiVersion_API_V2,
+	}, stream)
+
+	require.Eventually(t, func() bool { return len(stream.Updates()) >= 2 }, 2*time.Second, 10*time.Millisecond)
+
+	for _, update := range stream.Updates() {
+		require.NotNil(t, update.PriceDecimal)
+		assert.Equal(t, toDecimal(update.Price, 2), update.PriceDecimal)
+		require.NotNil(t, update.VolumeDecimal)
+		assert.Equal(t, int32(4), update.VolumeDecimal.Scale)
+	}
+
+	err := handler.StreamPrices(&proto.StreamPricesRequest{Symbols: []string{"BTC-USD"}, ApiVersion: 9}, newMockPriceStream(ctx))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"math"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
+)
+
+// deliveryFilter applies a subscriber's delivery policy to generated ticks.
+// Suppressed ticks are counted per symbol and reported on the next update
+// that is delivered for that symbol (per venue for venue quotes).
+type deliveryFilter struct {
+	policy    proto.DeliveryPolicy
+	maxRate   time.Duration
+	threshold float64 // Percent
+	symbols   map[string]*symbolDelivery
+	conflated uint64 // Total suppressed over the session
+}
+
+type symbolDelivery struct {
+	lastDelivered time.Time
+	lastPrice     float64
+	suppressed    uint32
+}
+
+func newDeliveryFilter(policy proto.DeliveryPolicy, maxRateMs int32, thresholdPercent float64) (*deliveryFilter, error) {
+	filter := &deliveryFilter{
+		policy:  policy,
+		symbols: make(map[string]*symbolDelivery),
+	}
+
+	switch policy {
+	case proto.DeliveryPolicy_EVERY_TICK:
+	case proto.DeliveryPolicy_CONFLATED:
+		if maxRateMs <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "max_rate_ms must be positive for CONFLATED delivery")
+		}
+		filter.maxRate = time.Duration(maxRateMs) * time.Millisecond
+	case proto.DeliveryPolicy_ON_CHANGE:
+		if thresholdPercent <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "change_threshold_percent must be positive for ON_CHANGE delivery")
+		}
+		filter.threshold = thresholdPercent
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown delivery policy %v", policy)
+	}
+
+	return filter, nil
+}
+
+// admit reports whether an update should be sent. Admitted updates carry the
+// number of ticks suppressed for their symbol since the previous delivery.
+func (f *deliveryFilter) admit(update *proto.PriceUpdate, now time.Time) bool {
+	if f.policy == proto.DeliveryPolicy_EVERY_TICK {
+		return true
+	}
+
+	key := update.Symbol
+	if update.Venue != "" {
+		key += "@" + update.Venue
+	}
+	state, seen := f.symbols[key]
+	if !seen {
+		state = &symbolDelivery{}
+		f.symbols[key] = state
+	}
+
+	deliver := !seen
+	if seen {
+		switch f.policy {
+		case proto.DeliveryPolicy_CONFLATED:
+			deliver = now.Sub(state.lastDelivered) >= f.maxRate
+		case proto.DeliveryPolicy_ON_CHANGE:
+			deliver = movePercent(state.lastPrice, update.Price) >= f.threshold
+		}
+	}
+
+	if !deliver {
+		state.suppressed++
+		f.conflated++
+		return false
+	}
+
+	update.ConflatedCount = state.suppressed
+	state.suppressed = 0
+	state.lastDelivered = now
+	state.lastPrice = update.Price
+	return true
+}
+
+// movePercent is the size of a move relative to the size of the price it
+// started from, so spreads below zero move like any price. From exactly zero
+// there is no relative move: leaving zero counts as a full move, staying as
+// none.
+func movePercent(from, to float64) float64 {
+	if from == 0 {
+		if to == 0 {
+			return 0
+		}
+		return math.Inf(1)
+	}
+	return math.Abs(to-from) / math.Abs(from) * 100
+}
@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
+)
+
+func TestDeliveryFilter_EveryTick(t *testing.T) {
+	filter, err := newDeliveryFilter(proto.DeliveryPolicy_EVERY_TICK, 0, 0)
+	require.NoError(t, err)
+
+	now := time.Now()
+	for i := 0; i < 5; i++ {
+		update := &proto.PriceUpdate{Symbol: "BTC-USD", Price: 100}
+		assert.True(t, filter.admit(update, now))
+		assert.Equal(t, uint32(0), update.ConflatedCount)
+	}
+	assert.Equal(t, uint64(0), filter.conflated)
+}
+
+func TestDeliveryFilter_Conflated(t *testing.T) {
+	filter, err := newDeliveryFilter(proto.DeliveryPolicy_CONFLATED, 1000, 0)
+	require.NoError(t, err)
+
+	start := time.Now()
+	assert.True(t, filter.admit(&proto.PriceUpdate{Symbol: "BTC-USD", Price: 100}, start))
+
+	// Ticks within the window are conflated
+	for i := 1; i <= 4; i++ {
+		update := &proto.PriceUpdate{Symbol: "BTC-USD", Price: 100 + float64(i)}
+		assert.False(t, filter.admit(update, start.Add(time.Duration(i)*200*time.Millisecond)))
+	}
+
+	// Symbols are rate limited independently
+	assert.True(t, filter.admit(&proto.PriceUpdate{Symbol: "ETH-USD", Price: 10}, start.Add(500*time.Millisecond)))
+
+	latest := &proto.PriceUpdate{Symbol: "BTC-USD", Price: 105}
+	assert.True(t, filter.admit(latest, start.Add(time.Second)))
+	assert.Equal(t, uint32(4), latest.ConflatedCount)
+	assert.Equal(t, uint64(4), filter.conflated)
+}
+
+func TestDeliveryFilter_OnChange(t *testing.T) {
+	filter, err := newDeliveryFilter(proto.DeliveryPolicy_ON_CHANGE, 0, 1.0)
+	require.NoError(t, err)
+
+	now := time.Now()
+	assert.True(t, filter.admit(&proto.PriceUpdate{Symbol: "BTC-USD", Price: 100}, now))
+
+	// Small moves accumulate against the last delivered price
+	assert.False(t, filter.admit(&proto.PriceUpdate{Symbol: "BTC-USD", Price: 100.5}, now))
+	assert.False(t, filter.admit(&proto.PriceUpdate{Symbol: "BTC-USD", Price: 99.2}, now))
+
+	moved := &proto.PriceUpdate{Symbol: "BTC-USD", Price: 98.9}
+	assert.True(t, filter.admit(moved, now))
+	assert.Equal(t, uint32(2), moved.ConflatedCount)
+
+	// Threshold is now measured from 98.9
+	assert.False(t, filter.admit(&proto.PriceUpdate{Symbol: "BTC-USD", Price: 99.5}, now))
+}
+
+func TestDeliveryFilter_OnChange_Spread(t *testing.T) {
+	filter, err := newDeliveryFilter(proto.DeliveryPolicy_ON_CHANGE, 0, 1.0)
+	require.NoError(t, err)
+	spread := func(price float64) *proto.PriceUpdate {
+		return &proto.PriceUpdate{Symbol: "BTC-ETH-SPREAD", Price: price}
+	}
+
+	// Negative spreads move by their size like positive prices
+	now := time.Now()
+	assert.True(t, filter.admit(spread(-100), now))
+	assert.False(t, filter.admit(spread(-100.5), now))
+	assert.False(t, filter.admit(spread(-99.2), now))
+	assert.True(t, filter.admit(spread(-98.9), now))
+
+	// At zero only leaving it is a change
+	assert.True(t, filter.admit(spread(0), now))
+	assert.False(t, filter.admit(spread(0), now))
+	assert.True(t, filter.admit(spread(-0.01), now))
+	assert.False(t, filter.admit(spread(-0.01005), now))
+}
+
+func TestDeliveryFilter_InvalidParameters(t *testing.T) {
+	_, err := newDeliveryFilter(proto.DeliveryPolicy_CONFLATED, 0, 0)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = newDeliveryFilter(proto.DeliveryPolicy_ON_CHANGE, 0, 0)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = newDeliveryFilter(proto.DeliveryPolicy(42), 0, 0)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
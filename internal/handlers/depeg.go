@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
+)
+
+// Stablecoin depeg scenario, modelled on USDC in March 2023: pinned at the
+// peg, a sharp break, a choppy trough, then a recovery
+const (
+	depegPeg          = 1.0
+	depegDefaultDepth = 13.0 // Percent below the peg; USDC traded down to about 0.87
+	depegDefaultShare = 0.3  // Share of the scenario the event lasts when no duration is given
+	depegBreak        = 0.4  // Progress at which the peg breaks
+	depegLatestEnd    = 0.95 // The event ends by here, so the scenario closes pinned
+	depegCrashShare   = 0.15 // Share of the event spent falling to the trough, gradual onset
+	depegSuddenShare  = 0.02 // Same, sudden onset
+	depegRecoverShare = 0.4  // Share of the event spent recovering
+	depegPinnedNoise  = 0.0001
+	depegEventNoise   = 0.05 // Noise during the event as a fraction of the depth
+)
+
+// depegPrice is the pegged asset's price at a point of the scenario and the
+// liquidity around it. Volume and trading pick up and the book thins with
+// the distance from the peg.
+func depegPrice(progress float64, scenario time.Duration, params *proto.ScenarioParameters) (float64, liquidityConditions) {
+	depth := depegDefaultDepth / 100
+	if params != nil && params.DepegDepthPercent > 0 {
+		depth = math.Min(params.DepegDepthPercent, 100) / 100
+	}
+
+	severity, inEvent := depegSeverity(progress, scenario, params)
+	noise := depegPinnedNoise
+	if inEvent {
+		noise += depth * depegEventNoise * severity
+	}
+	price := depegPeg * (1 - depth*severity) * (1 + rand.NormFloat64()*noise)
+
+	return math.Max(price, 0), liquidityConditions{
+		depth:    1 / (1 + 9*severity),
+		spread:   1 + 19*severity,
+		activity: 1 + 9*severity,
+	}
+}
+
+// depegSeverity is how far below the peg the scenario is, as a fraction of
+// the depth: 0 on the peg, 1 at the trough and the unrecovered share after
+// a partial recovery. It also reports whether the event is under way.
+func depegSeverity(progress float64, scenario time.Duration, params *proto.ScenarioParameters) (float64, bool) {
+	length := depegDefaultShare
+	crashShare := depegCrashShare
+	recoverShare := depegRecoverShare
+	recovered := 1.0
+	if params != nil {
+		if params.DepegDurationMinutes > 0 && scenario > 0 {
+			length = (time.Duration(params.DepegDurationMinutes) * time.Minute).Seconds() / scenario.Seconds()
+		}
+		if !params.GradualTransition {
+			crashShare = depegSuddenShare
+		}
+		if params.RecoveryFactor > 0 {
+			// Faster recovery means a shorter recovery phase
+			recoverShare = math.Min(math.Max(depegRecoverShare/params.RecoveryFactor, 0.1), 0.8)
+		}
+		if params.DepegRecoveryPercent != nil {
+			recovered = math.Min(math.Max(*params.DepegRecoveryPercent, 0), 100) / 100
+		}
+	}
+
+	start := depegBreak
+	end := math.Min(start+length, depegLatestEnd)
+	crashEnd := start + (end-start)*crashShare
+	recoveryStart := end - (end-start)*recoverShare
+
+	switch {
+	case progress < start:
+		return 0, false
+	case progress < crashEnd:
+		return (progress - start) / (crashEnd - start), true
+	case progress < recoveryStart:
+		return 1, true
+	case progress < end:
+		return 1 - recovered*(progress-recoveryStart)/(end-recoveryStart), true
+	default:
+		return 1 - recovered, false
+	}
+}
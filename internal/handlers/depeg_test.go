@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
+)
+
+func TestDepegSeverity(t *testing.T) {
+	scenario := 100 * time.Minute
+	params := &proto.ScenarioParameters{GradualTransition: true, DepegDurationMinutes: 40}
+
+	severity := func(progress float64) float64 {
+		value, _ := depegSeverity(progress, scenario, params)
+		return value
+	}
+
+	// Pinned until the break at 40%, then a crash over 15% of the event
+	assert.Equal(t, 0.0, severity(0.2))
+	assert.InDelta(t, 0.5, severity(0.43), 1e-9)
+	assert.Equal(t, 1.0, severity(0.5), "trough")
+	assert.InDelta(t, 0.5, severity(0.72), 1e-9, "recovering over the last 40% of the event")
+	assert.Equal(t, 0.0, severity(0.9), "fully recovered")
+
+	// A partial recovery leaves the price below the peg
+	recovered := 60.0
+	params.DepegRecoveryPercent = &recovered
+	assert.InDelta(t, 0.4, severity(0.9), 1e-9)
+
+	// No recovery at all
+	recovered = 0
+	assert.Equal(t, 1.0, severity(0.9))
+
+	// Sudden onset breaks the peg almost at once
+	params.GradualTransition = false
+	assert.Equal(t, 1.0, severity(0.41))
+
+	// The event ends before the scenario does, however long it is
+	params.DepegDurationMinutes = 500
+	_, inEvent := depegSeverity(0.96, scenario, params)
+	assert.False(t, inEvent)
+}
+
+func TestMarketDataGRPCHandler_GenerateScenarioPrice_StablecoinDepeg(t *testing.T) {
+	handler := setupHandler()
+
+	startTime := time.Now()
+	endTime := startTime.Add(10 * time.Minute)
+	recovered := 100.0
+	params := &proto.ScenarioParameters{Intensity: 1, GradualTransition: true, DepegDepthPercent: 13, DepegRecoveryPercent: &recovered}
+
+	generate := func(progress float64) *proto.PriceUpdate {
+		at := startTime.Add(time.Duration(progress * float64(endTime.Sub(startTime))))
+		return handler.generateScenarioPrice("USDC-USD", proto.ScenarioType_STABLECOIN_DEPEG, params, 100, at, startTime, endTime)
+	}
+
+	// Pinned near 1.0 before the event, whatever the symbol's usual price,
+	// and still moving on the stablecoin's tick once rounded
+	pinned := make(map[float64]bool)
+	for progress := 0.0; progress < 0.4; progress += 0.01 {
+		update := generate(progress)
+		handler.roundUpdate(update)
+		assert.InDelta(t, 1.0, update.Price, 0.001)
+		pinned[update.Price] = true
+	}
+	assert.Greater(t, len(pinned), 1, "pinned prices are not rounded flat")
+
+	before := generate(0.1)
+	trough := generate(0.55)
+	assert.InDelta(t, 0.87, trough.Price, 0.03)
+	assert.Less(t, trough.ChangeInfo.ChangePercentage, -10.0)
+
+	// Volume and trading spike while the book thins and spreads widen
+	assert.Greater(t, trough.TradeCount, 3*before.TradeCount)
+	assert.Greater(t, trough.Volume, before.Volume)
+	assert.Less(t, trough.Quote.BidSize, before.Quote.BidSize/3)
+	assert.Greater(t, trough.Quote.SpreadBps, 10*before.Quote.SpreadBps)
+
+	// Back on the peg after a full recovery
+	after := generate(0.98)
+	assert.InDelta(t, 1.0, after.Price, 0.001)
+	assert.InDelta(t, before.Quote.SpreadBps, after.Quote.SpreadBps, 1e-9)
+}
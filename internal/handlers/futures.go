@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"math/rand"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/services"
+)
+
+// futureUpdate reports a contract priced off its underlying in the market
+// snapshot, or its settlement when that happened since the session's last
+// tick. Contracts settled before then return nil.
+func (h *MarketDataGRPCHandler) futureUpdate(symbol string, contract services.FutureContract, session *StreamSession, market services.MarketSnapshot, at time.Time) *proto.PriceUpdate {
+	tick := market.Ticks[symbol]
+	state := tick.Future
+
+	var event *proto.MarketEvent
+	if state.Settled {
+		settlements := market.EventsOf(symbol, services.EventSettlement)
+		if len(settlements) == 0 {
+			return nil
+		}
+		event = marketEvent(settlements[0])
+	}
+
+	info := &proto.FutureInfo{
+		UnderlyingSymbol:       contract.Series.Underlying,
+		UnderlyingPrice:        state.UnderlyingPrice,
+		Expiry:                 timestamppb.New(contract.Expiry),
+		DaysToExpiry:           max(0, contract.Expiry.Sub(at).Hours()/24),
+		BasisBps:               state.BasisBps,
+		AnnualizedBasisPercent: state.AnnualizedBasisPercent,
+		Settled:                state.Settled,
+	}
+
+	price := tick.Price
+	var volume float64
+	if state.Settled {
+		info.SettlementPrice = state.SettlementPrice
+	} else {
+		volume = 1000 + rand.Float64()*9000
+	}
+
+	update := &proto.PriceUpdate{
+		Symbol:    symbol,
+		Price:     price,
+		Volume:    volume,
+		Timestamp: timestamppb.New(at),
+		Source:    "market-data-simulator",
+		Event:     event,
+		ChangeInfo: &proto.PriceChangeInfo{
+			DailyHigh:   price * 1.02,
+			DailyLow:    price * 0.98,
+			DailyVolume: volume * 100,
+		},
+		Future: info,
+	}
+	if !info.Settled {
+		attachLiquidity(update, normalLiquidity, session.updateInterval)
+	}
+
+	return update
+}
@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/services"
+)
+
+func setupFutureHandler() *MarketDataGRPCHandler {
+	return setupHandlerWith(func(cfg *config.Config) {
+		cfg.Futures = []config.FutureSeries{{
+			Root:       "BTC-FUT",
+			Underlying: "BTC-USD",
+			Cycle:      config.FutureCycleMonthly,
+			Listed:     2,
+			Curve:      []config.CurvePoint{{Tenor: 30 * 24 * time.Hour, BasisPercent: 10}},
+		}}
+		cfg.FutureBasisNoiseBps = 5
+	})
+}
+
+func TestPublishTick_FutureSettlement(t *testing.T) {
+	handler := setupFutureHandler()
+
+	front := services.ListedContracts(handler.config.Futures[0], time.Now())[0]
+	session, published := publishTicks(t, handler, []string{front.Symbol}, front.Expiry.Add(-3*time.Second), 6)
+	assert.Equal(t, []string{"BTC-USD"}, session.legs)
+	require.Len(t, published, 4, "nothing is published after settlement")
+
+	// Seconds before expiry the contract trades at the underlying
+	last := published[2]
+	require.NotNil(t, last.Future)
+	assert.Equal(t, "BTC-USD", last.Future.UnderlyingSymbol)
+	assert.False(t, last.Future.Settled)
+	assert.InDelta(t, last.Future.UnderlyingPrice, last.Price, 0.011)
+	assert.Equal(t, front.Expiry, last.Future.Expiry.AsTime())
+
+	settlement := published[3]
+	require.NotNil(t, settlement.Event)
+	assert.Equal(t, proto.MarketEventType_SETTLEMENT, settlement.Event.Type)
+	assert.Contains(t, settlement.Event.Reason, services.ListedContracts(handler.config.Futures[0], front.Expiry)[0].Symbol)
+	assert.True(t, settlement.Future.Settled)
+	assert.Equal(t, settlement.Future.UnderlyingPrice, settlement.Future.SettlementPrice)
+	assert.Equal(t, settlement.Future.SettlementPrice, settlement.Price)
+	assert.Zero(t, settlement.Volume)
+	assert.Nil(t, settlement.Quote)
+}
+
+func TestPublishTick_FutureSettlement_Pattern(t *testing.T) {
+	handler := setupFutureHandler()
+
+	series := handler.config.Futures[0]
+	front := services.ListedContracts(series, time.Now())[0]
+	session, published := publishTicks(t, handler, []string{"BTC-FUT-*"}, front.Expiry.Add(-3*time.Second), 6)
+
+	var settlements []*proto.PriceUpdate
+	for _, update := range published {
+		if update.Event != nil && update.Event.Type == proto.MarketEventType_SETTLEMENT {
+			settlements = append(settlements, update)
+		}
+	}
+
+	// The expired contract settles once, then leaves the subscription
+	require.Len(t, settlements, 1)
+	assert.Equal(t, front.Symbol, settlements[0].Symbol)
+	assert.NotContains(t, session.symbols, front.Symbol)
+	assert.Empty(t, session.settled)
+	for _, contract := range services.ListedContracts(series, front.Expiry) {
+		assert.Contains(t, session.symbols, contract.Symbol)
+	}
+}
+
+func TestMarketDataGRPCHandler_StreamPrices_FutureCurve(t *testing.T) {
+	handler := setupFutureHandler()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := newMockPriceStream(ctx)
+
+	go handler.StreamPrices(&proto.StreamPricesRequest{
+		Symbols:          []string{"BTC-FUT-*"},
+		UpdateIntervalMs: 100,
+	}, stream)
+
+	require.Eventually(t, func() bool { return len(stream.Updates()) >= 2 }, 2*time.Second, 10*time.Millisecond)
+
+	listed := services.ListedContracts(handler.config.Futures[0], time.Now())
+	front, back := stream.Updates()[0], stream.Updates()[1]
+	assert.Equal(t, listed[0].Symbol, front.Symbol)
+	assert.Equal(t, listed[1].Symbol, back.Symbol)
+
+	// Both contracts are priced off the same underlying tick, along the curve
+	require.NotNil(t, front.Future)
+	require.NotNil(t, back.Future)
+	assert.Equal(t, front.Future.UnderlyingPrice, back.Future.UnderlyingPrice)
+	assert.Equal(t, 10.0, back.Future.AnnualizedBasisPercent)
+	assert.Greater(t, back.Future.DaysToExpiry, front.Future.DaysToExpiry)
+	assert.Greater(t, back.Price, back.Future.UnderlyingPrice)
+}
@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"math/rand"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/services"
+)
+
+// crossUpdate reports an FX cross priced off its factors in the market
+// snapshot, with its dislocation from the triangulated rate
+func (h *MarketDataGRPCHandler) crossUpdate(symbol string, cross services.FXCross, session *StreamSession, market services.MarketSnapshot, at time.Time) *proto.PriceUpdate {
+	tick := market.Ticks[symbol]
+	price := tick.Price
+
+	volume := 1000 + rand.Float64()*9000
+	update := &proto.PriceUpdate{
+		Symbol:    symbol,
+		Price:     price,
+		Volume:    volume,
+		Timestamp: timestamppb.New(at),
+		Source:    "market-data-simulator",
+		ChangeInfo: &proto.PriceChangeInfo{
+			DailyHigh:   price * 1.02,
+			DailyLow:    price * 0.98,
+			DailyVolume: volume * 100,
+		},
+		Cross: &proto.CrossInfo{
+			PivotCurrency:     cross.Pivot,
+			BaseFactorSymbol:  cross.Base.Factor,
+			BaseFactorPrice:   market.Price(cross.Base.Factor),
+			QuoteFactorSymbol: cross.Quote.Factor,
+			QuoteFactorPrice:  market.Price(cross.Quote.Factor),
+			TriangulatedPrice: tick.Cross.TriangulatedPrice,
+			DislocationBps:    tick.Cross.DislocationBps,
+		},
+	}
+	attachLiquidity(update, normalLiquidity, session.updateInterval)
+
+	return update
+}
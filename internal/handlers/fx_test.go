@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
+)
+
+func setupFXHandler(dislocationBps float64) *MarketDataGRPCHandler {
+	return setupHandlerWith(func(cfg *config.Config) {
+		cfg.FXPivot = "USD"
+		cfg.FXFactors = []string{"EUR-USD", "USD-JPY", "GBP-USD"}
+		cfg.FXCrosses = []string{"EUR-JPY", "GBP-JPY", "EUR-GBP"}
+		cfg.FXDislocationBps = dislocationBps
+		cfg.FXDislocationHalfLife = time.Second
+	})
+}
+
+// publishFXTicks subscribes a session to the symbols and returns the last
+// price published for each after a few ticks
+func publishFXTicks(t *testing.T, handler *MarketDataGRPCHandler, symbols []string) (*StreamSession, map[string]*proto.PriceUpdate) {
+	session, published := publishTicks(t, handler, symbols, time.Now(), 5)
+
+	last := make(map[string]*proto.PriceUpdate)
+	for _, update := range published {
+		last[update.Symbol] = update
+	}
+	return session, last
+}
+
+func TestPublishTick_FXCrossesTriangulate(t *testing.T) {
+	handler := setupFXHandler(0)
+
+	session, last := publishFXTicks(t, handler, []string{"EUR-JPY", "GBP-JPY", "EUR-GBP"})
+	assert.ElementsMatch(t, []string{"EUR-USD", "USD-JPY", "GBP-USD"}, session.legs, "factors walk unpublished")
+	require.Len(t, last, 3)
+
+	eurJPY := last["EUR-JPY"]
+	require.NotNil(t, eurJPY.Cross)
+	assert.Equal(t, "USD", eurJPY.Cross.PivotCurrency)
+	assert.Equal(t, "EUR-USD", eurJPY.Cross.BaseFactorSymbol)
+	assert.Equal(t, "USD-JPY", eurJPY.Cross.QuoteFactorSymbol)
+	assert.Zero(t, eurJPY.Cross.DislocationBps)
+	assert.Equal(t, eurJPY.Cross.TriangulatedPrice, eurJPY.Price)
+
+	// Crosses agree with each other to the tick: EUR-JPY = EUR-GBP × GBP-JPY
+	implied := session.market.Price("EUR-GBP") * session.market.Price("GBP-JPY")
+	assert.InDelta(t, session.market.Price("EUR-JPY"), implied, session.market.Price("EUR-JPY")*1e-12)
+	assert.InEpsilon(t, eurJPY.Price, last["EUR-GBP"].Price*last["GBP-JPY"].Price, 1e-5, "within the rounding of EUR-GBP")
+}
+
+func TestPublishTick_FXDislocation(t *testing.T) {
+	handler := setupFXHandler(5)
+
+	session, last := publishFXTicks(t, handler, []string{"EUR-USD", "USD-JPY", "EUR-JPY"})
+	assert.Empty(t, session.legs, "subscribed factors are published themselves")
+
+	cross := last["EUR-JPY"].Cross
+	require.NotNil(t, cross)
+	assert.NotZero(t, cross.DislocationBps)
+	assert.InDelta(t, session.market.Price("EUR-USD")*session.market.Price("USD-JPY")*(1+cross.DislocationBps/10000), session.market.Price("EUR-JPY"), 1e-6)
+	assert.Less(t, cross.DislocationBps, 50.0, "dislocations stay small")
+}
+
+func TestMarketDataGRPCHandler_GetPrice_FXCross(t *testing.T) {
+	handler := setupFXHandler(0)
+
+	resp, err := handler.GetPrice(context.Background(), &proto.GetPriceRequest{Symbol: "GBP-JPY"})
+	require.NoError(t, err)
+	// Factors start at plausible rates, so the cross does too
+	assert.InDelta(t, 1.27/0.0067, resp.Price, 0.00001)
+
+	instrument, err := handler.GetInstrument(context.Background(), &proto.GetInstrumentRequest{Symbol: "EUR-JPY"})
+	require.NoError(t, err)
+	assert.Equal(t, "fx", instrument.AssetClass)
+}
@@ -9,9 +9,12 @@ import (
 	"time"
 
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/domain/ports"
 	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
 	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/services"
 )
@@ -21,17 +24,91 @@ type MarketDataGRPCHandler struct {
 	config            *config.Config
 	logger            *logrus.Logger
 	marketDataService *services.MarketDataService
+	metrics           ports.MetricsPort // Optional; nil disables stream metrics
 	activeStreams     map[string]*StreamSession
+	retainedStreams   map[string]*StreamSession // Disconnected sessions awaiting resume
 	streamsMutex      sync.RWMutex
 }
 
+// priceSender is the common send side of every PriceUpdate stream
+type priceSender interface {
+	Send(*proto.PriceUpdate) error
+}
+
+// minUpdateInterval is the fastest tick rate offered to stream subscribers
+const minUpdateInterval = 100 * time.Millisecond
+
+// sessionTakeoverTimeout bounds how long a resume waits for a stale
+// connection of the same session to shut down
+const sessionTakeoverTimeout = 5 * time.Second
+
 type StreamSession struct {
-	symbols       []string
+	id             string
+	subscriptions  []string // Symbols, patterns and @groups as requested
+	symbols        []string // Concrete symbols the subscriptions resolve to
+	legs           []string // Composite legs, indices, underlyings, FX factors and conversion rates read but not published
 	updateInterval time.Duration
-	ctx           context.Context
-	cancel        context.CancelFunc
-	lastPrices    map[string]float64
-	startTime     time.Time
+	ctx            context.Context
+	cancel         context.CancelFunc
+	startTime      time.Time
+
+	// The shared market as of the tick being published, and the last price
+	// published per symbol that change info is measured from
+	market         services.MarketSnapshot
+	previousPrices map[string]float64
+	eventSequence  uint64 // Latest market event the session has seen
+
+	// Sequencing for gap detection and recovery
+	sequence        uint64
+	symbolSequences map[string]uint64
+	replay          *ReplayBuffer
+
+	delivery *deliveryFilter
+
+	// High-frequency sessions tick on a grid below the 100ms floor
+	highFrequency bool
+	nextTick      time.Time
+
+	// Outbound queue between the tick loop and the sender goroutine
+	out    *outboundQueue
+	method string // RPC name, used as a metrics label
+
+	// Trading phases of scheduled symbols as last reported
+	phases map[string]services.TradingPhase
+
+	// Expired contracts whose settlement the session has published
+	settled map[string]bool
+
+	venues   *venueQuotes // Venue and consolidated quoting; nil streams the fair value
+	watching []string     // Symbols whose venues the running session keeps simulated
+
+	apiVersion proto.ApiVersion // API_V2 sessions also get exact decimal fields
+
+	// Prices are converted into quoteCurrency when set, per symbol
+	quoteCurrency string
+	conversions   map[string]services.Conversion
+
+	// Pattern subscriptions re-resolve when the symbol universe changes
+	dynamic         bool
+	universeVersion uint64
+
+	// Resume support: done is closed once the current connection detaches
+	resumable  bool
+	done       chan struct{}
+	detachedAt time.Time
+}
+
+// stamp assigns the per-stream and per-symbol sequence numbers to an update
+// and records it for replay. Updates must be stamped in send order.
+func (s *StreamSession) stamp(update *proto.PriceUpdate) {
+	s.sequence++
+	s.symbolSequences[update.Symbol]++
+
+	update.SessionId = s.id
+	update.Sequence = s.sequence
+	update.SymbolSequence = s.symbolSequences[update.Symbol]
+
+	s.replay.Append(update)
 }
 
 func NewMarketDataGRPCHandler(cfg *config.Config, marketDataService *services.MarketDataService, logger *logrus.Logger) *MarketDataGRPCHandler {
@@ -40,91 +117,470 @@ func NewMarketDataGRPCHandler(cfg *config.Config, marketDataService *services.Ma
 		logger:            logger,
 		marketDataService: marketDataService,
 		activeStreams:     make(map[string]*StreamSession),
+		retainedStreams:   make(map[string]*StreamSession),
 	}
 }
 
+// NewMarketDataGRPCHandlerWithMetrics creates a handler that exports stream
+// backpressure metrics (queue depth, send lag, drops) through metricsPort
+func NewMarketDataGRPCHandlerWithMetrics(cfg *config.Config, marketDataService *services.MarketDataService, metricsPort ports.MetricsPort, logger *logrus.Logger) *MarketDataGRPCHandler {
+	handler := NewMarketDataGRPCHandler(cfg, marketDataService, logger)
+	handler.metrics = metricsPort
+	return handler
+}
+
 func (h *MarketDataGRPCHandler) GetPrice(ctx context.Context, req *proto.GetPriceRequest) (*proto.GetPriceResponse, error) {
 	h.logger.WithField("symbol", req.Symbol).Info("GetPrice request received")
 
-	price, err := h.marketDataService.GetPrice(req.Symbol)
+	if err := h.requireInstruments([]string{req.Symbol}); err != nil {
+		return nil, err
+	}
+	if err := checkAPIVersion(req.ApiVersion); err != nil {
+		return nil, err
+	}
+
+	conversion, err := h.conversion(req.Symbol, req.QuoteCurrency)
 	if err != nil {
-		h.logger.WithError(err).WithField("symbol", req.Symbol).Error("Failed to get price")
 		return nil, err
 	}
 
-	return &proto.GetPriceResponse{
-		Symbol:    req.Symbol,
-		Price:     price,
-		Timestamp: timestamppb.Now(),
-		Source:    "market-data-simulator",
-	}, nil
+	// The price and its conversion rates come from one reading of the shared market
+	h.marketDataService.Advance(time.Now())
+	symbols := []string{req.Symbol}
+	if conversion != nil {
+		symbols = append(symbols, conversion.RateSymbols()...)
+	}
+	market := h.marketDataService.Snapshot(symbols, h.marketDataService.EventSequence())
+	price := market.Price(req.Symbol)
+
+	var info *proto.ConversionInfo
+	if conversion != nil {
+		info = h.conversionInfo(*conversion, market.Price, req.Symbol, price)
+		price *= info.Rate
+	}
+
+	instrument, _ := h.priceInstrument(req.Symbol, info)
+	response := &proto.GetPriceResponse{
+		Symbol:     req.Symbol,
+		Price:      services.RoundPrice(instrument, price),
+		Timestamp:  timestamppb.Now(),
+		Source:     "market-data-simulator",
+		Conversion: info,
+	}
+	if req.ApiVersion == proto.ApiVersion_API_V2 {
+		response.PriceDecimal = toDecimal(response.Price, services.PriceScale(instrument))
+	}
+	return response, nil
 }
 
 func (h *MarketDataGRPCHandler) StreamPrices(req *proto.StreamPricesRequest, stream proto.MarketDataService_StreamPricesServer) error {
+	return h.servePriceStream(stream.Context(), "StreamPrices", req, sendEach(stream))
+}
+
+// servePriceStream starts or resumes a price stream session, writing its
+// updates through write until the client goes away
+func (h *MarketDataGRPCHandler) servePriceStream(streamCtx context.Context, method string, req *proto.StreamPricesRequest, write updateWriter) error {
+	ctx, cancel := context.WithCancel(streamCtx)
+
+	if req.ResumeSessionId != "" {
+		return h.resumePriceStream(ctx, cancel, req, write)
+	}
+
+	if err := h.requireInstruments(req.Symbols); err != nil {
+		cancel()
+		return err
+	}
+
 	sessionID := fmt.Sprintf("stream_%d", time.Now().UnixNano())
-	ctx, cancel := context.WithCancel(stream.Context())
 
-	updateInterval := time.Duration(req.UpdateIntervalMs) * time.Millisecond
-	if updateInterval < 100*time.Millisecond {
-		updateInterval = 100 * time.Millisecond // Minimum 100ms
+	updateInterval, highFrequency, err := h.negotiateInterval(ctx, req.UpdateIntervalMs, req.UpdateIntervalUs)
+	if err != nil {
+		cancel()
+		return err
 	}
 
-	session := &StreamSession{
-		symbols:        req.Symbols,
-		updateInterval: updateInterval,
-		ctx:           ctx,
-		cancel:        cancel,
-		lastPrices:    make(map[string]float64),
-		startTime:     time.Now(),
+	delivery, err := newDeliveryFilter(req.DeliveryPolicy, req.MaxRateMs, req.ChangeThresholdPercent)
+	if err != nil {
+		cancel()
+		return err
 	}
 
-	h.streamsMutex.Lock()
-	h.activeStreams[sessionID] = session
-	h.streamsMutex.Unlock()
+	if err := checkAPIVersion(req.ApiVersion); err != nil {
+		cancel()
+		return err
+	}
 
-	defer func() {
-		h.streamsMutex.Lock()
-		delete(h.activeStreams, sessionID)
-		h.streamsMutex.Unlock()
+	venues, err := newVenueQuotes(h.config, req.Venues, req.Consolidated, req.ReferencePrices)
+	if err != nil {
 		cancel()
-	}()
+		return err
+	}
+
+	session := h.newStreamSession(ctx, cancel, sessionID, req.Symbols, updateInterval)
+	session.resumable = true
+	session.highFrequency = highFrequency
+	session.delivery = delivery
+	session.venues = venues
+	session.apiVersion = req.ApiVersion
+	session.quoteCurrency = req.QuoteCurrency
+	session.method = method
+	if req.OverflowPolicy != proto.OverflowPolicy_OVERFLOW_DEFAULT {
+		session.out = newOutboundQueue(h.config.StreamQueueSize, req.OverflowPolicy)
+	}
+
+	// Resolve patterns and groups, seeding last prices
+	h.registerSymbols(req.Symbols)
+	if err := h.resolveSymbols(session); err != nil {
+		cancel()
+		return err
+	}
+
+	h.registerSession(session)
+	defer h.unregisterSession(session)
 
 	h.logger.WithFields(logrus.Fields{
 		"session_id": sessionID,
-		"symbols":    req.Symbols,
+		"symbols":    session.symbols,
 		"interval":   updateInterval,
+		"high_freq":  highFrequency,
+		"delivery":   req.DeliveryPolicy,
+		"venues":     req.Venues,
+		"overflow":   session.out.policy,
 	}).Info("Starting price stream")
 
-	// Initialize last prices
-	for _, symbol := range req.Symbols {
-		price, err := h.marketDataService.GetPrice(symbol)
-		if err != nil {
-			h.logger.WithError(err).WithField("symbol", symbol).Warn("Failed to get initial price")
-			price = 100.0 // Default price
+	return h.runPriceStream(session, write)
+}
+
+// batchSender is implemented by streams that can hold back flushing until
+// a whole batch of updates is written, such as the Connect adapters
+type batchSender interface {
+	SendBatch([]*proto.PriceUpdate) error
+}
+
+// sendEach writes updates one message at a time. A flushed batch is written
+// back to back so it goes onto the wire together: gRPC coalesces queued
+// messages into shared frames, and batch senders flush once per batch.
+func sendEach(sender priceSender) updateWriter {
+	if batched, ok := sender.(batchSender); ok {
+		return batched.SendBatch
+	}
+	return func(batch []*proto.PriceUpdate) error {
+		for _, update := range batch {
+			if err := sender.Send(update); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// resumePriceStream reattaches a retained session to a new connection,
+// replays every update after req.LastSequence and then continues live.
+func (h *MarketDataGRPCHandler) resumePriceStream(ctx context.Context, cancel context.CancelFunc, req *proto.StreamPricesRequest, write updateWriter) error {
+	session, err := h.attachSession(ctx, cancel, req.ResumeSessionId)
+	if err != nil {
+		cancel()
+		return err
+	}
+	defer h.unregisterSession(session)
+
+	missed, complete := session.replay.Range(req.LastSequence+1, 0)
+	if !complete {
+		return status.Errorf(codes.OutOfRange, "updates after sequence %d are no longer buffered (oldest available: %d)", req.LastSequence, session.replay.FirstSequence())
+	}
+
+	h.logger.WithFields(logrus.Fields{
+		"session_id":    session.id,
+		"last_sequence": req.LastSequence,
+		"replayed":      len(missed),
+	}).Info("Resuming price stream")
+
+	if len(missed) > 0 {
+		if err := write(missed); err != nil {
+			h.logger.WithError(err).WithField("session_id", session.id).Error("Failed to replay price updates")
+			return err
 		}
-		session.lastPrices[symbol] = price
 	}
 
-	ticker := time.NewTicker(updateInterval)
+	return h.runPriceStream(session, write)
+}
+
+func (h *MarketDataGRPCHandler) runPriceStream(session *StreamSession, write updateWriter) error {
+	session.nextTick = time.Time{} // Ticks missed while detached are not generated
+	ticker := time.NewTicker(session.wakeInterval())
 	defer ticker.Stop()
 
+	sendErr := h.startSender(session, write)
+	defer h.watchVenues(session, nil)
+
 	for {
 		select {
-		case <-ctx.Done():
-			h.logger.WithField("session_id", sessionID).Info("Stream context cancelled")
-			return ctx.Err()
-		case <-ticker.C:
-			for _, symbol := range req.Symbols {
-				priceUpdate := h.generatePriceUpdate(symbol, session)
-				if err := stream.Send(priceUpdate); err != nil {
-					h.logger.WithError(err).WithField("session_id", sessionID).Error("Failed to send price update")
+		case <-session.ctx.Done():
+			h.logger.WithFields(logrus.Fields{
+				"session_id": session.id,
+				"conflated":  session.delivery.conflated,
+				"dropped":    session.out.dropped,
+			}).Info("Stream context cancelled")
+			return session.ctx.Err()
+		case err := <-sendErr:
+			return err
+		case now := <-ticker.C:
+			if err := h.publishDueTicks(session, now); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// publishTick advances the shared market to the given time and generates
+// one update per subscribed symbol from it, queueing those that pass the
+// session's delivery policy
+func (h *MarketDataGRPCHandler) publishTick(session *StreamSession, at time.Time) error {
+	h.marketDataService.Advance(at)
+	if session.dynamic && h.marketDataService.Universe().Version() != session.universeVersion {
+		if err := h.resolveSymbols(session); err != nil {
+			return err
+		}
+	}
+
+	// Every update of the tick reads the same snapshot, legs and rates included
+	h.watchVenues(session, session.symbols)
+	watched := append(append([]string(nil), session.symbols...), session.legs...)
+	market := h.marketDataService.Snapshot(watched, session.eventSequence)
+	session.market = market
+	session.eventSequence = market.Sequence
+
+	settled := false
+	for _, symbol := range session.symbols {
+		if perpetual, exists := h.marketDataService.Perpetual(symbol); exists {
+			perpetualUpdate := h.finishUpdate(session, h.perpetualUpdate(symbol, perpetual, session, market, at))
+			// Funding settlements are always delivered
+			if perpetualUpdate.Event == nil && !session.delivery.admit(perpetualUpdate, at) {
+				continue
+			}
+			if err := h.publish(session, perpetualUpdate); err != nil {
+				return err
+			}
+			continue
+		}
+		if contract, exists := h.marketDataService.Future(symbol); exists {
+			futureUpdate := h.finishUpdate(session, h.futureUpdate(symbol, contract, session, market, at))
+			if futureUpdate == nil {
+				continue
+			}
+			// Settlements are always delivered
+			if futureUpdate.Event == nil && !session.delivery.admit(futureUpdate, at) {
+				continue
+			}
+			if err := h.publish(session, futureUpdate); err != nil {
+				return err
+			}
+			if futureUpdate.Event != nil && futureUpdate.Event.Type == proto.MarketEventType_SETTLEMENT {
+				session.settled[symbol] = true
+				settled = true
+			}
+			continue
+		}
+		if bond, exists := h.marketDataService.Bond(symbol); exists {
+			bondUpdate := h.finishUpdate(session, h.bondUpdate(symbol, bond, session, market, at))
+			// Rate shocks are always delivered
+			if bondUpdate.Event == nil && !session.delivery.admit(bondUpdate, at) {
+				continue
+			}
+			if err := h.publish(session, bondUpdate); err != nil {
+				return err
+			}
+			continue
+		}
+		if cross, exists := h.marketDataService.Cross(symbol); exists {
+			crossUpdate := h.finishUpdate(session, h.crossUpdate(symbol, cross, session, market, at))
+			if session.delivery.admit(crossUpdate, at) {
+				if err := h.publish(session, crossUpdate); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if composite, exists := h.marketDataService.Composite(symbol); exists {
+			compositeUpdate := h.finishUpdate(session, h.compositeUpdate(symbol, composite, session, market, at))
+			if session.delivery.admit(compositeUpdate, at) {
+				if err := h.publish(session, compositeUpdate); err != nil {
 					return err
 				}
 			}
+			continue
+		}
+
+		if contract, exists := h.marketDataService.Option(symbol); exists {
+			if !optionQuoting(market.Ticks[contract.Series.Underlying]) {
+				continue
+			}
+			optionUpdate := h.finishUpdate(session, h.optionUpdate(contract, market, at))
+			if session.delivery.admit(optionUpdate, at) {
+				if err := h.publish(session, optionUpdate); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		tick := market.Ticks[symbol]
+		if phaseChange := h.tradingPhase(session, symbol, tick, at); phaseChange != nil {
+			if err := h.publish(session, h.finishUpdate(session, phaseChange)); err != nil {
+				return err
+			}
+		}
+
+		switch {
+		case tick.Phase == services.PhaseClosed || tick.Phase == services.PhasePreOpen:
+			continue
+		case tick.Phase.IsAuction():
+			auctionUpdate := h.finishUpdate(session, h.auctionUpdate(symbol, tick, at))
+			if session.delivery.admit(auctionUpdate, at) {
+				if err := h.publish(session, auctionUpdate); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		// Status changes are always delivered
+		for _, event := range bandEvents(market, symbol) {
+			if err := h.publish(session, h.finishUpdate(session, h.bandEventUpdate(symbol, event, at))); err != nil {
+				return err
+			}
+		}
+		if tick.Status == services.StatusHalted {
+			continue
+		}
+
+		priceUpdate := h.finishUpdate(session, h.generatePriceUpdateAt(symbol, session, market, at))
+		if session.venues != nil {
+			if err := h.publishVenueQuotes(session, priceUpdate, at); err != nil {
+				return err
+			}
+			continue
+		}
+		if !session.delivery.admit(priceUpdate, at) {
+			continue
+		}
+		if err := h.publish(session, priceUpdate); err != nil {
+			return err
+		}
+	}
+
+	// Settled contracts leave pattern and group subscriptions once delivered
+	if session.dynamic && settled {
+		return h.resolveSymbols(session)
+	}
+	return nil
+}
+
+// resolveSymbols expands the session's subscriptions against the symbol
+// universe, dropping what it reported for symbols no longer covered
+func (h *MarketDataGRPCHandler) resolveSymbols(session *StreamSession) error {
+	universe := h.marketDataService.Universe()
+
+	// Read the version first so additions racing with Resolve trigger another pass
+	session.universeVersion = universe.Version()
+	session.dynamic = services.IsDynamicSubscription(session.subscriptions)
+
+	symbols, err := universe.Resolve(session.subscriptions)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid subscription: %v", err)
+	}
+
+	// Groups may name symbols that are not registered instruments. A
+	// contract that expired while streamed stays until its settlement is out.
+	resolved := make(map[string]bool, len(session.symbols))
+	if !session.market.At.IsZero() {
+		for _, symbol := range session.symbols {
+			resolved[symbol] = true
+		}
+	}
+	registered := symbols[:0]
+	for _, symbol := range symbols {
+		_, exists := h.marketDataService.Instrument(symbol)
+		if !exists && resolved[symbol] && !session.settled[symbol] {
+			_, exists = h.marketDataService.Future(symbol)
+		}
+		if exists {
+			registered = append(registered, symbol)
+		}
+	}
+
+	// Conversion rates are read like legs when not subscribed themselves.
+	// Only explicitly named symbols must convert; what patterns and groups
+	// match without a rate stays unconverted, so a growing universe cannot
+	// fail the stream.
+	explicit := make(map[string]bool, len(session.subscriptions))
+	for _, entry := range session.subscriptions {
+		if !services.IsDynamicSubscription([]string{entry}) {
+			explicit[entry] = true
+		}
+	}
+	conversions := make(map[string]services.Conversion)
+	var rates []string
+	for _, symbol := range registered {
+		conversion, err := h.conversion(symbol, session.quoteCurrency)
+		if err != nil {
+			if explicit[symbol] {
+				return err
+			}
+			continue
+		}
+		if conversion != nil {
+			conversions[symbol] = *conversion
+			rates = append(rates, conversion.RateSymbols()...)
+		}
+	}
+
+	symbols, legs := h.orderDerived(registered, rates)
+
+	covered := make(map[string]bool, len(symbols))
+	for _, symbol := range symbols {
+		covered[symbol] = true
+	}
+	for symbol := range session.previousPrices {
+		if !covered[symbol] {
+			delete(session.previousPrices, symbol)
+			delete(session.phases, symbol)
+		}
+	}
+	for symbol := range session.settled {
+		if !covered[symbol] {
+			delete(session.settled, symbol)
+		}
+	}
+
+	session.symbols = symbols
+	session.legs = legs
+	session.conversions = conversions
+	return nil
+}
+
+// registerSymbols adds explicitly requested symbols to the universe
+func (h *MarketDataGRPCHandler) registerSymbols(entries []string) {
+	for _, entry := range entries {
+		if services.IsDynamicSubscription([]string{entry}) {
+			continue
+		}
+		if err := h.marketDataService.Subscribe(entry); err != nil {
+			h.logger.WithError(err).WithField("symbol", entry).Warn("Failed to register symbol")
 		}
 	}
 }
 
+// clampUpdateInterval converts a requested interval to a duration,
+// enforcing the minimum streaming interval
+func clampUpdateInterval(intervalMs int32) time.Duration {
+	updateInterval := time.Duration(intervalMs) * time.Millisecond
+	if updateInterval < minUpdateInterval {
+		updateInterval = minUpdateInterval
+	}
+	return updateInterval
+}
+
 func (h *MarketDataGRPCHandler) GenerateSimulation(ctx context.Context, req *proto.SimulationRequest) (*proto.SimulationResponse, error) {
 	h.logger.WithFields(logrus.Fields{
 		"symbol":          req.Symbol,
@@ -133,6 +589,13 @@ func (h *MarketDataGRPCHandler) GenerateSimulation(ctx context.Context, req *pro
 		"end_time":        req.EndTime,
 	}).Info("GenerateSimulation request received")
 
+	if err := h.requireInstruments([]string{req.Symbol}); err != nil {
+		return nil, err
+	}
+	if err := checkAPIVersion(req.ApiVersion); err != nil {
+		return nil, err
+	}
+
 	// Generate historical data (mock)
 	historicalData := h.generateHistoricalData(req.Symbol, req.StartTime.AsTime(), req.EndTime.AsTime())
 
@@ -141,15 +604,21 @@ func (h *MarketDataGRPCHandler) GenerateSimulation(ctx context.Context, req *pro
 
 	// Calculate similarity metrics
 	metrics := h.calculateSimilarityMetrics(historicalData, simulatedData)
+	h.roundPricePoints(req.Symbol, historicalData)
+	h.roundPricePoints(req.Symbol, simulatedData)
+	if req.ApiVersion == proto.ApiVersion_API_V2 {
+		h.attachPricePointDecimals(req.Symbol, historicalData)
+		h.attachPricePointDecimals(req.Symbol, simulatedData)
+	}
 
 	simulationID := fmt.Sprintf("sim_%s_%d", req.Symbol, time.Now().Unix())
 
 	return &proto.SimulationResponse{
-		Symbol:           req.Symbol,
-		HistoricalData:   historicalData,
-		SimulatedData:    simulatedData,
+		Symbol:            req.Symbol,
+		HistoricalData:    historicalData,
+		SimulatedData:     simulatedData,
 		SimilarityMetrics: metrics,
-		SimulationId:     simulationID,
+		SimulationId:      simulationID,
 	}, nil
 }
 
@@ -160,11 +629,20 @@ func (h *MarketDataGRPCHandler) StreamScenario(req *proto.ScenarioRequest, strea
 		"duration":      req.DurationMinutes,
 	}).Info("Starting scenario stream")
 
-	ctx := stream.Context()
+	if err := h.requireInstruments([]string{req.Symbol}); err != nil {
+		return err
+	}
+
+	sessionID := fmt.Sprintf("scenario_%d", time.Now().UnixNano())
+	ctx, cancel := context.WithCancel(stream.Context())
 	startTime := req.StartTime.AsTime()
 	duration := time.Duration(req.DurationMinutes) * time.Minute
 	endTime := startTime.Add(duration)
 
+	session := h.newStreamSession(ctx, cancel, sessionID, []string{req.Symbol}, 1*time.Second)
+	h.registerSession(session)
+	defer h.unregisterSession(session)
+
 	// Get base price
 	basePrice, err := h.marketDataService.GetPrice(req.Symbol)
 	if err != nil {
@@ -181,6 +659,8 @@ func (h *MarketDataGRPCHandler) StreamScenario(req *proto.ScenarioRequest, strea
 			return ctx.Err()
 		case <-ticker.C:
 			priceUpdate := h.generateScenarioPrice(req.Symbol, req.ScenarioType, req.Parameters, basePrice, currentTime, startTime, endTime)
+			h.roundUpdate(priceUpdate)
+			session.stamp(priceUpdate)
 			if err := stream.Send(priceUpdate); err != nil {
 				return err
 			}
@@ -191,15 +671,95 @@ func (h *MarketDataGRPCHandler) StreamScenario(req *proto.ScenarioRequest, strea
 	return nil
 }
 
+func (h *MarketDataGRPCHandler) RecoverPriceUpdates(ctx context.Context, req *proto.RecoverPriceUpdatesRequest) (*proto.RecoverPriceUpdatesResponse, error) {
+	h.logger.WithFields(logrus.Fields{
+		"session_id":    req.SessionId,
+		"from_sequence": req.FromSequence,
+		"to_sequence":   req.ToSequence,
+	}).Info("RecoverPriceUpdates request received")
+
+	if req.ToSequence != 0 && req.FromSequence > req.ToSequence {
+		return nil, status.Errorf(codes.InvalidArgument, "from_sequence %d is after to_sequence %d", req.FromSequence, req.ToSequence)
+	}
+
+	session, exists := h.lookupSession(req.SessionId)
+	if !exists {
+		return nil, status.Errorf(codes.NotFound, "stream session %s not found", req.SessionId)
+	}
+
+	updates, complete := session.replay.Range(req.FromSequence, req.ToSequence)
+
+	return &proto.RecoverPriceUpdatesResponse{
+		SessionId:              req.SessionId,
+		Updates:                updates,
+		FirstAvailableSequence: session.replay.FirstSequence(),
+		LastSequence:           session.replay.LastSequence(),
+		Complete:               complete,
+	}, nil
+}
+
+func (h *MarketDataGRPCHandler) ReportTrade(ctx context.Context, req *proto.TradeReport) (*proto.TradeReportResponse, error) {
+	h.logger.WithFields(logrus.Fields{
+		"symbol":   req.Symbol,
+		"side":     req.Side,
+		"quantity": req.Quantity,
+		"trade_id": req.TradeId,
+		"source":   req.Source,
+	}).Info("ReportTrade request received")
+
+	if req.Symbol == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "symbol is required")
+	}
+	if err := h.requireInstruments([]string{req.Symbol}); err != nil {
+		return nil, err
+	}
+	if !(req.Quantity > 0) || math.IsInf(req.Quantity, 0) {
+		return nil, status.Errorf(codes.InvalidArgument, "quantity must be a positive number")
+	}
+	if req.Side != proto.TradeSide_BUY && req.Side != proto.TradeSide_SELL {
+		return nil, status.Errorf(codes.InvalidArgument, "side must be BUY or SELL")
+	}
+	if err := checkAPIVersion(req.ApiVersion); err != nil {
+		return nil, err
+	}
+
+	priceBefore, err := h.marketDataService.GetPrice(req.Symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	impact := h.marketDataService.ReportTrade(services.Trade{
+		Symbol:   req.Symbol,
+		Quantity: req.Quantity,
+		Buy:      req.Side == proto.TradeSide_BUY,
+	})
+
+	response := &proto.TradeReportResponse{
+		Symbol:             req.Symbol,
+		PriceBefore:        h.roundPrice(req.Symbol, priceBefore),
+		PriceAfter:         h.roundPrice(req.Symbol, priceBefore*math.Exp(impact.LevelAfter-impact.LevelBefore)),
+		PermanentImpactBps: impact.Permanent * 10000,
+		TemporaryImpactBps: impact.Temporary * 10000,
+	}
+	if req.ApiVersion == proto.ApiVersion_API_V2 {
+		response.PriceBeforeDecimal = h.priceDecimal(req.Symbol, response.PriceBefore)
+		response.PriceAfterDecimal = h.priceDecimal(req.Symbol, response.PriceAfter)
+	}
+	return response, nil
+}
+
 func (h *MarketDataGRPCHandler) HealthCheck(ctx context.Context, req *proto.HealthCheckRequest) (*proto.HealthCheckResponse, error) {
 	status := proto.HealthStatus_SERVING
 	message := "Market Data Service is healthy"
 
+	h.streamsMutex.RLock()
 	details := map[string]string{
-		"service_name":    h.config.ServiceName,
-		"service_version": h.config.ServiceVersion,
-		"active_streams":  fmt.Sprintf("%d", len(h.activeStreams)),
+		"service_name":     h.config.ServiceName,
+		"service_version":  h.config.ServiceVersion,
+		"active_streams":   fmt.Sprintf("%d", len(h.activeStreams)),
+		"retained_streams": fmt.Sprintf("%d", len(h.retainedStreams)),
 	}
+	h.streamsMutex.RUnlock()
 
 	return &proto.HealthCheckResponse{
 		Status:    status,
@@ -209,35 +769,184 @@ func (h *MarketDataGRPCHandler) HealthCheck(ctx context.Context, req *proto.Heal
 	}, nil
 }
 
+func (h *MarketDataGRPCHandler) newStreamSession(ctx context.Context, cancel context.CancelFunc, id string, subscriptions []string, updateInterval time.Duration) *StreamSession {
+	return &StreamSession{
+		id:              id,
+		subscriptions:   subscriptions,
+		symbols:         subscriptions,
+		updateInterval:  updateInterval,
+		ctx:             ctx,
+		cancel:          cancel,
+		startTime:       time.Now(),
+		previousPrices:  make(map[string]float64),
+		eventSequence:   h.marketDataService.EventSequence(),
+		symbolSequences: make(map[string]uint64),
+		phases:          make(map[string]services.TradingPhase),
+		settled:         make(map[string]bool),
+		replay:          NewReplayBuffer(h.config.ReplayBufferSize),
+		delivery:        &deliveryFilter{policy: proto.DeliveryPolicy_EVERY_TICK},
+		out:             newOutboundQueue(h.config.StreamQueueSize, parseOverflowPolicy(h.config.StreamOverflowPolicy)),
+		done:            make(chan struct{}),
+	}
+}
+
+func (h *MarketDataGRPCHandler) registerSession(session *StreamSession) {
+	h.streamsMutex.Lock()
+	h.activeStreams[session.id] = session
+	h.streamsMutex.Unlock()
+}
+
+// unregisterSession detaches a session from its connection. Resumable
+// sessions are retained for the configured window so a reconnecting client
+// can pick up where it left off.
+func (h *MarketDataGRPCHandler) unregisterSession(session *StreamSession) {
+	session.cancel()
+
+	h.streamsMutex.Lock()
+	delete(h.activeStreams, session.id)
+	if session.resumable && h.config.StreamRetentionWindow > 0 {
+		session.detachedAt = time.Now()
+		h.retainedStreams[session.id] = session
+	}
+	h.purgeExpiredSessionsLocked()
+	h.streamsMutex.Unlock()
+
+	close(session.done)
+}
+
+// attachSession moves a retained session back to active under a new
+// connection. If the previous connection is still registered (the server has
+// not noticed the drop yet) it is cancelled and taken over.
+func (h *MarketDataGRPCHandler) attachSession(ctx context.Context, cancel context.CancelFunc, sessionID string) (*StreamSession, error) {
+	h.streamsMutex.RLock()
+	stale, active := h.activeStreams[sessionID]
+	h.streamsMutex.RUnlock()
+
+	if active {
+		stale.cancel()
+		select {
+		case <-stale.done:
+		case <-time.After(sessionTakeoverTimeout):
+			return nil, status.Errorf(codes.Aborted, "stream session %s is still attached to another connection", sessionID)
+		}
+	}
+
+	h.streamsMutex.Lock()
+	defer h.streamsMutex.Unlock()
+
+	h.purgeExpiredSessionsLocked()
+	session, exists := h.retainedStreams[sessionID]
+	if !exists {
+		return nil, status.Errorf(codes.NotFound, "stream session %s not found or retention window expired", sessionID)
+	}
+
+	delete(h.retainedStreams, sessionID)
+	session.ctx = ctx
+	session.cancel = cancel
+	session.done = make(chan struct{})
+	session.out = newOutboundQueue(session.out.capacity, session.out.policy) // Anything left queued is in the replay buffer
+	session.detachedAt = time.Time{}
+	h.activeStreams[sessionID] = session
+
+	return session, nil
+}
+
+// lookupSession finds a session that is either live or retained for resume
+func (h *MarketDataGRPCHandler) lookupSession(sessionID string) (*StreamSession, bool) {
+	h.streamsMutex.Lock()
+	defer h.streamsMutex.Unlock()
+
+	if session, exists := h.activeStreams[sessionID]; exists {
+		return session, true
+	}
+
+	h.purgeExpiredSessionsLocked()
+	session, exists := h.retainedStreams[sessionID]
+	return session, exists
+}
+
+// purgeExpiredSessionsLocked drops retained sessions past the retention
+// window. Callers must hold streamsMutex for writing.
+func (h *MarketDataGRPCHandler) purgeExpiredSessionsLocked() {
+	cutoff := time.Now().Add(-h.config.StreamRetentionWindow)
+	for id, session := range h.retainedStreams {
+		if session.detachedAt.Before(cutoff) {
+			delete(h.retainedStreams, id)
+		}
+	}
+}
+
+// generatePriceUpdate advances the shared market to now and reports the
+// symbol from it
 func (h *MarketDataGRPCHandler) generatePriceUpdate(symbol string, session *StreamSession) *proto.PriceUpdate {
-	lastPrice := session.lastPrices[symbol]
+	now := time.Now()
+	h.marketDataService.Advance(now)
+	return h.finishUpdate(session, h.generatePriceUpdateAt(symbol, session, h.marketDataService.Snapshot([]string{symbol}, session.eventSequence), now))
+}
 
-	// Generate realistic price movement (within 0.5% range)
-	changePercent := (rand.Float64() - 0.5) * 0.01 // -0.5% to +0.5%
-	newPrice := lastPrice * (1 + changePercent)
+// generatePriceUpdateAt reports a regular symbol's price in the market
+// snapshot for a tick at the given time
+func (h *MarketDataGRPCHandler) generatePriceUpdateAt(symbol string, session *StreamSession, market services.MarketSnapshot, at time.Time) *proto.PriceUpdate {
+	tick := market.Ticks[symbol]
+	newPrice := tick.Price
 
 	// Generate volume (between 1000 and 10000)
 	volume := 1000 + rand.Float64()*9000
 
-	changeAmount := newPrice - lastPrice
-	changePercentage := (changeAmount / lastPrice) * 100
+	update := &proto.PriceUpdate{
+		Symbol:        symbol,
+		Price:         newPrice,
+		Volume:        volume,
+		Timestamp:     timestamppb.New(at),
+		Source:        "market-data-simulator",
+		TradingStatus: proto.TradingStatus(tick.Status),
+		ChangeInfo: &proto.PriceChangeInfo{
+			DailyHigh:   newPrice * 1.02,
+			DailyLow:    newPrice * 0.98,
+			DailyVolume: volume * 100,
+		},
+	}
+	attachLiquidity(update, normalLiquidity, session.updateInterval)
+
+	return update
+}
 
-	session.lastPrices[symbol] = newPrice
+// previous returns the price last reported for a symbol, or price itself
+// the first time, and records price as the last reported
+func (s *StreamSession) previous(symbol string, price float64) float64 {
+	previous, published := s.previousPrices[symbol]
+	s.previousPrices[symbol] = price
+	if !published {
+		return price
+	}
+	return previous
+}
 
+// marketEventUpdate reports a status change without a new trade
+func (h *MarketDataGRPCHandler) marketEventUpdate(symbol string, price float64, status proto.TradingStatus, event *proto.MarketEvent, at time.Time) *proto.PriceUpdate {
 	return &proto.PriceUpdate{
-		Symbol:    symbol,
-		Price:     newPrice,
-		Volume:    volume,
-		Timestamp: timestamppb.Now(),
-		Source:    "market-data-simulator",
-		ChangeInfo: &proto.PriceChangeInfo{
-			ChangeAmount:     changeAmount,
-			ChangePercentage: changePercentage,
-			DailyHigh:        newPrice * 1.02,
-			DailyLow:         newPrice * 0.98,
-			DailyVolume:      volume * 100,
-		},
+		Symbol:        symbol,
+		Price:         price,
+		Timestamp:     timestamppb.New(at),
+		Source:        "market-data-simulator",
+		TradingStatus: status,
+		Event:         event,
+	}
+}
+
+// marketEvent converts an event of the shared market
+func marketEvent(event services.MarketEvent) *proto.MarketEvent {
+	converted := &proto.MarketEvent{
+		Type:           proto.MarketEventType(event.Type),
+		Reason:         event.Reason,
+		ReferencePrice: event.ReferencePrice,
+		BandLow:        event.BandLow,
+		BandHigh:       event.BandHigh,
 	}
+	if !event.ResumeAt.IsZero() {
+		converted.ResumeAt = timestamppb.New(event.ResumeAt)
+	}
+	return converted
 }
 
 func (h *MarketDataGRPCHandler) generateHistoricalData(symbol string, start, end time.Time) []*proto.PricePoint {
@@ -285,7 +994,7 @@ func (h *MarketDataGRPCHandler) generateSimulatedData(historicalData []*proto.Pr
 			// More complex Monte Carlo simulation
 			drift := 0.001
 			diffusion := 0.02 * volatilityFactor
-			simulatedPrice = historical.Close * math.Exp(drift + diffusion*rand.NormFloat64())
+			simulatedPrice = historical.Close * math.Exp(drift+diffusion*rand.NormFloat64())
 		default:
 			simulatedPrice = historical.Close
 		}
@@ -318,6 +1027,7 @@ func (h *MarketDataGRPCHandler) generateScenarioPrice(symbol string, scenarioTyp
 	}
 
 	var priceMultiplier float64 = 1.0
+	liquidity := normalLiquidity
 
 	switch scenarioType {
 	case proto.ScenarioType_RALLY:
@@ -333,12 +1043,20 @@ func (h *MarketDataGRPCHandler) generateScenarioPrice(symbol string, scenarioTyp
 		// Returns to baseline over time
 		deviation := (intensity - 1.0) * 0.2 * math.Sin(progress*math.Pi*2)
 		priceMultiplier = 1.0 + deviation*math.Exp(-progress*3)
+	case proto.ScenarioType_LIQUIDITY_DROUGHT:
+		// The book thins out while the mid only drifts modestly
+		liquidity = droughtConditions(progress, params)
+		priceMultiplier = 1.0 + 0.002*intensity*droughtSeverity(progress, params)*math.Sin(progress*math.Pi*8)
+	case proto.ScenarioType_STABLECOIN_DEPEG:
+		// Pegged assets trade around the peg whatever the symbol's usual price
+		basePrice = depegPeg
+		priceMultiplier, liquidity = depegPrice(progress, endTime.Sub(startTime), params)
 	}
 
 	finalPrice := basePrice * priceMultiplier
-	volume := 1000 + rand.Float64()*9000*intensity
+	volume := (1000 + rand.Float64()*9000*intensity) * liquidity.activity
 
-	return &proto.PriceUpdate{
+	update := &proto.PriceUpdate{
 		Symbol:    symbol,
 		Price:     finalPrice,
 		Volume:    volume,
@@ -352,12 +1070,15 @@ func (h *MarketDataGRPCHandler) generateScenarioPrice(symbol string, scenarioTyp
 			DailyVolume:      volume * 100,
 		},
 	}
+	attachLiquidity(update, liquidity, time.Second)
+
+	return update
 }
 
 func (h *MarketDataGRPCHandler) calculateSimilarityMetrics(historical, simulated []*proto.PricePoint) *proto.StatisticalMetrics {
 	if len(historical) == 0 || len(simulated) == 0 {
 		return &proto.StatisticalMetrics{
-			CorrelationCoefficient:        0.0,
+			CorrelationCoefficient:       0.0,
 			VolatilitySimilarity:         0.0,
 			ReturnDistributionSimilarity: 0.0,
 			TrendSimilarity:              0.0,
@@ -366,18 +1087,18 @@ func (h *MarketDataGRPCHandler) calculateSimilarityMetrics(historical, simulated
 	}
 
 	// Calculate simple correlation (mock implementation)
-	correlation := 0.85 + rand.Float64()*0.1 // 0.85-0.95
+	correlation := 0.85 + rand.Float64()*0.1           // 0.85-0.95
 	volatilitySimilarity := 0.80 + rand.Float64()*0.15 // 0.80-0.95
-	returnSimilarity := 0.75 + rand.Float64()*0.20 // 0.75-0.95
-	trendSimilarity := 0.82 + rand.Float64()*0.13 // 0.82-0.95
+	returnSimilarity := 0.75 + rand.Float64()*0.20     // 0.75-0.95
+	trendSimilarity := 0.82 + rand.Float64()*0.13      // 0.82-0.95
 
 	confidenceScore := (correlation + volatilitySimilarity + returnSimilarity + trendSimilarity) / 4.0
 
 	return &proto.StatisticalMetrics{
-		CorrelationCoefficient:        correlation,
+		CorrelationCoefficient:       correlation,
 		VolatilitySimilarity:         volatilitySimilarity,
 		ReturnDistributionSimilarity: returnSimilarity,
 		TrendSimilarity:              trendSimilarity,
 		ConfidenceScore:              confidenceScore,
 	}
-}
\ No newline at end of file
+}
@@ -2,12 +2,17 @@ package handlers
 
 import (
 	"context"
+	"net/http"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
@@ -16,6 +21,12 @@ import (
 )
 
 func setupHandler() *MarketDataGRPCHandler {
+	return setupHandlerWith(func(*config.Config) {})
+}
+
+// setupHandlerWith builds a handler over its own market data service, with
+// the test configuration adjusted by configure
+func setupHandlerWith(configure func(*config.Config)) *MarketDataGRPCHandler {
 	cfg := &config.Config{
 		ServiceName:    "market-data-simulator",
 		ServiceVersion: "1.0.0",
@@ -23,7 +34,9 @@ func setupHandler() *MarketDataGRPCHandler {
 		HTTPPort:       8080,
 		LogLevel:       "info",
 		RedisURL:       "redis://localhost:6379",
+		Instruments:    testInstruments(),
 	}
+	configure(cfg)
 
 	logger := logrus.New()
 	logger.SetLevel(logrus.WarnLevel) // Reduce log noise in tests
@@ -32,6 +45,73 @@ func setupHandler() *MarketDataGRPCHandler {
 	return NewMarketDataGRPCHandler(cfg, marketDataService, logger)
 }
 
+// publishTicks subscribes a session to the symbols and publishes n ticks a
+// second apart from start, returning the session and every update published
+func publishTicks(t *testing.T, handler *MarketDataGRPCHandler, symbols []string, start time.Time, n int) (*StreamSession, []*proto.PriceUpdate) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	session := handler.newStreamSession(ctx, cancel, "publish_test", symbols, time.Second)
+	require.NoError(t, handler.resolveSymbols(session))
+	for i := 0; i < n; i++ {
+		require.NoError(t, handler.publishTick(session, start.Add(time.Duration(i)*time.Second)))
+	}
+	session.out.flush()
+
+	var published []*proto.PriceUpdate
+	for _, batch := range session.out.drain() {
+		published = append(published, batch...)
+	}
+	return session, published
+}
+
+// testInstruments registers the symbols used across handler tests
+func testInstruments() []config.Instrument {
+	var instruments []config.Instrument
+	for _, symbol := range []string{"BTC-USD", "ETH-USD", "SOL-USD", "ETH-BTC", "BTC-EUR", "BTC/USD", "ETH/USD", "BTC/EUR", "ADA/BTC", "USDC-USD"} {
+		instruments = append(instruments, services.InferInstrument(symbol))
+	}
+	return instruments
+}
+
+// mockPriceStream collects updates sent on a server stream
+type mockPriceStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	mu      sync.Mutex
+	updates []*proto.PriceUpdate
+	blocked chan struct{} // When set, Send stalls until closed to simulate a slow client
+}
+
+func newMockPriceStream(ctx context.Context) *mockPriceStream {
+	return &mockPriceStream{ctx: ctx}
+}
+
+func (m *mockPriceStream) Send(update *proto.PriceUpdate) error {
+	if m.blocked != nil {
+		select {
+		case <-m.blocked:
+		case <-m.ctx.Done():
+			return m.ctx.Err()
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.updates = append(m.updates, update)
+	return nil
+}
+
+func (m *mockPriceStream) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockPriceStream) Updates() []*proto.PriceUpdate {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*proto.PriceUpdate(nil), m.updates...)
+}
+
 func TestMarketDataGRPCHandler_Creation(t *testing.T) {
 	handler := setupHandler()
 
@@ -64,7 +144,7 @@ func TestMarketDataGRPCHandler_GetPrice_DifferentSymbols(t *testing.T) {
 	handler := setupHandler()
 	ctx := context.Background()
 
-	symbols := []string{"ETH/USD", "BTC/EUR", "ADA/BTC", "INVALID_SYMBOL"}
+	symbols := []string{"ETH/USD", "BTC/EUR", "ADA/BTC", "USDC-USD"}
 
 	for _, symbol := range symbols {
 		req := &proto.GetPriceRequest{Symbol: symbol}
@@ -75,6 +155,10 @@ func TestMarketDataGRPCHandler_GetPrice_DifferentSymbols(t *testing.T) {
 		assert.Equal(t, symbol, resp.Symbol)
 		assert.Greater(t, resp.Price, 0.0)
 	}
+
+	// Symbols outside the instrument registry are rejected
+	_, err := handler.GetPrice(ctx, &proto.GetPriceRequest{Symbol: "INVALID_SYMBOL"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
 }
 
 func TestMarketDataGRPCHandler_HealthCheck(t *testing.T) {
@@ -195,14 +279,12 @@ func TestMarketDataGRPCHandler_GenerateSimulation_DifferentTypes(t *testing.T) {
 
 func TestMarketDataGRPCHandler_GeneratePriceUpdate(t *testing.T) {
 	handler := setupHandler()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 
-	originalPrice := 50000.0
-	session := &StreamSession{
-		symbols:        []string{"BTC/USD"},
-		updateInterval: 1 * time.Second,
-		lastPrices:     map[string]float64{"BTC/USD": originalPrice},
-		startTime:      time.Now(),
-	}
+	session := handler.newStreamSession(ctx, cancel, "generate_test", []string{"BTC/USD"}, time.Second)
+	first := handler.generatePriceUpdate("BTC/USD", session)
+	originalPrice := first.Price
 
 	update := handler.generatePriceUpdate("BTC/USD", session)
 
@@ -219,14 +301,16 @@ func TestMarketDataGRPCHandler_GeneratePriceUpdate(t *testing.T) {
 	assert.Greater(t, priceChange, -0.01) // Not more than 1% down
 	assert.Less(t, priceChange, 0.01)     // Not more than 1% up
 
-	// Verify change info is calculated correctly
+	// Verify change info is calculated from the previous update
 	expectedChange := update.Price - originalPrice
 	expectedChangePercent := (expectedChange / originalPrice) * 100
 	assert.InDelta(t, expectedChange, update.ChangeInfo.ChangeAmount, 0.001)
 	assert.InDelta(t, expectedChangePercent, update.ChangeInfo.ChangePercentage, 0.001)
 
-	// Verify the session was updated with the new price
-	assert.Equal(t, update.Price, session.lastPrices["BTC/USD"])
+	// Verify the price is the shared market's, rounded to the tick
+	price, err := handler.marketDataService.GetPrice("BTC/USD")
+	require.NoError(t, err)
+	assert.Equal(t, handler.roundPrice("BTC/USD", price), update.Price)
 }
 
 func TestMarketDataGRPCHandler_GenerateScenarioPrice(t *testing.T) {
@@ -244,6 +328,8 @@ func TestMarketDataGRPCHandler_GenerateScenarioPrice(t *testing.T) {
 		proto.ScenarioType_MEAN_REVERTING,
 		proto.ScenarioType_VOLATILITY_SPIKE,
 		proto.ScenarioType_CONSOLIDATION,
+		proto.ScenarioType_LIQUIDITY_DROUGHT,
+		proto.ScenarioType_STABLECOIN_DEPEG,
 	}
 
 	for _, scenario := range scenarios {
@@ -320,3 +406,379 @@ func TestMarketDataGRPCHandler_CalculateSimilarityMetrics_EmptyData(t *testing.T
 	assert.Equal(t, 0.0, metrics.TrendSimilarity)
 	assert.Equal(t, 0.0, metrics.ConfidenceScore)
 }
+
+func TestMarketDataGRPCHandler_StreamPrices_Sequencing(t *testing.T) {
+	handler := setupHandler()
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := newMockPriceStream(ctx)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- handler.StreamPrices(&proto.StreamPricesRequest{
+			Symbols:          []string{"BTC/USD", "ETH/USD"},
+			UpdateIntervalMs: 100,
+		}, stream)
+	}()
+
+	require.Eventually(t, func() bool { return len(stream.Updates()) >= 6 }, 2*time.Second, 10*time.Millisecond)
+	cancel()
+	<-done
+
+	updates := stream.Updates()
+	sessionID := updates[0].SessionId
+	assert.NotEmpty(t, sessionID)
+
+	symbolSequences := make(map[string]uint64)
+	for i, update := range updates {
+		assert.Equal(t, sessionID, update.SessionId)
+		assert.Equal(t, uint64(i+1), update.Sequence, "stream sequence must have no gaps")
+
+		symbolSequences[update.Symbol]++
+		assert.Equal(t, symbolSequences[update.Symbol], update.SymbolSequence)
+	}
+}
+
+func TestMarketDataGRPCHandler_RecoverPriceUpdates(t *testing.T) {
+	handler := setupHandler()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := newMockPriceStream(ctx)
+
+	go handler.StreamPrices(&proto.StreamPricesRequest{
+		Symbols:          []string{"BTC/USD", "ETH/USD"},
+		UpdateIntervalMs: 100,
+	}, stream)
+
+	require.Eventually(t, func() bool { return len(stream.Updates()) >= 4 }, 2*time.Second, 10*time.Millisecond)
+	sent := stream.Updates()
+
+	resp, err := handler.RecoverPriceUpdates(context.Background(), &proto.RecoverPriceUpdatesRequest{
+		SessionId:    sent[0].SessionId,
+		FromSequence: 2,
+		ToSequence:   3,
+	})
+
+	require.NoError(t, err)
+	assert.True(t, resp.Complete)
+	assert.Equal(t, uint64(1), resp.FirstAvailableSequence)
+	assert.GreaterOrEqual(t, resp.LastSequence, uint64(4))
+	require.Len(t, resp.Updates, 2)
+	assert.Equal(t, sent[1].Price, resp.Updates[0].Price)
+	assert.Equal(t, sent[2].Price, resp.Updates[1].Price)
+}
+
+func TestMarketDataGRPCHandler_RecoverPriceUpdates_Errors(t *testing.T) {
+	handler := setupHandler()
+	ctx := context.Background()
+
+	_, err := handler.RecoverPriceUpdates(ctx, &proto.RecoverPriceUpdatesRequest{SessionId: "stream_unknown"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = handler.RecoverPriceUpdates(ctx, &proto.RecoverPriceUpdatesRequest{
+		SessionId:    "stream_unknown",
+		FromSequence: 10,
+		ToSequence:   5,
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestMarketDataGRPCHandler_StreamPrices_Resume(t *testing.T) {
+	handler := setupHandler()
+	handler.config.StreamRetentionWindow = time.Minute
+
+	// First connection receives a few updates and then drops
+	firstCtx, dropConnection := context.WithCancel(context.Background())
+	first := newMockPriceStream(firstCtx)
+	done := make(chan error, 1)
+	go func() {
+		done <- handler.StreamPrices(&proto.StreamPricesRequest{
+			Symbols:          []string{"BTC/USD", "ETH/USD"},
+			UpdateIntervalMs: 100,
+		}, first)
+	}()
+
+	require.Eventually(t, func() bool { return len(first.Updates()) >= 4 }, 2*time.Second, 10*time.Millisecond)
+	dropConnection()
+	<-done
+
+	sent := first.Updates()
+	sessionID := sent[0].SessionId
+
+	// Second connection resumes, pretending it only saw the first two updates
+	secondCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	second := newMockPriceStream(secondCtx)
+	go handler.StreamPrices(&proto.StreamPricesRequest{
+		ResumeSessionId: sessionID,
+		LastSequence:    2,
+	}, second)
+
+	require.Eventually(t, func() bool { return len(second.Updates()) >= len(sent)+2 }, 2*time.Second, 10*time.Millisecond)
+	resumed := second.Updates()
+
+	for i, update := range resumed {
+		assert.Equal(t, sessionID, update.SessionId)
+		assert.Equal(t, uint64(i+3), update.Sequence, "replay then live must continue without gaps")
+	}
+	// Replayed updates are the originals
+	assert.Equal(t, sent[2].Price, resumed[0].Price)
+}
+
+func TestMarketDataGRPCHandler_StreamPrices_ResumeErrors(t *testing.T) {
+	handler := setupHandler()
+	handler.config.StreamRetentionWindow = time.Minute
+
+	stream := newMockPriceStream(context.Background())
+	err := handler.StreamPrices(&proto.StreamPricesRequest{ResumeSessionId: "stream_unknown"}, stream)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Sessions past the retention window can no longer be resumed
+	handler.config.StreamRetentionWindow = 50 * time.Millisecond
+	ctx, cancel := context.WithCancel(context.Background())
+	first := newMockPriceStream(ctx)
+	done := make(chan error, 1)
+	go func() {
+		done <- handler.StreamPrices(&proto.StreamPricesRequest{Symbols: []string{"BTC/USD"}}, first)
+	}()
+	require.Eventually(t, func() bool { return len(first.Updates()) >= 1 }, 2*time.Second, 10*time.Millisecond)
+	cancel()
+	<-done
+
+	time.Sleep(100 * time.Millisecond)
+	err = handler.StreamPrices(&proto.StreamPricesRequest{ResumeSessionId: first.Updates()[0].SessionId}, stream)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestMarketDataGRPCHandler_StreamPrices_Patterns(t *testing.T) {
+	handler := setupHandler()
+	universe := handler.marketDataService.Universe()
+	universe.Add("BTC-USD")
+	universe.Add("ETH-BTC")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := newMockPriceStream(ctx)
+
+	go handler.StreamPrices(&proto.StreamPricesRequest{
+		Symbols:          []string{"*-USD"},
+		UpdateIntervalMs: 100,
+	}, stream)
+
+	require.Eventually(t, func() bool { return len(stream.Updates()) >= 2 }, 2*time.Second, 10*time.Millisecond)
+	for _, update := range stream.Updates() {
+		assert.Equal(t, "BTC-USD", update.Symbol)
+	}
+
+	// A symbol added to the universe later is picked up by the running stream
+	require.NoError(t, handler.marketDataService.Subscribe("SOL-USD"))
+	require.Eventually(t, func() bool {
+		for _, update := range stream.Updates() {
+			if update.Symbol == "SOL-USD" {
+				return true
+			}
+		}
+		return false
+	}, 2*time.Second, 10*time.Millisecond)
+
+	for _, update := range stream.Updates() {
+		assert.NotEqual(t, "ETH-BTC", update.Symbol)
+	}
+}
+
+func TestMarketDataGRPCHandler_StreamPrices_UnknownGroup(t *testing.T) {
+	handler := setupHandler()
+	stream := newMockPriceStream(context.Background())
+
+	err := handler.StreamPrices(&proto.StreamPricesRequest{Symbols: []string{"@unknown"}}, stream)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestMarketDataGRPCHandler_StreamPrices_Conflated(t *testing.T) {
+	handler := setupHandler()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := newMockPriceStream(ctx)
+
+	go handler.StreamPrices(&proto.StreamPricesRequest{
+		Symbols:          []string{"BTC-USD"},
+		UpdateIntervalMs: 100,
+		DeliveryPolicy:   proto.DeliveryPolicy_CONFLATED,
+		MaxRateMs:        300,
+	}, stream)
+
+	require.Eventually(t, func() bool { return len(stream.Updates()) >= 2 }, 2*time.Second, 10*time.Millisecond)
+	updates := stream.Updates()
+
+	assert.Equal(t, uint32(0), updates[0].ConflatedCount)
+	assert.GreaterOrEqual(t, updates[1].ConflatedCount, uint32(1), "ticks between deliveries are conflated")
+	assert.Equal(t, uint64(2), updates[1].Sequence, "conflated ticks do not consume sequence numbers")
+}
+
+func TestMarketDataGRPCHandler_StreamPrices_SlowConsumerDisconnect(t *testing.T) {
+	handler := setupHandler()
+	handler.config.StreamQueueSize = 2
+	metrics := newRecordingMetrics()
+	handler.metrics = metrics
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := newMockPriceStream(ctx)
+	stream.blocked = make(chan struct{})
+
+	err := handler.StreamPrices(&proto.StreamPricesRequest{
+		Symbols:          []string{"BTC-USD", "ETH-USD", "SOL-USD"},
+		UpdateIntervalMs: 100,
+		OverflowPolicy:   proto.OverflowPolicy_DISCONNECT,
+	}, stream)
+
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, 1, metrics.Count(metricStreamSlowConsumers))
+}
+
+func TestMarketDataGRPCHandler_StreamPrices_SlowConsumerDropOldest(t *testing.T) {
+	handler := setupHandler()
+	handler.config.StreamQueueSize = 2
+	handler.config.StreamOverflowPolicy = "drop_oldest"
+	metrics := newRecordingMetrics()
+	handler.metrics = metrics
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := newMockPriceStream(ctx)
+	stream.blocked = make(chan struct{})
+
+	go handler.StreamPrices(&proto.StreamPricesRequest{
+		Symbols:          []string{"BTC-USD", "ETH-USD", "SOL-USD"},
+		UpdateIntervalMs: 100,
+	}, stream)
+
+	require.Eventually(t, func() bool { return metrics.Count(metricStreamUpdatesDropped) > 0 }, 2*time.Second, 10*time.Millisecond)
+	close(stream.blocked)
+
+	require.Eventually(t, func() bool { return len(stream.Updates()) >= 3 }, 2*time.Second, 10*time.Millisecond)
+	updates := stream.Updates()
+
+	gap := false
+	for i := 1; i < len(updates); i++ {
+		assert.Greater(t, updates[i].Sequence, updates[i-1].Sequence, "sequences stay in order")
+		if updates[i].Sequence > updates[i-1].Sequence+1 {
+			gap = true
+		}
+	}
+	assert.True(t, gap, "dropped updates show up as sequence gaps")
+
+	// Dropped updates remain recoverable
+	resp, err := handler.RecoverPriceUpdates(context.Background(), &proto.RecoverPriceUpdatesRequest{
+		SessionId:    updates[0].SessionId,
+		FromSequence: 1,
+		ToSequence:   updates[len(updates)-1].Sequence,
+	})
+	require.NoError(t, err)
+	assert.True(t, resp.Complete)
+	assert.Len(t, resp.Updates, int(updates[len(updates)-1].Sequence))
+}
+
+// recordingMetrics is a MetricsPort that counts counter increments by name
+type recordingMetrics struct {
+	mu       sync.Mutex
+	counters map[string]int
+}
+
+func newRecordingMetrics() *recordingMetrics {
+	return &recordingMetrics{counters: make(map[string]int)}
+}
+
+func (m *recordingMetrics) IncCounter(name string, labels map[string]string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.counters[name]++
+}
+
+func (m *recordingMetrics) ObserveHistogram(name string, value float64, labels map[string]string) {}
+
+func (m *recordingMetrics) SetGauge(name string, value float64, labels map[string]string) {}
+
+func (m *recordingMetrics) GetHTTPHandler() http.Handler {
+	return http.NotFoundHandler()
+}
+
+func (m *recordingMetrics) Count(name string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.counters[name]
+}
+
+func setupImpactHandler() *MarketDataGRPCHandler {
+	return setupHandlerWith(func(cfg *config.Config) {
+		cfg.ImpactVolatility = 0.02
+		cfg.ImpactDailyVolume = 1000000
+		cfg.ImpactTemporaryCoefficient = 0.5
+		cfg.ImpactPermanentCoefficient = 0.1
+		cfg.ImpactDecayHalfLife = time.Minute
+	})
+}
+
+func TestMarketDataGRPCHandler_ReportTrade(t *testing.T) {
+	handler := setupImpactHandler()
+	ctx := context.Background()
+
+	resp, err := handler.ReportTrade(ctx, &proto.TradeReport{
+		Symbol:   "BTC-USD",
+		Side:     proto.TradeSide_BUY,
+		Quantity: 250000,
+		TradeId:  "trade-1",
+		Source:   "exchange-simulator",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 100.0, resp.PriceBefore)
+	assert.Greater(t, resp.PriceAfter, resp.PriceBefore)
+	// 0.1 × 0.02 × sqrt(0.25) = 10bps permanent, 50bps temporary
+	assert.InDelta(t, 10.0, resp.PermanentImpactBps, 1e-9)
+	assert.InDelta(t, 50.0, resp.TemporaryImpactBps, 1e-9)
+
+	price, err := handler.GetPrice(ctx, &proto.GetPriceRequest{Symbol: "BTC-USD"})
+	require.NoError(t, err)
+	assert.InDelta(t, resp.PriceAfter, price.Price, 1e-3, "GetPrice sees the impact")
+
+	sell, err := handler.ReportTrade(ctx, &proto.TradeReport{Symbol: "BTC-USD", Side: proto.TradeSide_SELL, Quantity: 250000})
+	require.NoError(t, err)
+	assert.Less(t, sell.PriceAfter, sell.PriceBefore)
+}
+
+func TestMarketDataGRPCHandler_ReportTrade_Invalid(t *testing.T) {
+	handler := setupImpactHandler()
+	ctx := context.Background()
+
+	requests := []*proto.TradeReport{
+		{Side: proto.TradeSide_BUY, Quantity: 1},
+		{Symbol: "BTC-USD", Side: proto.TradeSide_BUY},
+		{Symbol: "BTC-USD", Side: proto.TradeSide_BUY, Quantity: -5},
+		{Symbol: "BTC-USD", Quantity: 1},
+	}
+	for _, req := range requests {
+		_, err := handler.ReportTrade(ctx, req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), "request %v", req)
+	}
+}
+
+func TestMarketDataGRPCHandler_GeneratePriceUpdate_AppliesImpact(t *testing.T) {
+	handler := setupImpactHandler()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	session := handler.newStreamSession(ctx, cancel, "impact_test", []string{"BTC-USD"}, time.Second)
+	require.NoError(t, handler.resolveSymbols(session))
+
+	resp, err := handler.ReportTrade(ctx, &proto.TradeReport{Symbol: "BTC-USD", Side: proto.TradeSide_BUY, Quantity: 1000000})
+	require.NoError(t, err)
+	jump := resp.PriceAfter/resp.PriceBefore - 1 // 120bps, beyond the ±0.5% tick range
+
+	update := handler.generatePriceUpdate("BTC-USD", session)
+	assert.Greater(t, update.Price, 100.0*(1+jump-0.006))
+
+	// The impact is carried over once, not on every tick
+	next := handler.generatePriceUpdate("BTC-USD", session)
+	assert.InDelta(t, update.Price, next.Price, update.Price*0.006)
+}
@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
+)
+
+// maxUpdatesPerBatch caps a single PriceUpdateBatch; larger flushes (a
+// high-frequency wake-up or a resume replay) are split
+const maxUpdatesPerBatch = 1000
+
+// priceBatchSender is the send side of a PriceUpdateBatch stream
+type priceBatchSender interface {
+	Send(*proto.PriceUpdateBatch) error
+}
+
+// StreamPriceBatches streams the same session as StreamPrices but writes each
+// flushed batch as a single message: one tick per message in regular mode,
+// one wake-up's worth of ticks in high-frequency mode
+func (h *MarketDataGRPCHandler) StreamPriceBatches(req *proto.StreamPricesRequest, stream proto.MarketDataService_StreamPriceBatchesServer) error {
+	return h.servePriceStream(stream.Context(), "StreamPriceBatches", req, sendBatches(stream))
+}
+
+// sendBatches writes updates as PriceUpdateBatch messages
+func sendBatches(sender priceBatchSender) updateWriter {
+	return func(batch []*proto.PriceUpdate) error {
+		for len(batch) > 0 {
+			size := len(batch)
+			if size > maxUpdatesPerBatch {
+				size = maxUpdatesPerBatch
+			}
+
+			chunk := batch[:size]
+			batch = batch[size:]
+
+			if err := sender.Send(&proto.PriceUpdateBatch{
+				SessionId:     chunk[0].SessionId,
+				Updates:       chunk,
+				FirstSequence: chunk[0].Sequence,
+				LastSequence:  chunk[size-1].Sequence,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
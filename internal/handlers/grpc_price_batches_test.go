@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
+)
+
+// mockBatchStream collects batches sent on a server stream
+type mockBatchStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	mu      sync.Mutex
+	batches []*proto.PriceUpdateBatch
+}
+
+func (m *mockBatchStream) Send(batch *proto.PriceUpdateBatch) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.batches = append(m.batches, batch)
+	return nil
+}
+
+func (m *mockBatchStream) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockBatchStream) Batches() []*proto.PriceUpdateBatch {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*proto.PriceUpdateBatch(nil), m.batches...)
+}
+
+func TestMarketDataGRPCHandler_StreamPriceBatches(t *testing.T) {
+	handler := setupHandler()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := &mockBatchStream{ctx: ctx}
+
+	go handler.StreamPriceBatches(&proto.StreamPricesRequest{
+		Symbols:          []string{"BTC-USD", "ETH-USD", "SOL-USD"},
+		UpdateIntervalMs: 100,
+	}, stream)
+
+	require.Eventually(t, func() bool { return len(stream.Batches()) >= 2 }, 2*time.Second, 10*time.Millisecond)
+	batches := stream.Batches()
+
+	// One tick per batch, all symbols together
+	for i, batch := range batches[:2] {
+		require.Len(t, batch.Updates, 3)
+		assert.Equal(t, batch.Updates[0].SessionId, batch.SessionId)
+		assert.Equal(t, uint64(i*3+1), batch.FirstSequence)
+		assert.Equal(t, uint64(i*3+3), batch.LastSequence)
+		for _, update := range batch.Updates[1:] {
+			assert.True(t, update.Timestamp.AsTime().Equal(batch.Updates[0].Timestamp.AsTime()))
+		}
+	}
+}
+
+func TestMarketDataGRPCHandler_StreamPriceBatches_HighFrequency(t *testing.T) {
+	handler := setupHandler()
+	handler.config.HighFrequencyEnabled = true
+	handler.config.HighFrequencyClients = []string{"*"}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := &mockBatchStream{ctx: ctx}
+
+	go handler.StreamPriceBatches(&proto.StreamPricesRequest{
+		Symbols:          []string{"BTC-USD"},
+		UpdateIntervalUs: 100,
+	}, stream)
+
+	require.Eventually(t, func() bool { return len(stream.Batches()) >= 5 }, 2*time.Second, 10*time.Millisecond)
+
+	// Each wake-up carries several ticks of the one symbol
+	multiTick := false
+	for _, batch := range stream.Batches()[1:] {
+		if len(batch.Updates) > 1 {
+			multiTick = true
+		}
+	}
+	assert.True(t, multiTick)
+}
+
+func TestSendBatches_Split(t *testing.T) {
+	stream := &mockBatchStream{ctx: context.Background()}
+
+	updates := make([]*proto.PriceUpdate, maxUpdatesPerBatch+5)
+	for i := range updates {
+		updates[i] = &proto.PriceUpdate{Symbol: "BTC-USD", Sequence: uint64(i + 1)}
+	}
+
+	require.NoError(t, sendBatches(stream)(updates))
+
+	batches := stream.Batches()
+	require.Len(t, batches, 2)
+	assert.Len(t, batches[0].Updates, maxUpdatesPerBatch)
+	assert.Equal(t, uint64(maxUpdatesPerBatch+1), batches[1].FirstSequence)
+	assert.Equal(t, uint64(maxUpdatesPerBatch+5), batches[1].LastSequence)
+}
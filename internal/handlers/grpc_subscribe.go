@@ -0,0 +1,225 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/services"
+)
+
+// Subscribe runs a single price stream whose symbols and interval are managed
+// by the client over the same connection. Requests are received on a separate
+// goroutine but applied on the tick loop, so session state needs no locking.
+func (h *MarketDataGRPCHandler) Subscribe(stream proto.MarketDataService_SubscribeServer) error {
+	sessionID := fmt.Sprintf("subscribe_%d", time.Now().UnixNano())
+	ctx, cancel := context.WithCancel(stream.Context())
+
+	session := h.newStreamSession(ctx, cancel, sessionID, nil, minUpdateInterval)
+	session.method = "Subscribe"
+	h.registerSession(session)
+	defer h.unregisterSession(session)
+
+	h.logger.WithField("session_id", sessionID).Info("Starting subscription stream")
+
+	requests := make(chan *proto.SubscriptionRequest)
+	recvErr := make(chan error, 1)
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			select {
+			case requests <- req:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(session.wakeInterval())
+	defer ticker.Stop()
+
+	sendErr := h.startSender(session, sendEach(stream))
+
+	for {
+		select {
+		case <-ctx.Done():
+			h.logger.WithField("session_id", sessionID).Info("Subscription context cancelled")
+			return ctx.Err()
+		case err := <-recvErr:
+			if errors.Is(err, io.EOF) {
+				// Client is done sending requests; keep streaming the current subscription
+				recvErr = nil
+				continue
+			}
+			return err
+		case err := <-sendErr:
+			return err
+		case req := <-requests:
+			if err := h.applySubscriptionRequest(session, req, ticker); err != nil {
+				return err
+			}
+		case now := <-ticker.C:
+			if err := h.publishDueTicks(session, now); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (h *MarketDataGRPCHandler) applySubscriptionRequest(session *StreamSession, req *proto.SubscriptionRequest, ticker *time.Ticker) error {
+	h.logger.WithFields(logrus.Fields{
+		"session_id": session.id,
+		"action":     req.Action,
+		"symbols":    req.Symbols,
+	}).Info("Subscription request received")
+
+	if req.ApiVersion != proto.ApiVersion_API_VERSION_UNSPECIFIED {
+		if err := checkAPIVersion(req.ApiVersion); err != nil {
+			return err
+		}
+		session.apiVersion = req.ApiVersion
+	}
+	if req.QuoteCurrency != "" && req.QuoteCurrency != session.quoteCurrency {
+		session.quoteCurrency = req.QuoteCurrency
+		// Changes are measured within one currency
+		clear(session.previousPrices)
+		if err := h.resolveSymbols(session); err != nil {
+			return err
+		}
+	}
+
+	switch req.Action {
+	case proto.SubscriptionAction_ADD_SYMBOLS:
+		if err := h.requireInstruments(req.Symbols); err != nil {
+			return err
+		}
+		session.subscriptions = appendUnique(session.subscriptions, req.Symbols)
+		h.registerSymbols(req.Symbols)
+		return h.resolveSymbols(session)
+	case proto.SubscriptionAction_REMOVE_SYMBOLS:
+		session.removeSubscriptions(req.Symbols)
+		return h.resolveSymbols(session)
+	case proto.SubscriptionAction_SET_INTERVAL:
+		interval, highFrequency, err := h.negotiateInterval(session.ctx, req.UpdateIntervalMs, req.UpdateIntervalUs)
+		if err != nil {
+			return err
+		}
+		session.setInterval(interval, highFrequency)
+		ticker.Reset(session.wakeInterval())
+	case proto.SubscriptionAction_SET_DELIVERY_POLICY:
+		delivery, err := newDeliveryFilter(req.DeliveryPolicy, req.MaxRateMs, req.ChangeThresholdPercent)
+		if err != nil {
+			return err
+		}
+		delivery.conflated = session.delivery.conflated
+		session.delivery = delivery
+	case proto.SubscriptionAction_SNAPSHOT:
+		if err := h.requireInstruments(req.Symbols); err != nil {
+			return err
+		}
+		symbols := req.Symbols
+		if len(symbols) == 0 {
+			symbols = session.symbols
+		}
+		if err := h.publishSnapshot(session, symbols); err != nil {
+			return err
+		}
+		session.out.flush()
+	default:
+		return status.Errorf(codes.InvalidArgument, "unknown subscription action %v", req.Action)
+	}
+
+	return nil
+}
+
+// publishSnapshot publishes the symbols' current prices in the shared market
+// without advancing it. Snapshots read one market snapshot, rates included,
+// and are finished like ticks, so they come in the stream's currency. Symbols
+// named only here must convert like explicit subscriptions.
+func (h *MarketDataGRPCHandler) publishSnapshot(session *StreamSession, symbols []string) error {
+	if session.conversions == nil {
+		session.conversions = make(map[string]services.Conversion)
+	}
+	subscribed := make(map[string]bool, len(session.symbols))
+	for _, symbol := range session.symbols {
+		subscribed[symbol] = true
+	}
+
+	watched := append([]string(nil), symbols...)
+	for _, symbol := range symbols {
+		if !subscribed[symbol] {
+			conversion, err := h.conversion(symbol, session.quoteCurrency)
+			if err != nil {
+				return err
+			}
+			if conversion != nil {
+				session.conversions[symbol] = *conversion
+			}
+		}
+		if conversion, exists := session.conversions[symbol]; exists {
+			watched = append(watched, conversion.RateSymbols()...)
+		}
+	}
+	session.market = h.marketDataService.Snapshot(watched, session.eventSequence)
+
+	for _, symbol := range symbols {
+		if err := h.publish(session, h.finishUpdate(session, h.snapshotUpdate(symbol, session.market))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// snapshotUpdate reports a symbol's price in a market snapshot
+func (h *MarketDataGRPCHandler) snapshotUpdate(symbol string, market services.MarketSnapshot) *proto.PriceUpdate {
+	return &proto.PriceUpdate{
+		Symbol:    symbol,
+		Price:     market.Price(symbol),
+		Timestamp: timestamppb.New(market.At),
+		Source:    "market-data-simulator",
+		Snapshot:  true,
+	}
+}
+
+// removeSubscriptions drops entries exactly as they were subscribed; a
+// symbol matched by a remaining pattern or group stays subscribed
+func (s *StreamSession) removeSubscriptions(entries []string) {
+	removed := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		removed[entry] = true
+	}
+
+	remaining := make([]string, 0, len(s.subscriptions))
+	for _, entry := range s.subscriptions {
+		if !removed[entry] {
+			remaining = append(remaining, entry)
+		}
+	}
+	s.subscriptions = remaining
+}
+
+func appendUnique(entries []string, additions []string) []string {
+	existing := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		existing[entry] = true
+	}
+	for _, entry := range additions {
+		if !existing[entry] {
+			existing[entry] = true
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
@@ -0,0 +1,178 @@
+package handlers
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
+)
+
+// mockSubscribeStream feeds subscription requests to the handler and
+// collects the updates it sends back
+type mockSubscribeStream struct {
+	*mockPriceStream
+	requests chan *proto.SubscriptionRequest
+}
+
+func newMockSubscribeStream(ctx context.Context) *mockSubscribeStream {
+	return &mockSubscribeStream{
+		mockPriceStream: newMockPriceStream(ctx),
+		requests:        make(chan *proto.SubscriptionRequest),
+	}
+}
+
+func (m *mockSubscribeStream) Recv() (*proto.SubscriptionRequest, error) {
+	select {
+	case req, ok := <-m.requests:
+		if !ok {
+			return nil, io.EOF
+		}
+		return req, nil
+	case <-m.ctx.Done():
+		return nil, m.ctx.Err()
+	}
+}
+
+func symbolsIn(updates []*proto.PriceUpdate) map[string]int {
+	counts := make(map[string]int)
+	for _, update := range updates {
+		counts[update.Symbol]++
+	}
+	return counts
+}
+
+func TestMarketDataGRPCHandler_Subscribe(t *testing.T) {
+	handler := setupHandler()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := newMockSubscribeStream(ctx)
+
+	done := make(chan error, 1)
+	go func() { done <- handler.Subscribe(stream) }()
+
+	// Add symbols and wait for ticks
+	stream.requests <- &proto.SubscriptionRequest{
+		Action:  proto.SubscriptionAction_ADD_SYMBOLS,
+		Symbols: []string{"BTC/USD", "ETH/USD"},
+	}
+	require.Eventually(t, func() bool {
+		counts := symbolsIn(stream.Updates())
+		return counts["BTC/USD"] >= 1 && counts["ETH/USD"] >= 1
+	}, 2*time.Second, 10*time.Millisecond)
+
+	// Remove one symbol; only the other keeps ticking
+	stream.requests <- &proto.SubscriptionRequest{
+		Action:  proto.SubscriptionAction_REMOVE_SYMBOLS,
+		Symbols: []string{"ETH/USD"},
+	}
+	stream.requests <- &proto.SubscriptionRequest{Action: proto.SubscriptionAction_SNAPSHOT}
+	require.Eventually(t, func() bool {
+		updates := stream.Updates()
+		return updates[len(updates)-1].Snapshot
+	}, 2*time.Second, 10*time.Millisecond)
+
+	marker := len(stream.Updates())
+	require.Eventually(t, func() bool { return len(stream.Updates()) >= marker+2 }, 2*time.Second, 10*time.Millisecond)
+	for _, update := range stream.Updates()[marker:] {
+		assert.Equal(t, "BTC/USD", update.Symbol)
+	}
+
+	// The whole conversation is a single sequenced session
+	updates := stream.Updates()
+	for i, update := range updates {
+		assert.Equal(t, updates[0].SessionId, update.SessionId)
+		assert.Equal(t, uint64(i+1), update.Sequence)
+	}
+
+	handler.streamsMutex.RLock()
+	assert.Len(t, handler.activeStreams, 1)
+	handler.streamsMutex.RUnlock()
+
+	// Closing the request side keeps the stream alive
+	close(stream.requests)
+	marker = len(stream.Updates())
+	require.Eventually(t, func() bool { return len(stream.Updates()) > marker }, 2*time.Second, 10*time.Millisecond)
+
+	cancel()
+	<-done
+}
+
+func TestApplySubscriptionRequest_SnapshotQuoteCurrency(t *testing.T) {
+	handler := setupFXHandler(0)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	session := handler.newStreamSession(ctx, cancel, "subscribe_test", nil, time.Second)
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+
+	require.NoError(t, handler.applySubscriptionRequest(session, &proto.SubscriptionRequest{
+		Action:        proto.SubscriptionAction_ADD_SYMBOLS,
+		Symbols:       []string{"BTC-USD"},
+		QuoteCurrency: "JPY",
+	}, ticker))
+	require.NoError(t, handler.publishTick(session, time.Now()))
+	require.NoError(t, handler.applySubscriptionRequest(session, &proto.SubscriptionRequest{
+		Action:  proto.SubscriptionAction_SNAPSHOT,
+		Symbols: []string{"BTC-USD", "ETH-USD"},
+	}, ticker))
+
+	var updates []*proto.PriceUpdate
+	for _, batch := range session.out.drain() {
+		updates = append(updates, batch...)
+	}
+	require.Len(t, updates, 3)
+
+	// Snapshots, of subscribed symbols or not, come in the stream's currency
+	tick := updates[0]
+	for _, update := range updates {
+		require.NotNil(t, update.Conversion, update.Symbol)
+		assert.Equal(t, "JPY", update.Conversion.ToCurrency)
+		assert.Equal(t, "USD-JPY", update.Conversion.RateSymbol)
+	}
+	assert.True(t, updates[1].Snapshot)
+	assert.Equal(t, "BTC-USD", updates[1].Symbol)
+	assert.Equal(t, tick.Price, updates[1].Price, "the market has not moved since the tick")
+	assert.Equal(t, tick.Conversion.Rate, updates[1].Conversion.Rate)
+}
+
+func TestMarketDataGRPCHandler_Subscribe_SetInterval(t *testing.T) {
+	handler := setupHandler()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := newMockSubscribeStream(ctx)
+
+	go handler.Subscribe(stream)
+
+	stream.requests <- &proto.SubscriptionRequest{
+		Action:  proto.SubscriptionAction_ADD_SYMBOLS,
+		Symbols: []string{"BTC/USD"},
+	}
+	stream.requests <- &proto.SubscriptionRequest{
+		Action:           proto.SubscriptionAction_SET_INTERVAL,
+		UpdateIntervalMs: 1000,
+	}
+
+	// Give the slower ticker a window shorter than one interval
+	marker := len(stream.Updates())
+	time.Sleep(500 * time.Millisecond)
+	assert.LessOrEqual(t, len(stream.Updates())-marker, 1)
+}
+
+func TestMarketDataGRPCHandler_Subscribe_InvalidAction(t *testing.T) {
+	handler := setupHandler()
+	stream := newMockSubscribeStream(context.Background())
+
+	done := make(chan error, 1)
+	go func() { done <- handler.Subscribe(stream) }()
+
+	stream.requests <- &proto.SubscriptionRequest{Action: proto.SubscriptionAction(99)}
+	err := <-done
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// clientIDMetadataKey names the calling client for per-client settings.
+	// The value is whatever the caller sends and is not authenticated, so
+	// HIGH_FREQUENCY_CLIENTS is an opt-in toggle to keep ordinary clients on
+	// the regular floor, not a security control.
+	clientIDMetadataKey = "x-client"
+
+	// defaultHighFrequencyMinInterval applies when HIGH_FREQUENCY_MIN_INTERVAL is unset
+	defaultHighFrequencyMinInterval = 100 * time.Microsecond
+
+	// highFrequencyWakeInterval is how often a high-frequency tick loop wakes.
+	// Ticks that fell due since the previous wake-up are generated together,
+	// each timestamped at its own point on the interval grid.
+	highFrequencyWakeInterval = time.Millisecond
+
+	// maxTicksPerWake bounds catch-up after a stall; older due ticks are skipped
+	maxTicksPerWake = 1000
+)
+
+// negotiateInterval resolves a requested tick interval. Millisecond intervals
+// keep the 100ms floor; a microsecond interval below it opts into
+// high-frequency mode, which must be enabled and granted to the client.
+func (h *MarketDataGRPCHandler) negotiateInterval(ctx context.Context, intervalMs int32, intervalUs int64) (time.Duration, bool, error) {
+	if intervalUs <= 0 {
+		return clampUpdateInterval(intervalMs), false, nil
+	}
+
+	interval := time.Duration(intervalUs) * time.Microsecond
+	if interval >= minUpdateInterval {
+		return interval, false, nil
+	}
+
+	if !h.config.HighFrequencyEnabled {
+		return 0, false, status.Errorf(codes.FailedPrecondition, "high-frequency mode is disabled; intervals below %v are not available", minUpdateInterval)
+	}
+
+	client := clientID(ctx)
+	if !h.highFrequencyAllowed(client) {
+		return 0, false, status.Errorf(codes.PermissionDenied, "client %q is not permitted to use high-frequency mode", client)
+	}
+
+	floor := h.config.HighFrequencyMinInterval
+	if floor <= 0 {
+		floor = defaultHighFrequencyMinInterval
+	}
+	if interval < floor {
+		interval = floor
+	}
+	return interval, true, nil
+}
+
+func (h *MarketDataGRPCHandler) highFrequencyAllowed(client string) bool {
+	for _, allowed := range h.config.HighFrequencyClients {
+		if allowed == "*" || (client != "" && allowed == client) {
+			return true
+		}
+	}
+	return false
+}
+
+// clientID reads the self-declared x-client name from incoming request metadata
+func clientID(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get(clientIDMetadataKey); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// publishDueTicks publishes the ticks due by now and flushes them as one
+// batch. Regular sessions publish one tick per wake-up; high-frequency
+// sessions publish one per interval elapsed since the last wake-up.
+func (h *MarketDataGRPCHandler) publishDueTicks(session *StreamSession, now time.Time) error {
+	defer session.out.flush()
+
+	if !session.highFrequency {
+		return h.publishTick(session, now)
+	}
+
+	if session.nextTick.IsZero() {
+		session.nextTick = now
+	}
+	if behind := int64(now.Sub(session.nextTick) / session.updateInterval); behind >= maxTicksPerWake {
+		skipped := behind - maxTicksPerWake + 1
+		session.nextTick = session.nextTick.Add(time.Duration(skipped) * session.updateInterval)
+	}
+
+	for !session.nextTick.After(now) {
+		if err := h.publishTick(session, session.nextTick); err != nil {
+			return err
+		}
+		session.nextTick = session.nextTick.Add(session.updateInterval)
+	}
+	return nil
+}
+
+// setInterval changes a session's tick interval and restarts its tick grid
+func (s *StreamSession) setInterval(interval time.Duration, highFrequency bool) {
+	s.updateInterval = interval
+	s.highFrequency = highFrequency
+	s.nextTick = time.Time{}
+}
+
+// wakeInterval is the tick loop's timer period
+func (s *StreamSession) wakeInterval() time.Duration {
+	if s.highFrequency && s.updateInterval < highFrequencyWakeInterval {
+		return highFrequencyWakeInterval
+	}
+	return s.updateInterval
+}
@@ -0,0 +1,168 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
+)
+
+func clientContext(client string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(clientIDMetadataKey, client))
+}
+
+func TestNegotiateInterval(t *testing.T) {
+	handler := setupHandler()
+
+	// Millisecond intervals keep the 100ms floor
+	interval, highFrequency, err := handler.negotiateInterval(context.Background(), 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, minUpdateInterval, interval)
+	assert.False(t, highFrequency)
+
+	// Sub-floor microsecond intervals need the mode enabled
+	_, _, err = handler.negotiateInterval(clientContext("latency-tests"), 0, 500)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	handler.config.HighFrequencyEnabled = true
+	handler.config.HighFrequencyClients = []string{"latency-tests"}
+
+	_, _, err = handler.negotiateInterval(clientContext("someone-else"), 0, 500)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, _, err = handler.negotiateInterval(context.Background(), 0, 500)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "anonymous clients are not granted by name")
+
+	interval, highFrequency, err = handler.negotiateInterval(clientContext("latency-tests"), 0, 500)
+	require.NoError(t, err)
+	assert.Equal(t, 500*time.Microsecond, interval)
+	assert.True(t, highFrequency)
+
+	// Clamped to the configured high-frequency floor
+	interval, _, err = handler.negotiateInterval(clientContext("latency-tests"), 0, 1)
+	require.NoError(t, err)
+	assert.Equal(t, defaultHighFrequencyMinInterval, interval)
+
+	// Microsecond intervals at or above the floor are regular streams
+	interval, highFrequency, err = handler.negotiateInterval(context.Background(), 0, 250000)
+	require.NoError(t, err)
+	assert.Equal(t, 250*time.Millisecond, interval)
+	assert.False(t, highFrequency)
+}
+
+func TestHighFrequencyAllowed_Wildcard(t *testing.T) {
+	handler := setupHandler()
+	handler.config.HighFrequencyClients = []string{"*"}
+
+	assert.True(t, handler.highFrequencyAllowed("anyone"))
+	assert.True(t, handler.highFrequencyAllowed(""))
+}
+
+func TestPublishDueTicks_Interpolated(t *testing.T) {
+	handler := setupHandler()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	session := handler.newStreamSession(ctx, cancel, "hf_test", []string{"BTC-USD"}, time.Second)
+	session.setInterval(250*time.Microsecond, true)
+	require.NoError(t, handler.resolveSymbols(session))
+
+	start := time.Now()
+	require.NoError(t, handler.publishDueTicks(session, start))
+	require.NoError(t, handler.publishDueTicks(session, start.Add(time.Millisecond)))
+
+	batches := session.out.drain()
+	require.Len(t, batches, 2, "one batch per wake-up")
+	require.Len(t, batches[1], 4, "four ticks per elapsed millisecond")
+
+	updates := append(batches[0], batches[1]...)
+	for i, update := range updates {
+		assert.Equal(t, uint64(i+1), update.Sequence)
+		assert.True(t, start.Add(time.Duration(i)*250*time.Microsecond).Equal(update.Timestamp.AsTime()))
+	}
+}
+
+func TestPublishDueTicks_SkipsAfterStall(t *testing.T) {
+	handler := setupHandler()
+	handler.config.StreamQueueSize = 2 * maxTicksPerWake
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	session := handler.newStreamSession(ctx, cancel, "hf_stall", []string{"BTC-USD"}, time.Second)
+	session.setInterval(100*time.Microsecond, true)
+	require.NoError(t, handler.resolveSymbols(session))
+
+	start := time.Now()
+	require.NoError(t, handler.publishDueTicks(session, start))
+	session.out.drain()
+
+	require.NoError(t, handler.publishDueTicks(session, start.Add(time.Second)))
+	assert.Equal(t, maxTicksPerWake, session.out.depth())
+}
+
+func TestMarketDataGRPCHandler_StreamPrices_HighFrequency(t *testing.T) {
+	handler := setupHandler()
+	handler.config.HighFrequencyEnabled = true
+	handler.config.HighFrequencyClients = []string{"latency-tests"}
+
+	ctx, cancel := context.WithCancel(clientContext("latency-tests"))
+	defer cancel()
+	stream := newMockPriceStream(ctx)
+
+	go handler.StreamPrices(&proto.StreamPricesRequest{
+		Symbols:          []string{"BTC-USD"},
+		UpdateIntervalUs: 500,
+	}, stream)
+
+	// 100ms of ticks at 500us is far beyond what the regular floor allows
+	require.Eventually(t, func() bool { return len(stream.Updates()) >= 20 }, 2*time.Second, 10*time.Millisecond)
+	updates := stream.Updates()
+	assert.Equal(t, 500*time.Microsecond, updates[1].Timestamp.AsTime().Sub(updates[0].Timestamp.AsTime()))
+}
+
+func TestMarketDataGRPCHandler_StreamPrices_HighFrequencyDenied(t *testing.T) {
+	handler := setupHandler()
+	handler.config.HighFrequencyEnabled = true
+
+	stream := newMockPriceStream(clientContext("latency-tests"))
+	err := handler.StreamPrices(&proto.StreamPricesRequest{
+		Symbols:          []string{"BTC-USD"},
+		UpdateIntervalUs: 500,
+	}, stream)
+
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+// batchingStream records how updates reach it: one Send each, or a batch
+type batchingStream struct {
+	sends   int
+	batches [][]*proto.PriceUpdate
+}
+
+func (s *batchingStream) Send(*proto.PriceUpdate) error {
+	s.sends++
+	return nil
+}
+
+func (s *batchingStream) SendBatch(batch []*proto.PriceUpdate) error {
+	s.batches = append(s.batches, batch)
+	return nil
+}
+
+func TestSendEach_WritesBatchesWhole(t *testing.T) {
+	stream := &batchingStream{}
+	updates := []*proto.PriceUpdate{{Symbol: "BTC-USD", Sequence: 1}, {Symbol: "BTC-USD", Sequence: 2}}
+
+	require.NoError(t, sendEach(stream)(updates))
+
+	assert.Zero(t, stream.sends)
+	require.Len(t, stream.batches, 1)
+	assert.Len(t, stream.batches[0], 2)
+}
@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/services"
+)
+
+func (h *MarketDataGRPCHandler) ListInstruments(ctx context.Context, req *proto.ListInstrumentsRequest) (*proto.ListInstrumentsResponse, error) {
+	h.logger.WithFields(logrus.Fields{
+		"asset_class":    req.AssetClass,
+		"quote_currency": req.QuoteCurrency,
+	}).Info("ListInstruments request received")
+
+	resp := &proto.ListInstrumentsResponse{}
+	for _, instrument := range h.marketDataService.Instruments() {
+		if req.AssetClass != "" && instrument.AssetClass != req.AssetClass {
+			continue
+		}
+		if req.QuoteCurrency != "" && instrument.QuoteCurrency != req.QuoteCurrency {
+			continue
+		}
+		resp.Instruments = append(resp.Instruments, h.instrumentProto(instrument))
+	}
+	return resp, nil
+}
+
+func (h *MarketDataGRPCHandler) GetInstrument(ctx context.Context, req *proto.GetInstrumentRequest) (*proto.Instrument, error) {
+	h.logger.WithField("symbol", req.Symbol).Info("GetInstrument request received")
+
+	if req.Symbol == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "symbol is required")
+	}
+	instrument, exists := h.marketDataService.Instrument(req.Symbol)
+	if !exists {
+		return nil, status.Errorf(codes.NotFound, "unknown instrument %q", req.Symbol)
+	}
+	return h.instrumentProto(instrument), nil
+}
+
+// requireInstruments rejects explicitly requested symbols that are not in the
+// instrument registry. Patterns and groups are not checked; they only ever
+// resolve to registered instruments.
+func (h *MarketDataGRPCHandler) requireInstruments(entries []string) error {
+	for _, entry := range entries {
+		if services.IsDynamicSubscription([]string{entry}) {
+			continue
+		}
+		if _, exists := h.marketDataService.Instrument(entry); !exists {
+			return status.Errorf(codes.NotFound, "unknown instrument %q", entry)
+		}
+	}
+	return nil
+}
+
+func (h *MarketDataGRPCHandler) instrumentProto(instrument config.Instrument) *proto.Instrument {
+	hours := &proto.TradingHours{AlwaysOpen: true}
+	if schedule := h.marketDataService.TradingSchedule(instrument.Symbol); schedule != nil {
+		hours = &proto.TradingHours{
+			TimeZone:       schedule.Location.String(),
+			PreOpen:        clockTime(schedule.PreOpen),
+			OpeningAuction: clockTime(schedule.OpeningAuction),
+			Continuous:     clockTime(schedule.Continuous),
+			ClosingAuction: clockTime(schedule.ClosingAuction),
+			Close:          clockTime(schedule.Close),
+		}
+	}
+
+	return &proto.Instrument{
+		Symbol:         instrument.Symbol,
+		AssetClass:     instrument.AssetClass,
+		BaseCurrency:   instrument.BaseCurrency,
+		QuoteCurrency:  instrument.QuoteCurrency,
+		TickSize:       instrument.TickSize,
+		LotSize:        instrument.LotSize,
+		PricePrecision: int32(instrument.PricePrecision),
+		TradingHours:   hours,
+	}
+}
+
+// clockTime formats an offset from midnight as HH:MM
+func clockTime(offset time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(offset/time.Hour), int(offset%time.Hour/time.Minute))
+}
@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
+)
+
+func TestMarketDataGRPCHandler_ListInstruments(t *testing.T) {
+	handler := setupHandler()
+	ctx := context.Background()
+
+	resp, err := handler.ListInstruments(ctx, &proto.ListInstrumentsRequest{})
+	require.NoError(t, err)
+	assert.Len(t, resp.Instruments, len(testInstruments()))
+
+	resp, err = handler.ListInstruments(ctx, &proto.ListInstrumentsRequest{QuoteCurrency: "EUR"})
+	require.NoError(t, err)
+	require.Len(t, resp.Instruments, 2)
+	assert.Equal(t, "BTC-EUR", resp.Instruments[0].Symbol)
+	assert.Equal(t, "BTC/EUR", resp.Instruments[1].Symbol)
+
+	resp, err = handler.ListInstruments(ctx, &proto.ListInstrumentsRequest{AssetClass: "equity"})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Instruments)
+}
+
+func TestMarketDataGRPCHandler_GetInstrument(t *testing.T) {
+	handler := setupHandler()
+	handler.config.TradingSchedules = []config.TradingSchedule{testSchedule(time.UTC)}
+	ctx := context.Background()
+
+	instrument, err := handler.GetInstrument(ctx, &proto.GetInstrumentRequest{Symbol: "BTC-USD"})
+	require.NoError(t, err)
+	assert.Equal(t, "crypto", instrument.AssetClass)
+	assert.Equal(t, "BTC", instrument.BaseCurrency)
+	assert.Equal(t, "USD", instrument.QuoteCurrency)
+	assert.Greater(t, instrument.TickSize, 0.0)
+	assert.True(t, instrument.TradingHours.AlwaysOpen)
+
+	instrument, err = handler.GetInstrument(ctx, &proto.GetInstrumentRequest{Symbol: "BTC-EUR"})
+	require.NoError(t, err)
+	assert.False(t, instrument.TradingHours.AlwaysOpen)
+	assert.Equal(t, "UTC", instrument.TradingHours.TimeZone)
+	assert.Equal(t, "07:50", instrument.TradingHours.OpeningAuction)
+	assert.Equal(t, "16:35", instrument.TradingHours.Close)
+
+	_, err = handler.GetInstrument(ctx, &proto.GetInstrumentRequest{Symbol: "BTC-USDD"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = handler.GetInstrument(ctx, &proto.GetInstrumentRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestMarketDataGRPCHandler_UnknownSymbolsRejected(t *testing.T) {
+	handler := setupHandler()
+	ctx := context.Background()
+
+	err := handler.StreamPrices(&proto.StreamPricesRequest{Symbols: []string{"BTC-USD", "BTCUSD"}}, newMockPriceStream(ctx))
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = handler.ReportTrade(ctx, &proto.TradeReport{Symbol: "BTCUSD", Side: proto.TradeSide_BUY, Quantity: 1})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = handler.GenerateSimulation(ctx, &proto.SimulationRequest{Symbol: "BTCUSD"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	err = handler.StreamScenario(&proto.ScenarioRequest{Symbol: "BTCUSD"}, nil)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Patterns only match registered instruments, even via the universe
+	handler.marketDataService.Universe().Add("BTC-USD")
+	handler.marketDataService.Universe().Add("DOGE-USD")
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream := newMockPriceStream(streamCtx)
+	go handler.StreamPrices(&proto.StreamPricesRequest{Symbols: []string{"*-USD"}, UpdateIntervalMs: 100}, stream)
+
+	require.Eventually(t, func() bool { return len(stream.Updates()) >= 2 }, 2*time.Second, 10*time.Millisecond)
+	for _, update := range stream.Updates() {
+		assert.NotEqual(t, "DOGE-USD", update.Symbol)
+	}
+}
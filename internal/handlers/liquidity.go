@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
+)
+
+// Normal market conditions the simulated book is built from
+const (
+	baseSpreadBps        = 2.0  // Touch spread
+	baseLevelSize        = 10.0 // Resting size at the touch
+	baseLevelGapBps      = 1.0  // Price gap between book levels
+	baseTradesPerSec     = 20.0
+	orderBookDepth       = 5
+	defaultRecoveryShare = 0.3 // Share of a drought scenario spent recovering
+)
+
+// liquidityConditions scales the book and trading activity relative to
+// normal conditions
+type liquidityConditions struct {
+	depth    float64 // Fraction of normal resting size
+	spread   float64 // Multiple of the normal spread and level gaps
+	activity float64 // Fraction of normal trade frequency
+}
+
+var normalLiquidity = liquidityConditions{depth: 1, spread: 1, activity: 1}
+
+// attachLiquidity adds a quote, an order book around the update's price and
+// the number of trades printed over the tick interval
+func attachLiquidity(update *proto.PriceUpdate, conditions liquidityConditions, interval time.Duration) {
+	mid := update.Price
+	halfSpread := mid * baseSpreadBps * conditions.spread / 2 / 10000
+	levelGap := mid * baseLevelGapBps * conditions.spread / 10000
+
+	book := &proto.OrderBook{}
+	for level := 0; level < orderBookDepth; level++ {
+		offset := halfSpread + float64(level)*levelGap
+		book.Bids = append(book.Bids, &proto.OrderBookLevel{Price: mid - offset, Size: levelSize(level, conditions)})
+		book.Asks = append(book.Asks, &proto.OrderBookLevel{Price: mid + offset, Size: levelSize(level, conditions)})
+	}
+
+	update.OrderBook = book
+	update.Quote = &proto.Quote{
+		Bid:       book.Bids[0].Price,
+		Ask:       book.Asks[0].Price,
+		BidSize:   book.Bids[0].Size,
+		AskSize:   book.Asks[0].Size,
+		SpreadBps: baseSpreadBps * conditions.spread,
+	}
+
+	// Expected trades over the interval, jittered ±50%
+	expected := baseTradesPerSec * conditions.activity * interval.Seconds()
+	update.TradeCount = uint32(math.Round(expected * (0.5 + rand.Float64())))
+}
+
+// levelSize grows away from the touch, jittered ±20%
+func levelSize(level int, conditions liquidityConditions) float64 {
+	return baseLevelSize * conditions.depth * (1 + float64(level)*0.5) * (0.8 + rand.Float64()*0.4)
+}
+
+// droughtConditions models a liquidity drought: conditions deteriorate to a
+// trough (abruptly unless gradual), hold, then recover to normal over the
+// last part of the scenario. Intensity sets how severe the trough is.
+func droughtConditions(progress float64, params *proto.ScenarioParameters) liquidityConditions {
+	severity := droughtSeverity(progress, params)
+
+	intensity := 1.0
+	if params != nil {
+		intensity = params.Intensity
+	}
+	strain := severity * intensity
+
+	return liquidityConditions{
+		depth:    1 / (1 + 9*strain),
+		spread:   1 + 9*strain,
+		activity: 1 / (1 + 4*strain),
+	}
+}
+
+// droughtSeverity runs from 0 (normal) to 1 (trough) over the scenario
+func droughtSeverity(progress float64, params *proto.ScenarioParameters) float64 {
+	gradual := true
+	recoveryShare := defaultRecoveryShare
+	if params != nil {
+		gradual = params.GradualTransition
+		if params.RecoveryFactor > 0 {
+			// Faster recovery means a shorter recovery phase
+			recoveryShare = math.Min(math.Max(defaultRecoveryShare/params.RecoveryFactor, 0.05), 0.6)
+		}
+	}
+
+	onsetEnd := 0.4
+	recoveryStart := 1 - recoveryShare
+
+	switch {
+	case progress >= 1:
+		return 0
+	case progress >= recoveryStart:
+		return (1 - progress) / recoveryShare
+	case gradual && progress < onsetEnd:
+		return progress / onsetEnd
+	default:
+		return 1
+	}
+}
@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
+)
+
+func TestAttachLiquidity(t *testing.T) {
+	update := &proto.PriceUpdate{Symbol: "BTC-USD", Price: 100}
+	attachLiquidity(update, normalLiquidity, time.Second)
+
+	require.NotNil(t, update.Quote)
+	require.NotNil(t, update.OrderBook)
+	assert.Len(t, update.OrderBook.Bids, orderBookDepth)
+	assert.Len(t, update.OrderBook.Asks, orderBookDepth)
+
+	assert.Less(t, update.Quote.Bid, update.Price)
+	assert.Greater(t, update.Quote.Ask, update.Price)
+	assert.InDelta(t, baseSpreadBps, (update.Quote.Ask-update.Quote.Bid)/update.Price*10000, 1e-9)
+	assert.Equal(t, update.OrderBook.Bids[0].Size, update.Quote.BidSize)
+
+	// Levels move away from the touch
+	for i := 1; i < orderBookDepth; i++ {
+		assert.Less(t, update.OrderBook.Bids[i].Price, update.OrderBook.Bids[i-1].Price)
+		assert.Greater(t, update.OrderBook.Asks[i].Price, update.OrderBook.Asks[i-1].Price)
+	}
+
+	assert.GreaterOrEqual(t, update.TradeCount, uint32(10))
+	assert.LessOrEqual(t, update.TradeCount, uint32(30))
+}
+
+func TestDroughtSeverity(t *testing.T) {
+	params := &proto.ScenarioParameters{Intensity: 1, GradualTransition: true}
+
+	assert.Equal(t, 0.0, droughtSeverity(0, params))
+	assert.InDelta(t, 0.5, droughtSeverity(0.2, params), 1e-9, "gradual onset")
+	assert.Equal(t, 1.0, droughtSeverity(0.5, params), "trough")
+	assert.InDelta(t, 0.5, droughtSeverity(0.85, params), 1e-9, "recovering")
+	assert.Equal(t, 0.0, droughtSeverity(1, params), "recovered")
+
+	// Sudden onset skips the ramp
+	params.GradualTransition = false
+	assert.Equal(t, 1.0, droughtSeverity(0.01, params))
+
+	// A higher recovery factor shortens the recovery phase
+	params.RecoveryFactor = 3
+	assert.Equal(t, 1.0, droughtSeverity(0.85, params))
+}
+
+func TestMarketDataGRPCHandler_GenerateScenarioPrice_LiquidityDrought(t *testing.T) {
+	handler := setupHandler()
+
+	basePrice := 100.0
+	startTime := time.Now()
+	endTime := startTime.Add(10 * time.Minute)
+	params := &proto.ScenarioParameters{Intensity: 1.5, GradualTransition: true}
+
+	generate := func(progress float64) *proto.PriceUpdate {
+		at := startTime.Add(time.Duration(progress * float64(endTime.Sub(startTime))))
+		return handler.generateScenarioPrice("BTC-USD", proto.ScenarioType_LIQUIDITY_DROUGHT, params, basePrice, at, startTime, endTime)
+	}
+
+	before := generate(0)
+	trough := generate(0.5)
+	after := generate(1)
+
+	// Spreads widen and depth and trading dry up at the trough
+	assert.Greater(t, trough.Quote.SpreadBps, 5*before.Quote.SpreadBps)
+	assert.Less(t, trough.Quote.BidSize, before.Quote.BidSize/5)
+	assert.Less(t, trough.TradeCount, before.TradeCount)
+
+	// The mid only moves modestly
+	assert.InDelta(t, basePrice, trough.Price, basePrice*0.005)
+
+	// Conditions recover by the end
+	assert.InDelta(t, before.Quote.SpreadBps, after.Quote.SpreadBps, 1e-9)
+}
@@ -0,0 +1,226 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/services"
+)
+
+func (h *MarketDataGRPCHandler) GetOptionChain(ctx context.Context, req *proto.GetOptionChainRequest) (*proto.OptionChain, error) {
+	h.logger.WithField("series", req.Series).Info("GetOptionChain request received")
+
+	series, err := h.optionSeries(req.Series)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkAPIVersion(req.ApiVersion); err != nil {
+		return nil, err
+	}
+
+	// Read the listing and the shared market as a stream tick would
+	h.marketDataService.Advance(time.Now())
+	contracts := h.marketDataService.ListedOptions(series)
+	symbols := make([]string, 0, len(contracts))
+	for _, contract := range contracts {
+		symbols = append(symbols, contract.Symbol)
+	}
+	market := h.marketDataService.Snapshot(symbols, h.marketDataService.EventSequence())
+
+	updates := make([]*proto.PriceUpdate, 0, len(contracts))
+	for _, contract := range contracts {
+		update := h.optionUpdate(contract, market, market.At)
+		h.roundUpdate(update)
+		if req.ApiVersion == proto.ApiVersion_API_V2 {
+			h.attachDecimals(update)
+		}
+		updates = append(updates, update)
+	}
+	chain := optionChain(series, updates)
+	if req.Expiry == nil {
+		return chain, nil
+	}
+	for _, expiry := range chain.Expiries {
+		if expiry.Expiry.AsTime().Equal(req.Expiry.AsTime()) {
+			chain.Expiries = []*proto.OptionExpiry{expiry}
+			return chain, nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "%s has no listed expiry at %s", series.Root, req.Expiry.AsTime().UTC().Format(time.RFC3339))
+}
+
+// StreamOptionChain streams a series' options like StreamPrices streams
+// symbols, writing each tick's option updates as one chain. The session
+// follows the series' pattern, so expiries listed later join the chain.
+func (h *MarketDataGRPCHandler) StreamOptionChain(req *proto.StreamOptionChainRequest, stream proto.MarketDataService_StreamOptionChainServer) error {
+	h.logger.WithFields(logrus.Fields{
+		"series":   req.Series,
+		"interval": req.UpdateIntervalMs,
+	}).Info("Starting option chain stream")
+
+	series, err := h.optionSeries(req.Series)
+	if err != nil {
+		return err
+	}
+	if err := checkAPIVersion(req.ApiVersion); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	sessionID := fmt.Sprintf("options_%d", time.Now().UnixNano())
+	session := h.newStreamSession(ctx, cancel, sessionID, []string{series.Root + "-*"}, clampUpdateInterval(req.UpdateIntervalMs))
+	session.apiVersion = req.ApiVersion
+	session.method = "StreamOptionChain"
+	if err := h.resolveSymbols(session); err != nil {
+		cancel()
+		return err
+	}
+
+	h.registerSession(session)
+	defer h.unregisterSession(session)
+
+	return h.runPriceStream(session, sendChains(series, stream))
+}
+
+// sendChains writes each flushed batch, the option updates of one tick, as
+// a chain. Ticks without options, such as while the underlying is halted,
+// send nothing.
+func sendChains(series config.OptionSeries, stream proto.MarketDataService_StreamOptionChainServer) updateWriter {
+	return func(batch []*proto.PriceUpdate) error {
+		chain := optionChain(series, batch)
+		if len(chain.Expiries) == 0 {
+			return nil
+		}
+		return stream.Send(chain)
+	}
+}
+
+// optionSeries validates a chain request's series
+func (h *MarketDataGRPCHandler) optionSeries(root string) (config.OptionSeries, error) {
+	if root == "" {
+		return config.OptionSeries{}, status.Errorf(codes.InvalidArgument, "series is required")
+	}
+	series, exists := h.marketDataService.OptionSeries(root)
+	if !exists {
+		return config.OptionSeries{}, status.Errorf(codes.NotFound, "unknown option series %q", root)
+	}
+	if err := h.requireInstruments([]string{series.Underlying}); err != nil {
+		return config.OptionSeries{}, err
+	}
+	return series, nil
+}
+
+// optionQuoting reports whether options on an underlying quote: while it
+// trades continuously and is not halted
+func optionQuoting(underlying services.MarketTick) bool {
+	return underlying.Phase == services.PhaseContinuous && underlying.Status != services.StatusHalted
+}
+
+// optionUpdate reports an option priced off its underlying in the market
+// snapshot; its price is the mark and its quote the volatility spread
+func (h *MarketDataGRPCHandler) optionUpdate(contract services.OptionContract, market services.MarketSnapshot, at time.Time) *proto.PriceUpdate {
+	tick := market.Ticks[contract.Symbol]
+	option := tick.Option
+
+	optionType := proto.OptionType_PUT
+	if contract.Call {
+		optionType = proto.OptionType_CALL
+	}
+	// Worthless options still ask a tick
+	ask := option.Ask
+	if instrument, exists := h.instrument(contract.Symbol); exists {
+		ask = math.Max(ask, instrument.TickSize)
+	}
+
+	return &proto.PriceUpdate{
+		Symbol:    contract.Symbol,
+		Price:     tick.Price,
+		Timestamp: timestamppb.New(at),
+		Source:    "market-data-simulator",
+		ChangeInfo: &proto.PriceChangeInfo{
+			DailyHigh: tick.Price * 1.02,
+			DailyLow:  tick.Price * 0.98,
+		},
+		Quote: &proto.Quote{Bid: option.Bid, Ask: ask},
+		Option: &proto.OptionInfo{
+			Series:            contract.Series.Root,
+			UnderlyingSymbol:  contract.Series.Underlying,
+			UnderlyingPrice:   option.UnderlyingPrice,
+			Expiry:            timestamppb.New(contract.Expiry),
+			Type:              optionType,
+			Strike:            contract.Strike,
+			Forward:           option.Forward,
+			AtmVolatility:     option.ATMVolatility,
+			ImpliedVolatility: option.ImpliedVolatility,
+			Greeks: &proto.OptionGreeks{
+				Delta: option.Greeks.Delta,
+				Gamma: option.Greeks.Gamma,
+				Vega:  option.Greeks.Vega,
+				Theta: option.Greeks.Theta,
+				Rho:   option.Greeks.Rho,
+			},
+		},
+	}
+}
+
+// optionChain assembles a series' option updates into a chain, nearest
+// expiry first, by strike, call before put
+func optionChain(series config.OptionSeries, updates []*proto.PriceUpdate) *proto.OptionChain {
+	chain := &proto.OptionChain{Series: series.Root, UnderlyingSymbol: series.Underlying}
+
+	var options []*proto.PriceUpdate
+	for _, update := range updates {
+		if update.Option != nil && update.Option.Series == series.Root {
+			options = append(options, update)
+		}
+	}
+	sort.SliceStable(options, func(i, j int) bool {
+		a, b := options[i].Option, options[j].Option
+		if !a.Expiry.AsTime().Equal(b.Expiry.AsTime()) {
+			return a.Expiry.AsTime().Before(b.Expiry.AsTime())
+		}
+		if a.Strike != b.Strike {
+			return a.Strike < b.Strike
+		}
+		return a.Type == proto.OptionType_CALL && b.Type != proto.OptionType_CALL
+	})
+
+	var entry *proto.OptionExpiry
+	for _, update := range options {
+		info := update.Option
+		if entry == nil || !entry.Expiry.AsTime().Equal(info.Expiry.AsTime()) {
+			entry = &proto.OptionExpiry{Expiry: info.Expiry, Forward: info.Forward, AtmVolatility: info.AtmVolatility}
+			chain.Expiries = append(chain.Expiries, entry)
+		}
+		chain.UnderlyingPrice = info.UnderlyingPrice
+		chain.UnderlyingPriceDecimal = info.UnderlyingPriceDecimal
+		chain.Timestamp = update.Timestamp
+		chain.Sequence = max(chain.Sequence, update.Sequence)
+
+		quote := &proto.OptionQuote{
+			Symbol:            update.Symbol,
+			Type:              info.Type,
+			Strike:            info.Strike,
+			Mark:              update.Price,
+			ImpliedVolatility: info.ImpliedVolatility,
+			Greeks:            info.Greeks,
+			MarkDecimal:       update.PriceDecimal,
+		}
+		if update.Quote != nil {
+			quote.Bid, quote.Ask = update.Quote.Bid, update.Quote.Ask
+			quote.BidDecimal, quote.AskDecimal = update.Quote.BidDecimal, update.Quote.AskDecimal
+		}
+		entry.Options = append(entry.Options, quote)
+	}
+	return chain
+}
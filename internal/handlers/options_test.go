@@ -0,0 +1,183 @@
+package handlers
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
+)
+
+func setupOptionHandler() *MarketDataGRPCHandler {
+	return setupHandlerWith(func(cfg *config.Config) {
+		cfg.Options = []config.OptionSeries{{
+			Root:              "BTC-OPT",
+			Underlying:        "BTC-USD",
+			Cycle:             config.FutureCycleMonthly,
+			Expiries:          2,
+			Strikes:           2,
+			StrikeStepPercent: 5,
+		}}
+		cfg.VolSurface = config.VolSurface{ATM: 0.6, TermSlope: -0.02, Skew: -0.1, Smile: 0.3}
+		cfg.OptionInterestRate = 0.05
+		cfg.OptionVolSpread = 0.01
+	})
+}
+
+// mockOptionChainStream collects chains sent on a server stream
+type mockOptionChainStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	mu     sync.Mutex
+	chains []*proto.OptionChain
+}
+
+func (m *mockOptionChainStream) Send(chain *proto.OptionChain) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.chains = append(m.chains, chain)
+	return nil
+}
+
+func (m *mockOptionChainStream) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockOptionChainStream) Chains() []*proto.OptionChain {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*proto.OptionChain(nil), m.chains...)
+}
+
+func TestMarketDataGRPCHandler_GetOptionChain(t *testing.T) {
+	handler := setupOptionHandler()
+
+	chain, err := handler.GetOptionChain(context.Background(), &proto.GetOptionChainRequest{Series: "BTC-OPT"})
+	require.NoError(t, err)
+	assert.Equal(t, "BTC-USD", chain.UnderlyingSymbol)
+	assert.Equal(t, 100.0, chain.UnderlyingPrice)
+	require.Len(t, chain.Expiries, 2)
+
+	for _, expiry := range chain.Expiries {
+		assert.Greater(t, expiry.Forward, chain.UnderlyingPrice, "positive rates carry the forward above spot")
+		require.Len(t, expiry.Options, 10, "five strikes, a call and a put each")
+
+		for i := 0; i < len(expiry.Options); i += 2 {
+			call, put := expiry.Options[i], expiry.Options[i+1]
+			assert.Equal(t, proto.OptionType_CALL, call.Type)
+			assert.Equal(t, proto.OptionType_PUT, put.Type)
+			assert.Equal(t, call.Strike, put.Strike)
+			assert.Equal(t, call.ImpliedVolatility, put.ImpliedVolatility)
+			assert.Regexp(t, `^BTC-OPT-\d{8}-\d+-C$`, call.Symbol)
+
+			for _, quote := range []*proto.OptionQuote{call, put} {
+				assert.LessOrEqual(t, quote.Bid, quote.Mark)
+				assert.Less(t, quote.Mark, quote.Ask)
+				assert.Greater(t, quote.Greeks.Gamma, 0.0)
+				assert.Greater(t, quote.Greeks.Vega, 0.0)
+			}
+			assert.Greater(t, call.Greeks.Delta, 0.0)
+			assert.Less(t, put.Greeks.Delta, 0.0)
+		}
+	}
+	assert.Equal(t, []float64{90, 95, 100, 105, 110}, []float64{
+		chain.Expiries[0].Options[0].Strike,
+		chain.Expiries[0].Options[2].Strike,
+		chain.Expiries[0].Options[4].Strike,
+		chain.Expiries[0].Options[6].Strike,
+		chain.Expiries[0].Options[8].Strike,
+	})
+
+	// One expiry on request
+	single, err := handler.GetOptionChain(context.Background(), &proto.GetOptionChainRequest{Series: "BTC-OPT", Expiry: chain.Expiries[1].Expiry})
+	require.NoError(t, err)
+	require.Len(t, single.Expiries, 1)
+	assert.Equal(t, chain.Expiries[1].Expiry.AsTime(), single.Expiries[0].Expiry.AsTime())
+}
+
+func TestMarketDataGRPCHandler_GetOptionChain_Errors(t *testing.T) {
+	handler := setupOptionHandler()
+
+	tests := []struct {
+		name string
+		req  *proto.GetOptionChainRequest
+		code codes.Code
+	}{
+		{"missing series", &proto.GetOptionChainRequest{}, codes.InvalidArgument},
+		{"unknown series", &proto.GetOptionChainRequest{Series: "ETH-OPT"}, codes.NotFound},
+		{"unlisted expiry", &proto.GetOptionChainRequest{Series: "BTC-OPT", Expiry: timestamppb.New(time.Date(2020, 1, 31, 8, 0, 0, 0, time.UTC))}, codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := handler.GetOptionChain(context.Background(), tt.req)
+			assert.Equal(t, tt.code, status.Code(err))
+		})
+	}
+}
+
+func TestMarketDataGRPCHandler_StreamOptionChain(t *testing.T) {
+	handler := setupOptionHandler()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := &mockOptionChainStream{ctx: ctx}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- handler.StreamOptionChain(&proto.StreamOptionChainRequest{Series: "BTC-OPT", UpdateIntervalMs: 100}, stream)
+	}()
+
+	require.Eventually(t, func() bool { return len(stream.Chains()) >= 3 }, 2*time.Second, 20*time.Millisecond)
+	cancel()
+	<-done
+
+	chains := stream.Chains()
+	moved := false
+	for i, chain := range chains {
+		// Every option is a sequenced update of the stream
+		assert.Equal(t, uint64(20*(i+1)), chain.Sequence)
+		// Strikes stay listed while the underlying moves
+		assert.Equal(t, chains[0].Expiries[0].Options[0].Strike, chain.Expiries[0].Options[0].Strike)
+		if chain.UnderlyingPrice != chains[0].UnderlyingPrice {
+			moved = true
+			assert.NotEqual(t, chains[0].Expiries[0].Options[0].Greeks.Delta, chain.Expiries[0].Options[0].Greeks.Delta)
+		}
+	}
+	assert.True(t, moved, "the chain follows the underlying")
+}
+
+func TestPublishTick_Option(t *testing.T) {
+	handler := setupOptionHandler()
+
+	series := handler.config.Options[0]
+	contract := handler.marketDataService.ListedOptions(series)[0]
+	session, published := publishTicks(t, handler, []string{contract.Symbol}, time.Now(), 1)
+	assert.Equal(t, []string{"BTC-USD"}, session.legs)
+	require.Len(t, published, 1)
+
+	update := published[0]
+	require.NotNil(t, update.Option)
+	assert.Equal(t, uint64(1), update.Sequence)
+	assert.Equal(t, contract.Strike, update.Option.Strike)
+	assert.Equal(t, proto.OptionType_CALL, update.Option.Type)
+	assert.Equal(t, session.market.Price("BTC-USD"), update.Option.UnderlyingPrice)
+	assert.Equal(t, handler.roundPrice(contract.Symbol, session.market.Price(contract.Symbol)), update.Price)
+	assert.LessOrEqual(t, update.Quote.Bid, update.Price)
+	assert.Less(t, update.Price, update.Quote.Ask)
+}
+
+func TestMarketDataGRPCHandler_StreamOptionChain_UnknownSeries(t *testing.T) {
+	handler := setupOptionHandler()
+
+	err := handler.StreamOptionChain(&proto.StreamOptionChainRequest{Series: "ETH-OPT"}, &mockOptionChainStream{ctx: context.Background()})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,241 @@
+package handlers
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
+)
+
+const defaultOutboundQueueSize = 256
+
+// outboundQueue decouples a session's tick loop from its network sender so a
+// slow client cannot stall tick generation. Updates are pushed into an open
+// batch and handed to the sender when the tick loop flushes, so the sender
+// never sees half a tick. When full, the overflow policy decides what gives:
+// the oldest update, a stale update of the same symbol, or the connection.
+type outboundQueue struct {
+	mu       sync.Mutex
+	batches  [][]*proto.PriceUpdate // Flushed batches, oldest first
+	open     []*proto.PriceUpdate   // Pushed since the last flush
+	size     int
+	capacity int
+	policy   proto.OverflowPolicy
+	ready    chan struct{}
+	dropped  uint64
+}
+
+func newOutboundQueue(capacity int, policy proto.OverflowPolicy) *outboundQueue {
+	if capacity <= 0 {
+		capacity = defaultOutboundQueueSize
+	}
+	if policy == proto.OverflowPolicy_OVERFLOW_DEFAULT {
+		policy = proto.OverflowPolicy_DROP_OLDEST
+	}
+	return &outboundQueue{
+		capacity: capacity,
+		policy:   policy,
+		ready:    make(chan struct{}, 1),
+	}
+}
+
+// push adds an update to the open batch and reports how many queued updates
+// were dropped to make room. Under the DISCONNECT policy a full queue is an
+// error.
+func (q *outboundQueue) push(update *proto.PriceUpdate) (int, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	dropped := 0
+	if q.size >= q.capacity {
+		switch q.policy {
+		case proto.OverflowPolicy_DISCONNECT:
+			return 0, status.Errorf(codes.ResourceExhausted, "slow consumer: outbound queue of %d updates is full", q.capacity)
+		case proto.OverflowPolicy_CONFLATE:
+			// Drop the queued update of the same symbol and venue, falling back to the oldest
+			if !q.removeFirstLocked(func(queued *proto.PriceUpdate) bool {
+				return queued.Symbol == update.Symbol && queued.Venue == update.Venue
+			}) {
+				q.removeFirstLocked(func(*proto.PriceUpdate) bool { return true })
+			}
+		default:
+			q.removeFirstLocked(func(*proto.PriceUpdate) bool { return true })
+		}
+		dropped = 1
+		q.dropped++
+	}
+
+	q.open = append(q.open, update)
+	q.size++
+	return dropped, nil
+}
+
+// flush closes the open batch and wakes the sender
+func (q *outboundQueue) flush() {
+	q.mu.Lock()
+	if len(q.open) > 0 {
+		q.batches = append(q.batches, q.open)
+		q.open = nil
+	}
+	q.mu.Unlock()
+
+	select {
+	case q.ready <- struct{}{}:
+	default:
+	}
+}
+
+// drain removes and returns every flushed batch
+func (q *outboundQueue) drain() [][]*proto.PriceUpdate {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	batches := q.batches
+	q.batches = nil
+	for _, batch := range batches {
+		q.size -= len(batch)
+	}
+	return batches
+}
+
+func (q *outboundQueue) depth() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.size
+}
+
+// removeFirstLocked drops the oldest queued update matching the predicate,
+// flushed batches first. Callers must hold mu.
+func (q *outboundQueue) removeFirstLocked(match func(*proto.PriceUpdate) bool) bool {
+	for i, batch := range q.batches {
+		for j, queued := range batch {
+			if !match(queued) {
+				continue
+			}
+			q.batches[i] = append(batch[:j:j], batch[j+1:]...)
+			if len(q.batches[i]) == 0 {
+				q.batches = append(q.batches[:i], q.batches[i+1:]...)
+			}
+			q.size--
+			return true
+		}
+	}
+	for j, queued := range q.open {
+		if match(queued) {
+			q.open = append(q.open[:j:j], q.open[j+1:]...)
+			q.size--
+			return true
+		}
+	}
+	return false
+}
+
+// parseOverflowPolicy maps the STREAM_OVERFLOW_POLICY setting onto the enum,
+// defaulting to DROP_OLDEST for unknown values
+func parseOverflowPolicy(value string) proto.OverflowPolicy {
+	switch strings.ToLower(value) {
+	case "conflate":
+		return proto.OverflowPolicy_CONFLATE
+	case "disconnect":
+		return proto.OverflowPolicy_DISCONNECT
+	default:
+		return proto.OverflowPolicy_DROP_OLDEST
+	}
+}
+
+// Stream backpressure metrics. Labels are limited to the RPC and policy to
+// keep cardinality low.
+const (
+	metricStreamQueueDepth     = "stream_outbound_queue_depth"
+	metricStreamSendLag        = "stream_send_lag_seconds"
+	metricStreamUpdatesDropped = "stream_updates_dropped_total"
+	metricStreamSlowConsumers  = "stream_slow_consumer_disconnects_total"
+)
+
+// publish sequences a finished update and adds it to the session's open
+// batch; the caller flushes once the tick is complete. Drops still consume a
+// sequence number, so clients see them as recoverable gaps.
+func (h *MarketDataGRPCHandler) publish(session *StreamSession, update *proto.PriceUpdate) error {
+	if session.apiVersion == proto.ApiVersion_API_V2 {
+		h.attachDecimals(update)
+	}
+	session.stamp(update)
+
+	dropped, err := session.out.push(update)
+	if err != nil {
+		h.logger.WithFields(logrus.Fields{
+			"session_id": session.id,
+			"sequence":   update.Sequence,
+		}).Warn("Disconnecting slow consumer")
+		h.incCounter(metricStreamSlowConsumers, map[string]string{"rpc": session.method})
+		return err
+	}
+	if dropped > 0 {
+		h.incCounter(metricStreamUpdatesDropped, map[string]string{
+			"rpc":    session.method,
+			"policy": session.out.policy.String(),
+		})
+	}
+	h.setGauge(metricStreamQueueDepth, float64(session.out.depth()), map[string]string{"rpc": session.method})
+	return nil
+}
+
+// updateWriter writes one flushed batch of updates to a client stream
+type updateWriter func(batch []*proto.PriceUpdate) error
+
+// startSender drains the session's outbound queue onto the stream until the
+// session ends. It is the only goroutine that writes to the stream once
+// started; a write failure is reported on the returned channel.
+func (h *MarketDataGRPCHandler) startSender(session *StreamSession, write updateWriter) <-chan error {
+	ctx, queue := session.ctx, session.out
+	sendErr := make(chan error, 1)
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-queue.ready:
+			}
+
+			for _, batch := range queue.drain() {
+				if ctx.Err() != nil {
+					return
+				}
+				if err := write(batch); err != nil {
+					h.logger.WithError(err).WithField("session_id", session.id).Error("Failed to send price update")
+					sendErr <- err
+					return
+				}
+				for _, update := range batch {
+					h.observeHistogram(metricStreamSendLag, time.Since(update.Timestamp.AsTime()).Seconds(), map[string]string{"rpc": session.method})
+				}
+			}
+		}
+	}()
+
+	return sendErr
+}
+
+func (h *MarketDataGRPCHandler) incCounter(name string, labels map[string]string) {
+	if h.metrics != nil {
+		h.metrics.IncCounter(name, labels)
+	}
+}
+
+func (h *MarketDataGRPCHandler) observeHistogram(name string, value float64, labels map[string]string) {
+	if h.metrics != nil {
+		h.metrics.ObserveHistogram(name, value, labels)
+	}
+}
+
+func (h *MarketDataGRPCHandler) setGauge(name string, value float64, labels map[string]string) {
+	if h.metrics != nil {
+		h.metrics.SetGauge(name, value, labels)
+	}
+}
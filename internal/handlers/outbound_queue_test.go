@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
+)
+
+func queuedSequences(batches [][]*proto.PriceUpdate) []uint64 {
+	var sequences []uint64
+	for _, batch := range batches {
+		for _, update := range batch {
+			sequences = append(sequences, update.Sequence)
+		}
+	}
+	return sequences
+}
+
+func TestOutboundQueue_DropOldest(t *testing.T) {
+	queue := newOutboundQueue(2, proto.OverflowPolicy_DROP_OLDEST)
+
+	for seq := uint64(1); seq <= 3; seq++ {
+		dropped, err := queue.push(&proto.PriceUpdate{Symbol: "BTC-USD", Sequence: seq})
+		require.NoError(t, err)
+		if seq == 3 {
+			assert.Equal(t, 1, dropped)
+		}
+	}
+	queue.flush()
+
+	assert.Equal(t, []uint64{2, 3}, queuedSequences(queue.drain()))
+	assert.Equal(t, uint64(1), queue.dropped)
+	assert.Equal(t, 0, queue.depth())
+}
+
+func TestOutboundQueue_Conflate(t *testing.T) {
+	queue := newOutboundQueue(2, proto.OverflowPolicy_CONFLATE)
+
+	queue.push(&proto.PriceUpdate{Symbol: "BTC-USD", Sequence: 1})
+	queue.push(&proto.PriceUpdate{Symbol: "ETH-USD", Sequence: 2})
+	dropped, err := queue.push(&proto.PriceUpdate{Symbol: "ETH-USD", Sequence: 3})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, dropped)
+	queue.flush()
+	// The stale ETH-USD update is replaced; BTC-USD survives
+	assert.Equal(t, []uint64{1, 3}, queuedSequences(queue.drain()))
+}
+
+func TestOutboundQueue_Disconnect(t *testing.T) {
+	queue := newOutboundQueue(1, proto.OverflowPolicy_DISCONNECT)
+
+	_, err := queue.push(&proto.PriceUpdate{Symbol: "BTC-USD", Sequence: 1})
+	require.NoError(t, err)
+
+	_, err = queue.push(&proto.PriceUpdate{Symbol: "BTC-USD", Sequence: 2})
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, 1, queue.depth())
+}
+
+func TestOutboundQueue_FlushBoundaries(t *testing.T) {
+	queue := newOutboundQueue(10, proto.OverflowPolicy_DROP_OLDEST)
+
+	queue.push(&proto.PriceUpdate{Symbol: "BTC-USD", Sequence: 1})
+	queue.push(&proto.PriceUpdate{Symbol: "ETH-USD", Sequence: 2})
+	queue.flush()
+	queue.push(&proto.PriceUpdate{Symbol: "BTC-USD", Sequence: 3})
+
+	// Unflushed updates stay queued
+	batches := queue.drain()
+	require.Len(t, batches, 1)
+	assert.Equal(t, []uint64{1, 2}, queuedSequences(batches))
+	assert.Equal(t, 1, queue.depth())
+
+	queue.flush()
+	assert.Equal(t, []uint64{3}, queuedSequences(queue.drain()))
+	assert.Equal(t, 0, queue.depth())
+}
+
+func TestOutboundQueue_DropAcrossBatches(t *testing.T) {
+	queue := newOutboundQueue(2, proto.OverflowPolicy_DROP_OLDEST)
+
+	queue.push(&proto.PriceUpdate{Symbol: "BTC-USD", Sequence: 1})
+	queue.flush()
+	queue.push(&proto.PriceUpdate{Symbol: "BTC-USD", Sequence: 2})
+	queue.push(&proto.PriceUpdate{Symbol: "BTC-USD", Sequence: 3})
+	queue.flush()
+
+	// The emptied first batch disappears entirely
+	batches := queue.drain()
+	require.Len(t, batches, 1)
+	assert.Equal(t, []uint64{2, 3}, queuedSequences(batches))
+}
+
+func TestOutboundQueue_Defaults(t *testing.T) {
+	queue := newOutboundQueue(0, proto.OverflowPolicy_OVERFLOW_DEFAULT)
+
+	assert.Equal(t, defaultOutboundQueueSize, queue.capacity)
+	assert.Equal(t, proto.OverflowPolicy_DROP_OLDEST, queue.policy)
+}
+
+func TestParseOverflowPolicy(t *testing.T) {
+	assert.Equal(t, proto.OverflowPolicy_DROP_OLDEST, parseOverflowPolicy("drop_oldest"))
+	assert.Equal(t, proto.OverflowPolicy_CONFLATE, parseOverflowPolicy("conflate"))
+	assert.Equal(t, proto.OverflowPolicy_DISCONNECT, parseOverflowPolicy("DISCONNECT"))
+	assert.Equal(t, proto.OverflowPolicy_DROP_OLDEST, parseOverflowPolicy("unknown"))
+}
@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"math/rand"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/services"
+)
+
+// perpetualUpdate reports a perpetual priced off its index in the market
+// snapshot, with the funding settled since the session's last tick
+func (h *MarketDataGRPCHandler) perpetualUpdate(symbol string, perpetual config.Perpetual, session *StreamSession, market services.MarketSnapshot, at time.Time) *proto.PriceUpdate {
+	tick := market.Ticks[symbol]
+	state := tick.Perpetual
+
+	var event *proto.MarketEvent
+	if fundings := market.EventsOf(symbol, services.EventFunding); len(fundings) > 0 {
+		event = marketEvent(fundings[len(fundings)-1])
+	}
+
+	price := tick.Price
+
+	info := &proto.PerpetualInfo{
+		IndexSymbol:            perpetual.Index,
+		IndexPrice:             state.IndexPrice,
+		MarkPrice:              state.MarkPrice,
+		BasisBps:               state.BasisBps,
+		FundingRate:            state.FundingRate,
+		NextFundingTime:        timestamppb.New(state.NextFunding),
+		FundingIntervalSeconds: int64(perpetual.FundingInterval / time.Second),
+		LastFundingRate:        state.LastFundingRate,
+	}
+	if !state.LastFunding.IsZero() {
+		info.LastFundingTime = timestamppb.New(state.LastFunding)
+	}
+
+	volume := 1000 + rand.Float64()*9000
+	update := &proto.PriceUpdate{
+		Symbol:    symbol,
+		Price:     price,
+		Volume:    volume,
+		Timestamp: timestamppb.New(at),
+		Source:    "market-data-simulator",
+		Event:     event,
+		ChangeInfo: &proto.PriceChangeInfo{
+			DailyHigh:   price * 1.02,
+			DailyLow:    price * 0.98,
+			DailyVolume: volume * 100,
+		},
+		Perpetual: info,
+	}
+	attachLiquidity(update, normalLiquidity, session.updateInterval)
+
+	return update
+}
@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
+)
+
+func setupPerpetualHandler() *MarketDataGRPCHandler {
+	return setupHandlerWith(func(cfg *config.Config) {
+		cfg.Perpetuals = []config.Perpetual{{Symbol: "BTC-PERP", Index: "BTC-USD", FundingInterval: time.Hour}}
+		cfg.PerpetualBasisBps = 10
+		cfg.PerpetualBasisHalfLife = time.Minute
+		cfg.PerpetualInterestRate = 0.0001
+	})
+}
+
+func TestOrderDerived_Perpetuals(t *testing.T) {
+	handler := setupPerpetualHandler()
+
+	symbols, legs := handler.orderDerived([]string{"BTC-PERP", "SOL-USD"}, nil)
+	assert.Equal(t, []string{"SOL-USD", "BTC-PERP"}, symbols)
+	assert.Equal(t, []string{"BTC-USD"}, legs, "the index moves unpublished")
+}
+
+func TestPublishTick_PerpetualFunding(t *testing.T) {
+	handler := setupPerpetualHandler()
+
+	funding := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	_, published := publishTicks(t, handler, []string{"BTC-PERP"}, funding.Add(-10*time.Second), 13)
+	require.Len(t, published, 13, "only the perpetual is published")
+
+	before := published[0]
+	require.NotNil(t, before.Perpetual)
+	assert.Equal(t, "BTC-PERP", before.Symbol)
+	assert.Equal(t, "BTC-USD", before.Perpetual.IndexSymbol)
+	assert.Equal(t, funding, before.Perpetual.NextFundingTime.AsTime())
+	assert.Equal(t, int64(3600), before.Perpetual.FundingIntervalSeconds)
+	assert.Nil(t, before.Perpetual.LastFundingTime)
+	assert.InDelta(t, before.Perpetual.IndexPrice*(1+before.Perpetual.BasisBps/10000), before.Price, 0.01)
+	// Ten seconds before funding almost none of the predicted rate is left to accrue
+	assert.InDelta(t, before.Perpetual.IndexPrice, before.Perpetual.MarkPrice, before.Perpetual.IndexPrice*0.0075*10/3600+0.01)
+
+	// Funding settles on the first tick at or after the funding time
+	var settled []*proto.PriceUpdate
+	for _, update := range published {
+		if update.Event != nil {
+			settled = append(settled, update)
+		}
+	}
+	require.Len(t, settled, 1)
+	settlement := settled[0]
+	assert.Equal(t, funding, settlement.Timestamp.AsTime())
+	assert.Equal(t, proto.MarketEventType_FUNDING, settlement.Event.Type)
+	assert.Equal(t, funding, settlement.Perpetual.LastFundingTime.AsTime())
+	assert.Equal(t, funding.Add(time.Hour), settlement.Perpetual.NextFundingTime.AsTime())
+	assert.LessOrEqual(t, settlement.Perpetual.LastFundingRate, 0.0075)
+	assert.GreaterOrEqual(t, settlement.Perpetual.LastFundingRate, -0.0075)
+	assert.Equal(t, settlement.Perpetual.LastFundingRate, published[len(published)-1].Perpetual.LastFundingRate)
+}
+
+func TestMarketDataGRPCHandler_StreamPrices_Perpetual(t *testing.T) {
+	handler := setupPerpetualHandler()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := newMockPriceStream(ctx)
+
+	go handler.StreamPrices(&proto.StreamPricesRequest{
+		Symbols:          []string{"BTC-PERP", "BTC-USD"},
+		UpdateIntervalMs: 100,
+		ApiVersion:       proto.ApiVersion_API_V2,
+	}, stream)
+
+	require.Eventually(t, func() bool { return len(stream.Updates()) >= 4 }, 2*time.Second, 10*time.Millisecond)
+
+	updates := stream.Updates()
+	spot, perpetual := updates[0], updates[1]
+	assert.Equal(t, "BTC-USD", spot.Symbol)
+	require.Equal(t, "BTC-PERP", perpetual.Symbol)
+	require.NotNil(t, perpetual.Perpetual)
+	assert.Equal(t, spot.Price, perpetual.Perpetual.IndexPrice, "the swap is priced off the same tick's index")
+	assert.NotNil(t, perpetual.Quote)
+	assert.Equal(t, toDecimal(perpetual.Perpetual.MarkPrice, 2), perpetual.Perpetual.MarkPriceDecimal)
+}
@@ -0,0 +1,28 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/services"
+)
+
+// bandEvents are the symbol's halts, resumes and limit events in the tick
+func bandEvents(market services.MarketSnapshot, symbol string) []services.MarketEvent {
+	var events []services.MarketEvent
+	for _, event := range market.Events {
+		if event.Symbol != symbol {
+			continue
+		}
+		switch event.Type {
+		case services.EventHalt, services.EventResume, services.EventLimitReached, services.EventLimitReleased:
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+// bandEventUpdate reports a price band event at the price it happened at
+func (h *MarketDataGRPCHandler) bandEventUpdate(symbol string, event services.MarketEvent, at time.Time) *proto.PriceUpdate {
+	return h.marketEventUpdate(symbol, event.Price, proto.TradingStatus(event.Status), marketEvent(event), at)
+}
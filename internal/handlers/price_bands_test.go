@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
+)
+
+func TestMarketDataGRPCHandler_StreamPrices_HaltAndResume(t *testing.T) {
+	handler := setupHandler()
+	handler.config.PriceBands = []config.PriceBandRule{
+		{Symbol: "BTC-USD", MovePercent: 0.05, Window: time.Minute, Halt: true, HaltDuration: 300 * time.Millisecond},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := newMockPriceStream(ctx)
+
+	go handler.StreamPrices(&proto.StreamPricesRequest{
+		Symbols:          []string{"BTC-USD"},
+		UpdateIntervalMs: 100,
+	}, stream)
+
+	eventTypes := func() []proto.MarketEventType {
+		var types []proto.MarketEventType
+		for _, update := range stream.Updates() {
+			if update.Event != nil {
+				types = append(types, update.Event.Type)
+			}
+		}
+		return types
+	}
+
+	require.Eventually(t, func() bool {
+		types := eventTypes()
+		return len(types) >= 2 && types[0] == proto.MarketEventType_HALT && types[1] == proto.MarketEventType_RESUME
+	}, 3*time.Second, 10*time.Millisecond)
+
+	// Nothing trades while halted
+	updates := stream.Updates()
+	for i, update := range updates {
+		if update.Event != nil && update.Event.Type == proto.MarketEventType_HALT {
+			next := updates[i+1]
+			assert.Equal(t, proto.MarketEventType_RESUME, next.Event.Type)
+			assert.GreaterOrEqual(t, next.Timestamp.AsTime().Sub(update.Timestamp.AsTime()), 300*time.Millisecond)
+			break
+		}
+	}
+}
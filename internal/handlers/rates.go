@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/services"
+)
+
+// defaultCurveTenors are the points of a curve snapshot when none are requested
+var defaultCurveTenors = []float64{0.25, 0.5, 1, 2, 3, 5, 7, 10, 20, 30}
+
+func (h *MarketDataGRPCHandler) GetYieldCurve(ctx context.Context, req *proto.GetYieldCurveRequest) (*proto.YieldCurve, error) {
+	h.logger.WithField("tenors", req.TenorsYears).Info("GetYieldCurve request received")
+
+	tenors := req.TenorsYears
+	if len(tenors) == 0 {
+		tenors = defaultCurveTenors
+	}
+	for _, tenor := range tenors {
+		if !(tenor > 0) || math.IsInf(tenor, 0) {
+			return nil, status.Errorf(codes.InvalidArgument, "tenors must be positive numbers of years")
+		}
+	}
+
+	// The curve bonds are priced off in the shared market
+	h.marketDataService.Advance(time.Now())
+	market := h.marketDataService.Snapshot(nil, h.marketDataService.EventSequence())
+	curve, factors := h.marketDataService.YieldCurve(), market.Curve
+	snapshot := &proto.YieldCurve{
+		Currency:    curve.Currency,
+		Factors:     curveFactorsProto(factors),
+		LambdaYears: curve.Lambda,
+		Timestamp:   timestamppb.New(market.At),
+	}
+	for _, tenor := range tenors {
+		rate := factors.ZeroRate(tenor, curve.Lambda)
+		snapshot.Points = append(snapshot.Points, &proto.YieldCurvePoint{
+			TenorYears:      tenor,
+			ZeroRatePercent: rate * 100,
+			DiscountFactor:  math.Exp(-rate * tenor),
+		})
+	}
+	return snapshot, nil
+}
+
+// bondUpdate reports a bond priced off the shared yield curve, with any
+// rate shock since the session's last tick
+func (h *MarketDataGRPCHandler) bondUpdate(symbol string, bond config.Bond, session *StreamSession, market services.MarketSnapshot, at time.Time) *proto.PriceUpdate {
+	curve := h.config.YieldCurve
+	tick := market.Ticks[symbol]
+	valuation, factors := tick.Bond.Valuation, tick.Bond.Factors
+
+	var event *proto.MarketEvent
+	if shocks := market.EventsOf("", services.EventRateShock); len(shocks) > 0 {
+		event = marketEvent(shocks[len(shocks)-1])
+	}
+
+	price := tick.Price
+
+	volume := 1000 + rand.Float64()*9000
+	update := &proto.PriceUpdate{
+		Symbol:    symbol,
+		Price:     price,
+		Volume:    volume,
+		Timestamp: timestamppb.New(at),
+		Source:    "market-data-simulator",
+		Event:     event,
+		ChangeInfo: &proto.PriceChangeInfo{
+			DailyHigh:   price * 1.02,
+			DailyLow:    price * 0.98,
+			DailyVolume: volume * 100,
+		},
+		Bond: &proto.BondInfo{
+			MaturityYears:    bond.MaturityYears,
+			CouponPercent:    bond.CouponPercent,
+			YieldPercent:     valuation.Yield * 100,
+			ZeroRatePercent:  factors.ZeroRate(bond.MaturityYears, curve.Lambda) * 100,
+			ModifiedDuration: valuation.Duration,
+			Curve:            curveFactorsProto(factors),
+		},
+	}
+	attachLiquidity(update, normalLiquidity, session.updateInterval)
+
+	return update
+}
+
+func curveFactorsProto(factors services.CurveFactors) *proto.CurveFactors {
+	return &proto.CurveFactors{
+		Level:     factors.Level * 100,
+		Slope:     factors.Slope * 100,
+		Curvature: factors.Curvature * 100,
+	}
+}
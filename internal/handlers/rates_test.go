@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
+)
+
+func setupRatesHandler() *MarketDataGRPCHandler {
+	return setupHandlerWith(func(cfg *config.Config) {
+		cfg.YieldCurve = config.YieldCurve{
+			Currency:  "USD",
+			Level:     0.045,
+			Slope:     -0.01,
+			Curvature: 0.01,
+			Lambda:    2,
+			VolBps:    10,
+			HalfLife:  time.Minute,
+			ShockBps:  25,
+		}
+		cfg.Bonds = []config.Bond{
+			{Symbol: "UST-2Y", MaturityYears: 2, CouponPercent: 4},
+			{Symbol: "UST-10Y", MaturityYears: 10, CouponPercent: 4.25},
+		}
+	})
+}
+
+func TestMarketDataGRPCHandler_GetYieldCurve(t *testing.T) {
+	handler := setupRatesHandler()
+
+	curve, err := handler.GetYieldCurve(context.Background(), &proto.GetYieldCurveRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "USD", curve.Currency)
+	assert.InDelta(t, 4.5, curve.Factors.Level, 0.5, "within a few VolBps of the configured level")
+
+	// The snapshot is the curve bonds are priced off
+	bond := handler.marketDataService.Snapshot([]string{"UST-10Y"}, 0).Ticks["UST-10Y"].Bond
+	assert.Equal(t, curveFactorsProto(bond.Factors), curve.Factors)
+	require.Len(t, curve.Points, len(defaultCurveTenors))
+	for i := 1; i < len(curve.Points); i++ {
+		assert.Less(t, curve.Points[i].DiscountFactor, curve.Points[i-1].DiscountFactor)
+	}
+
+	curve, err = handler.GetYieldCurve(context.Background(), &proto.GetYieldCurveRequest{TenorsYears: []float64{10, 2}})
+	require.NoError(t, err)
+	require.Len(t, curve.Points, 2)
+	assert.Equal(t, 10.0, curve.Points[0].TenorYears)
+	assert.Greater(t, curve.Points[0].ZeroRatePercent, curve.Points[1].ZeroRatePercent)
+
+	_, err = handler.GetYieldCurve(context.Background(), &proto.GetYieldCurveRequest{TenorsYears: []float64{0}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestMarketDataGRPCHandler_Bonds(t *testing.T) {
+	handler := setupRatesHandler()
+
+	instrument, err := handler.GetInstrument(context.Background(), &proto.GetInstrumentRequest{Symbol: "UST-10Y"})
+	require.NoError(t, err)
+	assert.Equal(t, "bond", instrument.AssetClass)
+	assert.Equal(t, "USD", instrument.QuoteCurrency)
+
+	resp, err := handler.GetPrice(context.Background(), &proto.GetPriceRequest{Symbol: "UST-10Y"})
+	require.NoError(t, err)
+	assert.InDelta(t, 100, resp.Price, 5)
+}
+
+func TestPublishTick_BondsShareTheCurve(t *testing.T) {
+	handler := setupRatesHandler()
+	handler.config.YieldCurve.ShocksPerDay = 24 * 3600 // A shock every second
+
+	_, published := publishTicks(t, handler, []string{"UST-2Y", "UST-10Y"}, time.Now(), 3)
+	require.Len(t, published, 6)
+
+	for i := 0; i < len(published); i += 2 {
+		short, long := published[i], published[i+1]
+		require.NotNil(t, short.Bond)
+		require.NotNil(t, long.Bond)
+		assert.Equal(t, short.Bond.Curve.Level, long.Bond.Curve.Level, "one curve per tick")
+		assert.Greater(t, long.Bond.ModifiedDuration, short.Bond.ModifiedDuration)
+		assert.InDelta(t, long.Bond.ZeroRatePercent, long.Bond.YieldPercent, 0.1)
+	}
+
+	// Shocks after the first tick move the whole curve and are reported
+	shock := published[2]
+	require.NotNil(t, shock.Event)
+	assert.Equal(t, proto.MarketEventType_RATE_SHOCK, shock.Event.Type)
+	assert.NotNil(t, published[3].Event)
+	assert.InDelta(t, 0.25, math.Abs(shock.Bond.Curve.Level-published[0].Bond.Curve.Level), 0.1)
+}
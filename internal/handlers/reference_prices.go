@@ -0,0 +1,175 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
+)
+
+// referenceVenue labels updates carrying reference prices
+const referenceVenue = "REFERENCE"
+
+// referenceUpdate derives the reference prices from one tick of venue
+// quotes. The update's price is the mark and its quote the NBBO.
+func referenceUpdate(quotes []*proto.PriceUpdate, outlierBps float64) *proto.PriceUpdate {
+	nbbo := consolidate(quotes)
+	prices := referencePrices(quotes, nbbo, outlierBps)
+
+	return &proto.PriceUpdate{
+		Symbol:          nbbo.Symbol,
+		Price:           prices[2].Price,
+		Volume:          nbbo.Volume,
+		Timestamp:       nbbo.Timestamp,
+		Source:          nbbo.Source,
+		Venue:           referenceVenue,
+		Quote:           nbbo.Quote,
+		TradeCount:      nbbo.TradeCount,
+		TradingStatus:   nbbo.TradingStatus,
+		TradingPhase:    nbbo.TradingPhase,
+		ReferencePrices: prices,
+	}
+}
+
+// referencePrices returns the NBBO, index and mark prices, in that order
+func referencePrices(quotes []*proto.PriceUpdate, nbbo *proto.PriceUpdate, outlierBps float64) []*proto.ReferencePrice {
+	venues := make([]string, len(quotes))
+	for i, quote := range quotes {
+		venues[i] = quote.Venue
+	}
+
+	return []*proto.ReferencePrice{
+		{
+			Type:         proto.ReferencePriceType_NBBO,
+			Price:        nbbo.Price,
+			Methodology:  fmt.Sprintf("midpoint of the best bid (%s) and best offer (%s) across %d venues", nbbo.Quote.BidVenue, nbbo.Quote.AskVenue, len(quotes)),
+			Constituents: venues,
+		},
+		indexPrice(quotes, venues),
+		markPrice(quotes, outlierBps),
+	}
+}
+
+// indexPrice weights each venue mid by the volume it traded over the tick,
+// falling back to equal weights when nothing traded
+func indexPrice(quotes []*proto.PriceUpdate, venues []string) *proto.ReferencePrice {
+	var weighted, volume, sum float64
+	for _, quote := range quotes {
+		weighted += quote.Price * quote.Volume
+		volume += quote.Volume
+		sum += quote.Price
+	}
+
+	if volume <= 0 {
+		return &proto.ReferencePrice{
+			Type:         proto.ReferencePriceType_INDEX,
+			Price:        sum / float64(len(quotes)),
+			Methodology:  fmt.Sprintf("equal-weighted average of %d venue mids (no traded volume)", len(quotes)),
+			Constituents: venues,
+		}
+	}
+	return &proto.ReferencePrice{
+		Type:         proto.ReferencePriceType_INDEX,
+		Price:        weighted / volume,
+		Methodology:  fmt.Sprintf("volume-weighted average of %d venue mids", len(quotes)),
+		Constituents: venues,
+	}
+}
+
+// markPrice averages the venue mids within outlierBps of their median, so a
+// single dislocated venue cannot move the mark. Distances are relative to the
+// median's size, so spreads below zero filter like any price; a median of
+// zero has no relative distance and excludes no venue.
+func markPrice(quotes []*proto.PriceUpdate, outlierBps float64) *proto.ReferencePrice {
+	mids := make([]float64, len(quotes))
+	for i, quote := range quotes {
+		mids[i] = quote.Price
+	}
+	sort.Float64s(mids)
+	median := mids[len(mids)/2]
+	if len(mids)%2 == 0 {
+		median = (mids[len(mids)/2-1] + mids[len(mids)/2]) / 2
+	}
+
+	mark := &proto.ReferencePrice{
+		Type:        proto.ReferencePriceType_MARK,
+		Methodology: fmt.Sprintf("average of venue mids within %.1f bps of the median (%.6g)", outlierBps, median),
+	}
+	var sum float64
+	for _, quote := range quotes {
+		if median != 0 && math.Abs(quote.Price-median)/math.Abs(median)*10000 > outlierBps {
+			mark.Excluded = append(mark.Excluded, quote.Venue)
+			continue
+		}
+		mark.Constituents = append(mark.Constituents, quote.Venue)
+		sum += quote.Price
+	}
+
+	// With an even number of venues every mid can sit beyond the threshold
+	if len(mark.Constituents) == 0 {
+		mark.Price = median
+		mark.Methodology += "; all venues rejected, using the median"
+		return mark
+	}
+	mark.Price = sum / float64(len(mark.Constituents))
+	return mark
+}
+
+// GetReferencePrices computes reference prices from the shared market's
+// current venue quotes for the symbol
+func (h *MarketDataGRPCHandler) GetReferencePrices(ctx context.Context, req *proto.GetReferencePricesRequest) (*proto.ReferencePricesResponse, error) {
+	h.logger.WithFields(logrus.Fields{
+		"symbol": req.Symbol,
+	}).Info("GetReferencePrices request received")
+
+	if req.Symbol == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "symbol is required")
+	}
+	if err := h.requireInstruments([]string{req.Symbol}); err != nil {
+		return nil, err
+	}
+	if err := checkAPIVersion(req.ApiVersion); err != nil {
+		return nil, err
+	}
+
+	venues, err := newVenueQuotes(h.config, nil, false, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Venues are simulated while watched; a symbol no stream watches gets
+	// venues at their long-run spread
+	h.marketDataService.Advance(time.Now())
+	h.marketDataService.WatchVenues([]string{req.Symbol})
+	market := h.marketDataService.Snapshot([]string{req.Symbol}, h.marketDataService.EventSequence())
+	h.marketDataService.UnwatchVenues([]string{req.Symbol})
+	tick := market.Ticks[req.Symbol]
+	updates := venues.quote(&proto.PriceUpdate{
+		Symbol:    req.Symbol,
+		Price:     tick.Price,
+		Volume:    1000 + rand.Float64()*9000,
+		Timestamp: timestamppb.New(market.At),
+		Source:    "market-data-simulator",
+	}, tick.VenueBps, minUpdateInterval)
+	reference := updates[len(updates)-1]
+	h.roundUpdate(reference)
+	if req.ApiVersion == proto.ApiVersion_API_V2 {
+		h.attachDecimals(reference)
+	}
+
+	return &proto.ReferencePricesResponse{
+		Symbol:    req.Symbol,
+		Prices:    reference.ReferencePrices,
+		Nbbo:      reference.Quote,
+		Timestamp: reference.Timestamp,
+	}, nil
+}
@@ -0,0 +1,138 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
+)
+
+func venueQuote(venue string, mid, volume float64) *proto.PriceUpdate {
+	return &proto.PriceUpdate{
+		Symbol: "BTC-USD",
+		Venue:  venue,
+		Price:  mid,
+		Volume: volume,
+		Quote:  &proto.Quote{Bid: mid - 0.01, Ask: mid + 0.01},
+	}
+}
+
+func TestIndexPrice(t *testing.T) {
+	quotes := []*proto.PriceUpdate{venueQuote("a", 100, 3), venueQuote("b", 104, 1)}
+
+	index := indexPrice(quotes, []string{"a", "b"})
+	assert.Equal(t, proto.ReferencePriceType_INDEX, index.Type)
+	assert.InDelta(t, 101, index.Price, 1e-9)
+	assert.Contains(t, index.Methodology, "volume-weighted")
+
+	quotes = []*proto.PriceUpdate{venueQuote("a", 100, 0), venueQuote("b", 104, 0)}
+	index = indexPrice(quotes, []string{"a", "b"})
+	assert.InDelta(t, 102, index.Price, 1e-9)
+	assert.Contains(t, index.Methodology, "equal-weighted")
+}
+
+func TestMarkPrice_RejectsOutliers(t *testing.T) {
+	quotes := []*proto.PriceUpdate{
+		venueQuote("a", 100.00, 1),
+		venueQuote("b", 100.02, 1),
+		venueQuote("c", 100.50, 1), // 48bps from the median
+	}
+
+	mark := markPrice(quotes, 10)
+	assert.Equal(t, proto.ReferencePriceType_MARK, mark.Type)
+	assert.InDelta(t, 100.01, mark.Price, 1e-9)
+	assert.Equal(t, []string{"a", "b"}, mark.Constituents)
+	assert.Equal(t, []string{"c"}, mark.Excluded)
+
+	// Two venues far apart: both are outliers around their midpoint
+	mark = markPrice([]*proto.PriceUpdate{venueQuote("a", 100, 1), venueQuote("b", 101, 1)}, 10)
+	assert.InDelta(t, 100.5, mark.Price, 1e-9)
+	assert.Len(t, mark.Excluded, 2)
+}
+
+func TestMarkPrice_Spread(t *testing.T) {
+	// A spread composite below zero filters by distance from the median's size
+	mark := markPrice([]*proto.PriceUpdate{
+		venueQuote("a", -100.00, 1),
+		venueQuote("b", -100.02, 1),
+		venueQuote("c", -100.50, 1),
+	}, 10)
+	assert.InDelta(t, -100.01, mark.Price, 1e-9)
+	assert.Equal(t, []string{"a", "b"}, mark.Constituents)
+	assert.Equal(t, []string{"c"}, mark.Excluded)
+
+	// At a zero median every venue counts
+	mark = markPrice([]*proto.PriceUpdate{
+		venueQuote("a", -0.02, 1),
+		venueQuote("b", 0, 1),
+		venueQuote("c", 0.05, 1),
+	}, 10)
+	assert.InDelta(t, 0.01, mark.Price, 1e-9)
+	assert.Equal(t, []string{"a", "b", "c"}, mark.Constituents)
+	assert.Empty(t, mark.Excluded)
+}
+
+func TestReferenceUpdate(t *testing.T) {
+	quotes := []*proto.PriceUpdate{venueQuote("a", 100, 1), venueQuote("b", 100.04, 1)}
+
+	update := referenceUpdate(quotes, 0)
+	assert.Equal(t, referenceVenue, update.Venue)
+	require.Len(t, update.ReferencePrices, 3)
+	assert.Equal(t, proto.ReferencePriceType_NBBO, update.ReferencePrices[0].Type)
+	assert.Equal(t, "b", update.Quote.BidVenue)
+	assert.Equal(t, "a", update.Quote.AskVenue)
+	assert.Equal(t, update.ReferencePrices[2].Price, update.Price)
+	for _, price := range update.ReferencePrices {
+		assert.NotEmpty(t, price.Methodology)
+	}
+}
+
+func TestMarketDataGRPCHandler_GetReferencePrices(t *testing.T) {
+	handler := setupHandler()
+	handler.config.Venues = testVenueConfig().Venues
+	handler.config.VenueMaxDislocationBps = 20
+
+	resp, err := handler.GetReferencePrices(context.Background(), &proto.GetReferencePricesRequest{Symbol: "BTC-USD"})
+	require.NoError(t, err)
+	assert.Equal(t, "BTC-USD", resp.Symbol)
+	require.Len(t, resp.Prices, 3)
+	require.NotNil(t, resp.Nbbo)
+
+	price, err := handler.marketDataService.GetPrice("BTC-USD")
+	require.NoError(t, err)
+	for _, reference := range resp.Prices {
+		assert.InDelta(t, price, reference.Price, price*10/10000)
+	}
+
+	_, err = handler.GetReferencePrices(context.Background(), &proto.GetReferencePricesRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestMarketDataGRPCHandler_StreamPrices_ReferencePrices(t *testing.T) {
+	handler := setupHandler()
+	handler.config.Venues = testVenueConfig().Venues
+	handler.config.VenueMaxDislocationBps = 20
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := newMockPriceStream(ctx)
+
+	go handler.StreamPrices(&proto.StreamPricesRequest{
+		Symbols:          []string{"BTC-USD"},
+		UpdateIntervalMs: 100,
+		ReferencePrices:  true,
+	}, stream)
+
+	require.Eventually(t, func() bool { return len(stream.Updates()) >= 2 }, 2*time.Second, 10*time.Millisecond)
+
+	for _, update := range stream.Updates() {
+		assert.Equal(t, referenceVenue, update.Venue)
+		assert.Len(t, update.ReferencePrices, 3)
+	}
+}
@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"sync"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
+)
+
+const defaultReplayBufferSize = 1000
+
+// ReplayBuffer keeps the most recent updates of a stream so clients can
+// recover a sequence gap without reopening the stream. Updates must be
+// appended in sequence order; the oldest update is evicted once full.
+type ReplayBuffer struct {
+	mu      sync.RWMutex
+	updates []*proto.PriceUpdate
+	start   int
+	count   int
+}
+
+func NewReplayBuffer(capacity int) *ReplayBuffer {
+	if capacity <= 0 {
+		capacity = defaultReplayBufferSize
+	}
+	return &ReplayBuffer{
+		updates: make([]*proto.PriceUpdate, capacity),
+	}
+}
+
+func (b *ReplayBuffer) Append(update *proto.PriceUpdate) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	capacity := len(b.updates)
+	if b.count < capacity {
+		b.updates[(b.start+b.count)%capacity] = update
+		b.count++
+		return
+	}
+
+	// Buffer full: overwrite the oldest entry
+	b.updates[b.start] = update
+	b.start = (b.start + 1) % capacity
+}
+
+// FirstSequence returns the oldest sequence still held, or 0 when empty
+func (b *ReplayBuffer) FirstSequence() uint64 {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if b.count == 0 {
+		return 0
+	}
+	return b.updates[b.start].Sequence
+}
+
+// LastSequence returns the newest sequence held, or 0 when empty
+func (b *ReplayBuffer) LastSequence() uint64 {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if b.count == 0 {
+		return 0
+	}
+	return b.updates[(b.start+b.count-1)%len(b.updates)].Sequence
+}
+
+// Range returns the buffered updates with from <= sequence <= to. A to of 0
+// means up to the latest update. The bool reports whether the whole range was
+// still available, i.e. nothing in it had been evicted.
+func (b *ReplayBuffer) Range(from, to uint64) ([]*proto.PriceUpdate, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if b.count == 0 {
+		return nil, from == 0
+	}
+
+	first := b.updates[b.start].Sequence
+	last := b.updates[(b.start+b.count-1)%len(b.updates)].Sequence
+	if to == 0 || to > last {
+		to = last
+	}
+	if from == 0 {
+		from = first
+	}
+
+	complete := from >= first
+	if from < first {
+		from = first
+	}
+	if from > to {
+		return nil, complete
+	}
+
+	// Sequences are contiguous within the buffer, so offsets map directly
+	offset := int(from - first)
+	n := int(to-from) + 1
+	result := make([]*proto.PriceUpdate, 0, n)
+	for i := 0; i < n; i++ {
+		result = append(result, b.updates[(b.start+offset+i)%len(b.updates)])
+	}
+
+	return result, complete
+}
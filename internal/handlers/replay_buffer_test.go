@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
+)
+
+func fillReplayBuffer(buffer *ReplayBuffer, from, to uint64) {
+	for seq := from; seq <= to; seq++ {
+		buffer.Append(&proto.PriceUpdate{Symbol: "BTC/USD", Sequence: seq})
+	}
+}
+
+func TestReplayBuffer_Empty(t *testing.T) {
+	buffer := NewReplayBuffer(10)
+
+	assert.Equal(t, uint64(0), buffer.FirstSequence())
+	assert.Equal(t, uint64(0), buffer.LastSequence())
+
+	updates, complete := buffer.Range(0, 0)
+	assert.Empty(t, updates)
+	assert.True(t, complete)
+
+	updates, complete = buffer.Range(5, 0)
+	assert.Empty(t, updates)
+	assert.False(t, complete)
+}
+
+func TestReplayBuffer_Range(t *testing.T) {
+	buffer := NewReplayBuffer(10)
+	fillReplayBuffer(buffer, 1, 5)
+
+	updates, complete := buffer.Range(2, 4)
+	assert.True(t, complete)
+	assert.Len(t, updates, 3)
+	assert.Equal(t, uint64(2), updates[0].Sequence)
+	assert.Equal(t, uint64(4), updates[2].Sequence)
+
+	// to_sequence of 0 means up to latest
+	updates, complete = buffer.Range(3, 0)
+	assert.True(t, complete)
+	assert.Len(t, updates, 3)
+	assert.Equal(t, uint64(5), updates[2].Sequence)
+
+	// Range past the latest update is clamped
+	updates, complete = buffer.Range(4, 100)
+	assert.True(t, complete)
+	assert.Len(t, updates, 2)
+}
+
+func TestReplayBuffer_Eviction(t *testing.T) {
+	buffer := NewReplayBuffer(5)
+	fillReplayBuffer(buffer, 1, 12)
+
+	assert.Equal(t, uint64(8), buffer.FirstSequence())
+	assert.Equal(t, uint64(12), buffer.LastSequence())
+
+	// Requesting evicted sequences returns what is left and flags the gap
+	updates, complete := buffer.Range(3, 10)
+	assert.False(t, complete)
+	assert.Len(t, updates, 3)
+	assert.Equal(t, uint64(8), updates[0].Sequence)
+	assert.Equal(t, uint64(10), updates[2].Sequence)
+
+	updates, complete = buffer.Range(9, 12)
+	assert.True(t, complete)
+	assert.Len(t, updates, 4)
+}
+
+func TestReplayBuffer_DefaultCapacity(t *testing.T) {
+	buffer := NewReplayBuffer(0)
+	fillReplayBuffer(buffer, 1, defaultReplayBufferSize+1)
+
+	assert.Equal(t, uint64(2), buffer.FirstSequence())
+	assert.Equal(t, uint64(defaultReplayBufferSize+1), buffer.LastSequence())
+}
@@ -0,0 +1,184 @@
+package handlers
+
+import (
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/services"
+)
+
+// instrument returns the reference data updates of a symbol are formatted
+// with, including future contracts that have expired and are settling
+func (h *MarketDataGRPCHandler) instrument(symbol string) (config.Instrument, bool) {
+	if instrument, exists := h.marketDataService.Instrument(symbol); exists {
+		return instrument, true
+	}
+	if contract, exists := h.marketDataService.Future(symbol); exists {
+		return h.marketDataService.FutureInstrument(contract), true
+	}
+	return config.Instrument{}, false
+}
+
+// priceInstrument returns the reference data a symbol's own prices are
+// rounded with: the symbol's, on the converted grid when converted
+func (h *MarketDataGRPCHandler) priceInstrument(symbol string, conversion *proto.ConversionInfo) (config.Instrument, bool) {
+	instrument, exists := h.instrument(symbol)
+	if exists && conversion != nil {
+		instrument.QuoteCurrency = conversion.ToCurrency
+		instrument.TickSize = conversion.TickSize
+		instrument.PricePrecision = int(conversion.PricePrecision)
+	}
+	return instrument, exists
+}
+
+// finishUpdate converts and rounds an update as it is built, so the delivery
+// policy judges the prices clients would see, and derives the change from
+// the symbol's last rounded price. It returns the update for chaining.
+func (h *MarketDataGRPCHandler) finishUpdate(session *StreamSession, update *proto.PriceUpdate) *proto.PriceUpdate {
+	if update == nil {
+		return nil
+	}
+	h.convertUpdate(session, update)
+	h.roundUpdate(update)
+
+	if change := update.ChangeInfo; change != nil {
+		previous := session.previous(update.Symbol, update.Price)
+		change.ChangeAmount = update.Price - previous
+		if instrument, exists := h.priceInstrument(update.Symbol, update.Conversion); exists {
+			change.ChangeAmount = services.RoundPrice(instrument, change.ChangeAmount)
+		}
+		// Spreads can sit at or cross zero, where a percentage change is meaningless
+		change.ChangePercentage = 0
+		if previous > 0 {
+			change.ChangePercentage = (update.Price - previous) / previous * 100
+		}
+	}
+	return update
+}
+
+// roundUpdate snaps every price in an update to the symbol's tick and every
+// size to its lot. It runs as the update leaves the simulator; the price
+// processes underneath keep full precision, so moves smaller than a tick
+// still accumulate. Converted updates round to the converted grid, so they
+// must be converted first. Symbols without reference data pass through
+// unchanged. Rounding is idempotent, so quotes derived from a rounded update
+// can be rounded again.
+func (h *MarketDataGRPCHandler) roundUpdate(update *proto.PriceUpdate) {
+	instrument, exists := h.priceInstrument(update.Symbol, update.Conversion)
+	if !exists {
+		return
+	}
+
+	update.Price = services.RoundPrice(instrument, update.Price)
+	update.Volume = services.RoundSize(instrument, update.Volume)
+
+	if change := update.ChangeInfo; change != nil {
+		change.DailyHigh = services.RoundPrice(instrument, change.DailyHigh)
+		change.DailyLow = services.RoundPrice(instrument, change.DailyLow)
+		change.DailyVolume = services.RoundSize(instrument, change.DailyVolume)
+	}
+	if update.Quote != nil {
+		roundQuote(instrument, update.Quote)
+	}
+	if book := update.OrderBook; book != nil {
+		book.Bids = roundLevels(instrument, book.Bids, services.RoundBid)
+		book.Asks = roundLevels(instrument, book.Asks, services.RoundAsk)
+	}
+	if event := update.Event; event != nil {
+		event.ReferencePrice = services.RoundPrice(instrument, event.ReferencePrice)
+		event.BandLow = services.RoundPrice(instrument, event.BandLow)
+		event.BandHigh = services.RoundPrice(instrument, event.BandHigh)
+	}
+	if auction := update.Auction; auction != nil {
+		auction.IndicativePrice = services.RoundPrice(instrument, auction.IndicativePrice)
+		auction.IndicativeVolume = services.RoundSize(instrument, auction.IndicativeVolume)
+		auction.Imbalance = services.RoundSize(instrument, auction.Imbalance)
+	}
+	for _, reference := range update.ReferencePrices {
+		reference.Price = services.RoundPrice(instrument, reference.Price)
+	}
+	if perpetual := update.Perpetual; perpetual != nil {
+		perpetual.MarkPrice = services.RoundPrice(instrument, perpetual.MarkPrice)
+		perpetual.IndexPrice = h.roundPrice(perpetual.IndexSymbol, perpetual.IndexPrice)
+	}
+	if future := update.Future; future != nil {
+		future.UnderlyingPrice = h.roundPrice(future.UnderlyingSymbol, future.UnderlyingPrice)
+		future.SettlementPrice = services.RoundPrice(instrument, future.SettlementPrice)
+	}
+	if option := update.Option; option != nil {
+		option.UnderlyingPrice = h.roundPrice(option.UnderlyingSymbol, option.UnderlyingPrice)
+	}
+	if cross := update.Cross; cross != nil {
+		cross.TriangulatedPrice = services.RoundPrice(instrument, cross.TriangulatedPrice)
+		cross.BaseFactorPrice = h.roundPrice(cross.BaseFactorSymbol, cross.BaseFactorPrice)
+		cross.QuoteFactorPrice = h.roundPrice(cross.QuoteFactorSymbol, cross.QuoteFactorPrice)
+	}
+	if update.Composite != nil {
+		for _, leg := range update.Composite.Legs {
+			if legInstrument, exists := h.instrument(leg.Symbol); exists {
+				leg.Price = services.RoundPrice(legInstrument, leg.Price)
+			}
+		}
+	}
+}
+
+// roundQuote rounds bids down and asks up, which keeps an uncrossed quote
+// uncrossed, and recomputes the spread from the rounded prices
+func roundQuote(instrument config.Instrument, quote *proto.Quote) {
+	quote.Bid = services.RoundBid(instrument, quote.Bid)
+	quote.Ask = services.RoundAsk(instrument, quote.Ask)
+	quote.BidSize = restingSize(instrument, quote.BidSize)
+	quote.AskSize = restingSize(instrument, quote.AskSize)
+	if mid := (quote.Bid + quote.Ask) / 2; mid > 0 {
+		quote.SpreadBps = (quote.Ask - quote.Bid) / mid * 10000
+	}
+}
+
+// roundLevels rounds book levels away from the touch, merging levels that
+// land on the same tick
+func roundLevels(instrument config.Instrument, levels []*proto.OrderBookLevel, round func(config.Instrument, float64) float64) []*proto.OrderBookLevel {
+	merged := levels[:0]
+	for _, level := range levels {
+		level.Price = round(instrument, level.Price)
+		if last := len(merged) - 1; last >= 0 && merged[last].Price == level.Price {
+			merged[last].Size += level.Size
+			continue
+		}
+		merged = append(merged, level)
+	}
+	for _, level := range merged {
+		level.Size = restingSize(instrument, level.Size)
+	}
+	return merged
+}
+
+// restingSize rounds a displayed size to the lot, never below one lot, so
+// quoted liquidity does not vanish on instruments with large lots
+func restingSize(instrument config.Instrument, size float64) float64 {
+	if size <= 0 {
+		return 0
+	}
+	return max(services.RoundSize(instrument, size), instrument.LotSize)
+}
+
+// roundPricePoints rounds simulated candles for the symbol
+func (h *MarketDataGRPCHandler) roundPricePoints(symbol string, points []*proto.PricePoint) {
+	instrument, exists := h.instrument(symbol)
+	if !exists {
+		return
+	}
+	for _, point := range points {
+		point.Open = services.RoundPrice(instrument, point.Open)
+		point.High = services.RoundPrice(instrument, point.High)
+		point.Low = services.RoundPrice(instrument, point.Low)
+		point.Close = services.RoundPrice(instrument, point.Close)
+		point.Volume = services.RoundSize(instrument, point.Volume)
+	}
+}
+
+// roundPrice rounds a single price for the symbol
+func (h *MarketDataGRPCHandler) roundPrice(symbol string, price float64) float64 {
+	if instrument, exists := h.instrument(symbol); exists {
+		return services.RoundPrice(instrument, price)
+	}
+	return price
+}
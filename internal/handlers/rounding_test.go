@@ -0,0 +1,134 @@
+package handlers
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
+)
+
+// setupRoundingHandler lists BTC-USD with a coarse tick and lot
+func setupRoundingHandler() *MarketDataGRPCHandler {
+	return setupHandlerWith(func(cfg *config.Config) {
+		cfg.Instruments = append(cfg.Instruments[1:], config.Instrument{
+			Symbol: "BTC-USD", AssetClass: "crypto", BaseCurrency: "BTC", QuoteCurrency: "USD",
+			TickSize: 0.5, LotSize: 0.1, PricePrecision: 1,
+		})
+	})
+}
+
+func onIncrement(value, increment float64) bool {
+	steps := value / increment
+	return math.Abs(steps-math.Round(steps)) < 1e-9
+}
+
+func TestRoundUpdate(t *testing.T) {
+	handler := setupRoundingHandler()
+
+	update := &proto.PriceUpdate{
+		Symbol: "BTC-USD",
+		Price:  100.3,
+		Volume: 12.34,
+		Quote:  &proto.Quote{Bid: 100.2, Ask: 100.4, BidSize: 0.01, AskSize: 2.26},
+		OrderBook: &proto.OrderBook{
+			Bids: []*proto.OrderBookLevel{{Price: 100.2, Size: 1}, {Price: 100.1, Size: 2}, {Price: 99.7, Size: 3}},
+			Asks: []*proto.OrderBookLevel{{Price: 100.4, Size: 1}, {Price: 100.9, Size: 2}},
+		},
+		Auction: &proto.AuctionInfo{IndicativePrice: 100.7, IndicativeVolume: 5.56, Imbalance: -1.27},
+	}
+	handler.roundUpdate(update)
+
+	assert.Equal(t, 100.5, update.Price)
+	assert.Equal(t, 12.3, update.Volume)
+
+	// Bids round down and asks up, so the quote never improves
+	assert.Equal(t, 100.0, update.Quote.Bid)
+	assert.Equal(t, 100.5, update.Quote.Ask)
+	assert.Equal(t, 0.1, update.Quote.BidSize, "displayed size never drops below one lot")
+	assert.Equal(t, 2.3, update.Quote.AskSize)
+	assert.InDelta(t, 0.5/100.25*10000, update.Quote.SpreadBps, 1e-9)
+
+	// Levels landing on the same tick merge
+	require.Len(t, update.OrderBook.Bids, 2)
+	assert.Equal(t, 100.0, update.OrderBook.Bids[0].Price)
+	assert.Equal(t, 3.0, update.OrderBook.Bids[0].Size)
+	assert.Equal(t, 99.5, update.OrderBook.Bids[1].Price)
+	assert.Equal(t, []float64{100.5, 101}, []float64{update.OrderBook.Asks[0].Price, update.OrderBook.Asks[1].Price})
+
+	assert.Equal(t, 100.5, update.Auction.IndicativePrice)
+	assert.Equal(t, 5.6, update.Auction.IndicativeVolume)
+	assert.Equal(t, -1.3, update.Auction.Imbalance)
+
+	// Symbols without reference data pass through
+	unknown := &proto.PriceUpdate{Symbol: "DOGE-USD", Price: 0.123456}
+	handler.roundUpdate(unknown)
+	assert.Equal(t, 0.123456, unknown.Price)
+}
+
+func TestFinishUpdate_ChangeFromRoundedPrices(t *testing.T) {
+	handler := setupRoundingHandler()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	session := handler.newStreamSession(ctx, cancel, "finish_test", []string{"BTC-USD"}, time.Second)
+
+	first := handler.finishUpdate(session, &proto.PriceUpdate{Symbol: "BTC-USD", Price: 100.3, ChangeInfo: &proto.PriceChangeInfo{}})
+	assert.Equal(t, 100.5, first.Price)
+	assert.Equal(t, 0.0, first.ChangeInfo.ChangeAmount)
+
+	// A sub-tick move prints no change, however far the raw price went
+	second := handler.finishUpdate(session, &proto.PriceUpdate{Symbol: "BTC-USD", Price: 100.6, ChangeInfo: &proto.PriceChangeInfo{}})
+	assert.Equal(t, 100.5, second.Price)
+	assert.Equal(t, 0.0, second.ChangeInfo.ChangeAmount)
+	assert.Equal(t, 0.0, second.ChangeInfo.ChangePercentage)
+
+	third := handler.finishUpdate(session, &proto.PriceUpdate{Symbol: "BTC-USD", Price: 101.2, ChangeInfo: &proto.PriceChangeInfo{}})
+	assert.Equal(t, 101.0, third.Price)
+	assert.Equal(t, 0.5, third.ChangeInfo.ChangeAmount)
+	assert.InDelta(t, 0.5/100.5*100, third.ChangeInfo.ChangePercentage, 1e-9)
+}
+
+func TestMarketDataGRPCHandler_StreamPrices_Rounded(t *testing.T) {
+	handler := setupRoundingHandler()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := newMockPriceStream(ctx)
+
+	go handler.StreamPrices(&proto.StreamPricesRequest{
+		Symbols:          []string{"BTC-USD"},
+		UpdateIntervalMs: 100,
+	}, stream)
+
+	require.Eventually(t, func() bool { return len(stream.Updates()) >= 3 }, 2*time.Second, 10*time.Millisecond)
+
+	for _, update := range stream.Updates() {
+		assert.True(t, onIncrement(update.Price, 0.5), "price %v is off tick", update.Price)
+		assert.True(t, onIncrement(update.Volume, 0.1), "volume %v is off lot", update.Volume)
+		if update.ChangeInfo != nil {
+			assert.True(t, onIncrement(update.ChangeInfo.ChangeAmount, 0.5), "change %v is off tick", update.ChangeInfo.ChangeAmount)
+		}
+		if update.Quote != nil {
+			assert.True(t, onIncrement(update.Quote.Bid, 0.5))
+			assert.True(t, onIncrement(update.Quote.Ask, 0.5))
+			assert.Less(t, update.Quote.Bid, update.Quote.Ask)
+		}
+		if update.OrderBook != nil {
+			for _, levels := range [][]*proto.OrderBookLevel{update.OrderBook.Bids, update.OrderBook.Asks} {
+				for _, level := range levels {
+					assert.True(t, onIncrement(level.Price, 0.5))
+					assert.True(t, onIncrement(level.Size, 0.1))
+				}
+			}
+		}
+	}
+
+	response, err := handler.GetPrice(context.Background(), &proto.GetPriceRequest{Symbol: "BTC-USD"})
+	require.NoError(t, err)
+	assert.True(t, onIncrement(response.Price, 0.5))
+}
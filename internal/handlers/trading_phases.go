@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/services"
+)
+
+// tradingPhase returns the phase-change update when the symbol's phase
+// differs from the one last reported to the session, or on the first tick of
+// a scheduled symbol; leaving an auction, that update is the uncross print
+// at the indicative price
+func (h *MarketDataGRPCHandler) tradingPhase(session *StreamSession, symbol string, tick services.MarketTick, at time.Time) *proto.PriceUpdate {
+	if !tick.Scheduled {
+		return nil
+	}
+
+	previous, seen := session.phases[symbol]
+	if seen && previous == tick.Phase {
+		return nil
+	}
+	session.phases[symbol] = tick.Phase
+
+	phase := proto.TradingPhase(tick.Phase)
+	update := h.marketEventUpdate(symbol, tick.Price, proto.TradingStatus_TRADING, &proto.MarketEvent{
+		Type:   proto.MarketEventType_PHASE_CHANGE,
+		Reason: fmt.Sprintf("entering %s", phase),
+		Phase:  phase,
+	}, at)
+	update.TradingPhase = phase
+
+	if seen && previous.IsAuction() {
+		update.Volume = tick.Uncrossed
+		update.Event.Reason = fmt.Sprintf("%s uncrossed, entering %s", proto.TradingPhase(previous), phase)
+	}
+	return update
+}
+
+// auctionUpdate reports a running auction's indicative price and book
+func (h *MarketDataGRPCHandler) auctionUpdate(symbol string, tick services.MarketTick, at time.Time) *proto.PriceUpdate {
+	auction := services.AuctionState{}
+	if tick.Auction != nil {
+		auction = *tick.Auction
+	}
+
+	return &proto.PriceUpdate{
+		Symbol:       symbol,
+		Price:        tick.Price,
+		Timestamp:    timestamppb.New(at),
+		Source:       "market-data-simulator",
+		TradingPhase: proto.TradingPhase(tick.Phase),
+		Auction: &proto.AuctionInfo{
+			IndicativePrice:  tick.Price,
+			IndicativeVolume: auction.Matched,
+			Imbalance:        auction.Imbalance,
+			UncrossAt:        timestamppb.New(tick.PhaseEnd),
+		},
+	}
+}
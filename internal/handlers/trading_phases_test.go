@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
+)
+
+func testSchedule(location *time.Location) config.TradingSchedule {
+	return config.TradingSchedule{
+		Symbol:         "BTC-EUR",
+		Location:       location,
+		PreOpen:        7*time.Hour + 30*time.Minute,
+		OpeningAuction: 7*time.Hour + 50*time.Minute,
+		Continuous:     8 * time.Hour,
+		ClosingAuction: 16*time.Hour + 30*time.Minute,
+		Close:          16*time.Hour + 35*time.Minute,
+	}
+}
+
+func TestPublishTick_TradingPhases(t *testing.T) {
+	handler := setupHandler()
+	handler.config.TradingSchedules = []config.TradingSchedule{testSchedule(time.UTC)}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	session := handler.newStreamSession(ctx, cancel, "phase_test", []string{"BTC-EUR", "ETH-USD"}, time.Second)
+	require.NoError(t, handler.resolveSymbols(session))
+
+	publishAt := func(at time.Time) []*proto.PriceUpdate {
+		require.NoError(t, handler.publishTick(session, at))
+		session.out.flush()
+		var updates []*proto.PriceUpdate
+		for _, batch := range session.out.drain() {
+			for _, update := range batch {
+				if update.Symbol == "BTC-EUR" {
+					updates = append(updates, update)
+				}
+			}
+		}
+		return updates
+	}
+	at := func(hour, minute int) time.Time {
+		return time.Date(2026, time.October, 19, hour, minute, 0, 0, time.UTC)
+	}
+
+	// First tick announces the phase; nothing prints while pre-open
+	updates := publishAt(at(7, 40))
+	require.Len(t, updates, 1)
+	require.NotNil(t, updates[0].Event)
+	assert.Equal(t, proto.MarketEventType_PHASE_CHANGE, updates[0].Event.Type)
+	assert.Equal(t, proto.TradingPhase_PRE_OPEN, updates[0].Event.Phase)
+	assert.Empty(t, publishAt(at(7, 45)))
+
+	updates = publishAt(at(7, 50))
+	require.Len(t, updates, 2)
+	assert.Equal(t, proto.TradingPhase_OPENING_AUCTION, updates[0].Event.Phase)
+	indicative := updates[1]
+	assert.Nil(t, indicative.Event)
+	assert.Equal(t, proto.TradingPhase_OPENING_AUCTION, indicative.TradingPhase)
+	require.NotNil(t, indicative.Auction)
+	assert.Equal(t, indicative.Price, indicative.Auction.IndicativePrice)
+	assert.True(t, at(8, 0).Equal(indicative.Auction.UncrossAt.AsTime()))
+
+	updates = publishAt(at(7, 55))
+	require.Len(t, updates, 1)
+	matched := updates[0].Auction.IndicativeVolume
+	assert.Greater(t, matched, indicative.Auction.IndicativeVolume)
+
+	// The uncross prints the matched volume, then continuous trading starts
+	updates = publishAt(at(8, 0))
+	require.Len(t, updates, 2)
+	uncross := updates[0]
+	assert.Equal(t, proto.TradingPhase_CONTINUOUS, uncross.Event.Phase)
+	assert.Equal(t, matched, uncross.Volume)
+	assert.Equal(t, proto.TradingPhase_CONTINUOUS, updates[1].TradingPhase)
+	assert.Nil(t, updates[1].Auction)
+
+	// Unscheduled symbols trade around the clock without phase events
+	require.NoError(t, handler.publishTick(session, at(3, 0)))
+	session.out.flush()
+	for _, batch := range session.out.drain() {
+		for _, update := range batch {
+			if update.Symbol == "ETH-USD" {
+				assert.Nil(t, update.Event)
+				assert.Equal(t, proto.TradingPhase_CONTINUOUS, update.TradingPhase)
+			}
+		}
+	}
+}
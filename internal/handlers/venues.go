@@ -0,0 +1,177 @@
+package handlers
+
+import (
+	"math/rand"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
+)
+
+// consolidatedVenue labels best bid/offer updates across all venues
+const consolidatedVenue = "CONSOLIDATED"
+
+// venueQuotes selects which of the shared market's venue quotes a session
+// publishes. Every configured venue is quoted so the consolidated quote sees
+// the whole market; only the requested venues are published. A nil
+// *venueQuotes means the session streams the fair value itself.
+type venueQuotes struct {
+	venues       []config.Venue
+	published    map[string]bool
+	consolidated bool
+	reference    bool
+	outlierBps   float64 // Mark price outlier threshold
+}
+
+// newVenueQuotes validates a stream's venue selection
+func newVenueQuotes(cfg *config.Config, names []string, consolidated, reference bool) (*venueQuotes, error) {
+	if len(names) == 0 && !consolidated && !reference {
+		return nil, nil
+	}
+	if len(cfg.Venues) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "no venues are configured")
+	}
+
+	quotes := &venueQuotes{
+		venues:       cfg.Venues,
+		published:    make(map[string]bool),
+		consolidated: consolidated,
+		reference:    reference,
+		outlierBps:   cfg.MarkOutlierBps,
+	}
+
+	for _, name := range names {
+		if name == "*" {
+			for _, venue := range cfg.Venues {
+				quotes.published[venue.Name] = true
+			}
+			continue
+		}
+		if !quotes.known(name) {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown venue %q", name)
+		}
+		quotes.published[name] = true
+	}
+	return quotes, nil
+}
+
+func (q *venueQuotes) known(name string) bool {
+	for _, venue := range q.venues {
+		if venue.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// quote derives every venue's update for the symbol from its fair value
+// update and the venue mids' offsets from it in bps, followed by the
+// consolidated quote and reference prices when requested, and returns the
+// ones the session publishes
+func (q *venueQuotes) quote(fair *proto.PriceUpdate, offsets map[string]float64, interval time.Duration) []*proto.PriceUpdate {
+	share := 1 / float64(len(q.venues))
+
+	var quotes, updates []*proto.PriceUpdate
+	for _, venue := range q.venues {
+		update := &proto.PriceUpdate{
+			Symbol:        fair.Symbol,
+			Price:         fair.Price * (1 + offsets[venue.Name]/10000),
+			Volume:        fair.Volume * share * (0.5 + rand.Float64()),
+			Timestamp:     fair.Timestamp,
+			Source:        fair.Source,
+			Venue:         venue.Name,
+			TradingStatus: fair.TradingStatus,
+			TradingPhase:  fair.TradingPhase,
+		}
+		// Liquidity is fragmented: each venue rests a share of the normal book
+		attachLiquidity(update, liquidityConditions{
+			depth:    share,
+			spread:   venue.SpreadBps / baseSpreadBps,
+			activity: share,
+		}, interval)
+
+		quotes = append(quotes, update)
+		if q.published[venue.Name] {
+			updates = append(updates, update)
+		}
+	}
+
+	if q.consolidated {
+		updates = append(updates, consolidate(quotes))
+	}
+	if q.reference {
+		updates = append(updates, referenceUpdate(quotes, q.outlierBps))
+	}
+	return updates
+}
+
+// consolidate builds the best bid/offer across venue quotes
+func consolidate(quotes []*proto.PriceUpdate) *proto.PriceUpdate {
+	best := &proto.Quote{}
+	var volume float64
+	var tradeCount uint32
+	for _, update := range quotes {
+		quote := update.Quote
+		if best.BidVenue == "" || quote.Bid > best.Bid {
+			best.Bid, best.BidSize, best.BidVenue = quote.Bid, quote.BidSize, update.Venue
+		}
+		if best.AskVenue == "" || quote.Ask < best.Ask {
+			best.Ask, best.AskSize, best.AskVenue = quote.Ask, quote.AskSize, update.Venue
+		}
+		volume += update.Volume
+		tradeCount += update.TradeCount
+	}
+
+	mid := (best.Bid + best.Ask) / 2
+	best.SpreadBps = (best.Ask - best.Bid) / mid * 10000 // Negative while the market is crossed
+
+	first := quotes[0]
+	return &proto.PriceUpdate{
+		Symbol:        first.Symbol,
+		Price:         mid,
+		Volume:        volume,
+		Timestamp:     first.Timestamp,
+		Source:        first.Source,
+		Venue:         consolidatedVenue,
+		Quote:         best,
+		TradeCount:    tradeCount,
+		TradingStatus: first.TradingStatus,
+		TradingPhase:  first.TradingPhase,
+	}
+}
+
+// watchVenues keeps the shared market simulating the venues of the symbols a
+// venue session quotes, releasing those it no longer does. New symbols are
+// watched first so venues the session keeps are never reset.
+func (h *MarketDataGRPCHandler) watchVenues(session *StreamSession, symbols []string) {
+	if session.venues == nil || slices.Equal(session.watching, symbols) {
+		return
+	}
+	released := session.watching
+	session.watching = slices.Clone(symbols)
+	h.marketDataService.WatchVenues(session.watching)
+	h.marketDataService.UnwatchVenues(released)
+}
+
+// publishVenueQuotes publishes a finished fair value update's venue and
+// consolidated quotes in its place
+func (h *MarketDataGRPCHandler) publishVenueQuotes(session *StreamSession, fair *proto.PriceUpdate, at time.Time) error {
+	offsets := session.market.Ticks[fair.Symbol].VenueBps
+	for _, update := range session.venues.quote(fair, offsets, session.updateInterval) {
+		// The fair value is already converted; venue quotes only need rounding
+		// to its grid
+		update.Conversion = fair.Conversion
+		h.roundUpdate(update)
+		if !session.delivery.admit(update, at) {
+			continue
+		}
+		if err := h.publish(session, update); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
+)
+
+func testVenueConfig() *config.Config {
+	return &config.Config{
+		Venues: []config.Venue{
+			{Name: "fast", NoiseBps: 1, Latency: 0, SpreadBps: 2},
+			{Name: "slow", NoiseBps: 3, Latency: 100 * time.Millisecond, SpreadBps: 4},
+			{Name: "wide", NoiseBps: 2, Latency: 50 * time.Millisecond, SpreadBps: 8},
+		},
+		VenueMaxDislocationBps: 20,
+	}
+}
+
+func TestNewVenueQuotes(t *testing.T) {
+	cfg := testVenueConfig()
+
+	quotes, err := newVenueQuotes(cfg, nil, false, false)
+	require.NoError(t, err)
+	assert.Nil(t, quotes, "no venue selection streams the fair value")
+
+	quotes, err = newVenueQuotes(cfg, []string{"*"}, false, false)
+	require.NoError(t, err)
+	assert.Len(t, quotes.published, 3)
+
+	_, err = newVenueQuotes(cfg, []string{"fast", "nasdaq"}, false, false)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = newVenueQuotes(&config.Config{}, nil, true, false)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestVenueQuotes_Quote(t *testing.T) {
+	quotes, err := newVenueQuotes(testVenueConfig(), []string{"fast", "wide"}, true, false)
+	require.NoError(t, err)
+
+	offsets := map[string]float64{"fast": 2, "slow": -5, "wide": 8}
+	at := time.Now()
+	updates := quotes.quote(&proto.PriceUpdate{Symbol: "BTC-USD", Price: 100, Volume: 30, Timestamp: timestamppb.New(at)}, offsets, 10*time.Millisecond)
+	require.Len(t, updates, 3)
+
+	// Venue mids sit at their offsets from the fair value
+	assert.Equal(t, "fast", updates[0].Venue)
+	assert.InDelta(t, 100.02, updates[0].Price, 1e-9)
+	assert.Equal(t, "wide", updates[1].Venue)
+	assert.InDelta(t, 100.08, updates[1].Price, 1e-9)
+	for _, update := range updates[:2] {
+		require.NotNil(t, update.Quote)
+		assert.Less(t, update.Quote.Bid, update.Quote.Ask)
+	}
+
+	// The consolidated quote sees the unpublished venue too
+	bbo := updates[2]
+	assert.Equal(t, consolidatedVenue, bbo.Venue)
+	for _, update := range updates[:2] {
+		assert.GreaterOrEqual(t, bbo.Quote.Bid, update.Quote.Bid)
+		assert.LessOrEqual(t, bbo.Quote.Ask, update.Quote.Ask)
+	}
+	assert.Contains(t, []string{"fast", "slow", "wide"}, bbo.Quote.BidVenue)
+}
+
+func TestConsolidate(t *testing.T) {
+	quote := func(venue string, bid, ask float64) *proto.PriceUpdate {
+		return &proto.PriceUpdate{Symbol: "BTC-USD", Venue: venue, Volume: 1, Quote: &proto.Quote{Bid: bid, Ask: ask, BidSize: 1, AskSize: 2}}
+	}
+
+	bbo := consolidate([]*proto.PriceUpdate{quote("a", 99.9, 100.2), quote("b", 100.0, 100.3), quote("c", 99.8, 100.1)})
+	assert.Equal(t, "b", bbo.Quote.BidVenue)
+	assert.Equal(t, 100.0, bbo.Quote.Bid)
+	assert.Equal(t, "c", bbo.Quote.AskVenue)
+	assert.Equal(t, 100.1, bbo.Quote.Ask)
+	assert.InDelta(t, 100.05, bbo.Price, 1e-9)
+	assert.Equal(t, 3.0, bbo.Volume)
+}
+
+func TestMarketDataGRPCHandler_StreamPrices_Venues(t *testing.T) {
+	handler := setupHandler()
+	handler.config.Venues = testVenueConfig().Venues
+	handler.config.VenueMaxDislocationBps = 20
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := newMockPriceStream(ctx)
+
+	go handler.StreamPrices(&proto.StreamPricesRequest{
+		Symbols:          []string{"BTC-USD"},
+		UpdateIntervalMs: 100,
+		Venues:           []string{"slow"},
+		Consolidated:     true,
+	}, stream)
+
+	require.Eventually(t, func() bool { return len(stream.Updates()) >= 4 }, 2*time.Second, 10*time.Millisecond)
+
+	for i, update := range stream.Updates()[:4] {
+		expected := "slow"
+		if i%2 == 1 {
+			expected = consolidatedVenue
+		}
+		assert.Equal(t, expected, update.Venue)
+		assert.Equal(t, uint64(i+1), update.Sequence)
+	}
+
+	// Venues are simulated while the stream runs and released when it ends
+	venueBps := func() map[string]float64 {
+		return handler.marketDataService.Snapshot([]string{"BTC-USD"}, 0).Ticks["BTC-USD"].VenueBps
+	}
+	assert.Len(t, venueBps(), 3)
+	cancel()
+	require.Eventually(t, func() bool { return venueBps() == nil }, time.Second, 10*time.Millisecond)
+}
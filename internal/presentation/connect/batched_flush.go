@@ -0,0 +1,92 @@
+package connectpresentation
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
+)
+
+// flushGate defers response flushes while a stream writes a batch. Connect
+// flushes after every message; with the gate held those flushes collapse
+// into one when the batch is released, so a tick's updates share frames.
+type flushGate struct {
+	mu      sync.Mutex
+	flusher http.Flusher
+	held    bool
+	pending bool
+}
+
+type flushGateKey struct{}
+
+// WithBatchedFlush wraps the Connect handler so stream adapters can write a
+// batch of messages before flushing them onto the wire together
+func WithBatchedFlush(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+		gate := &flushGate{flusher: flusher}
+		ctx := context.WithValue(r.Context(), flushGateKey{}, gate)
+		next.ServeHTTP(&gatedResponseWriter{ResponseWriter: w, gate: gate}, r.WithContext(ctx))
+	})
+}
+
+// gatedResponseWriter routes flushes through the request's gate
+type gatedResponseWriter struct {
+	http.ResponseWriter
+	gate *flushGate
+}
+
+func (w *gatedResponseWriter) Flush() {
+	w.gate.flush()
+}
+
+// Unwrap exposes the underlying writer to http.ResponseController
+func (w *gatedResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func (g *flushGate) flush() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.held {
+		g.pending = true
+		return
+	}
+	g.flusher.Flush()
+}
+
+func (g *flushGate) hold() {
+	g.mu.Lock()
+	g.held = true
+	g.mu.Unlock()
+}
+
+func (g *flushGate) release() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.held = false
+	if g.pending {
+		g.pending = false
+		g.flusher.Flush()
+	}
+}
+
+// sendBatched sends each message with the response flushed once at the end.
+// Without a gate in the context every send flushes as usual.
+func sendBatched(ctx context.Context, batch []*proto.PriceUpdate, send func(*proto.PriceUpdate) error) error {
+	if gate, ok := ctx.Value(flushGateKey{}).(*flushGate); ok {
+		gate.hold()
+		defer gate.release()
+	}
+	for _, update := range batch {
+		if err := send(update); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -2,9 +2,12 @@ package connectpresentation
 
 import (
 	"context"
+	"errors"
+	"net/http"
 
 	"connectrpc.com/connect"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/handlers"
 	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/proto"
@@ -34,7 +37,7 @@ func (h *MarketDataConnectAdapter) GetPrice(
 	// Call the underlying gRPC handler
 	resp, err := h.grpcHandler.GetPrice(ctx, req.Msg)
 	if err != nil {
-		return nil, err
+		return nil, toConnectError(err)
 	}
 	return connect.NewResponse(resp), nil
 }
@@ -48,11 +51,38 @@ func (h *MarketDataConnectAdapter) StreamPrices(
 	// Create a stream adapter to bridge Connect and gRPC streaming interfaces
 	streamAdapter := &priceStreamAdapter{
 		stream: stream,
-		ctx:    ctx,
+		ctx:    withRequestMetadata(ctx, req.Header()),
 	}
 
 	// Call the underlying gRPC handler with adapted stream
-	return h.grpcHandler.StreamPrices(req.Msg, streamAdapter)
+	return toConnectError(h.grpcHandler.StreamPrices(req.Msg, streamAdapter))
+}
+
+// StreamPriceBatches implements the Connect handler for StreamPriceBatches (server streaming RPC)
+func (h *MarketDataConnectAdapter) StreamPriceBatches(
+	ctx context.Context,
+	req *connect.Request[proto.StreamPricesRequest],
+	stream *connect.ServerStream[proto.PriceUpdateBatch],
+) error {
+	streamAdapter := &priceBatchStreamAdapter{
+		stream: stream,
+		ctx:    withRequestMetadata(ctx, req.Header()),
+	}
+
+	return toConnectError(h.grpcHandler.StreamPriceBatches(req.Msg, streamAdapter))
+}
+
+// Subscribe implements the Connect handler for Subscribe (bidirectional streaming RPC)
+func (h *MarketDataConnectAdapter) Subscribe(
+	ctx context.Context,
+	stream *connect.BidiStream[proto.SubscriptionRequest, proto.PriceUpdate],
+) error {
+	streamAdapter := &subscribeStreamAdapter{
+		stream: stream,
+		ctx:    withRequestMetadata(ctx, stream.RequestHeader()),
+	}
+
+	return toConnectError(h.grpcHandler.Subscribe(streamAdapter))
 }
 
 // GenerateSimulation implements the Connect handler for GenerateSimulation (unary RPC)
@@ -81,6 +111,104 @@ func (h *MarketDataConnectAdapter) StreamScenario(
 	return h.grpcHandler.StreamScenario(req.Msg, streamAdapter)
 }
 
+// GetOptionChain implements the Connect handler for GetOptionChain (unary RPC)
+func (h *MarketDataConnectAdapter) GetOptionChain(
+	ctx context.Context,
+	req *connect.Request[proto.GetOptionChainRequest],
+) (*connect.Response[proto.OptionChain], error) {
+	resp, err := h.grpcHandler.GetOptionChain(ctx, req.Msg)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// StreamOptionChain implements the Connect handler for StreamOptionChain (server streaming RPC)
+func (h *MarketDataConnectAdapter) StreamOptionChain(
+	ctx context.Context,
+	req *connect.Request[proto.StreamOptionChainRequest],
+	stream *connect.ServerStream[proto.OptionChain],
+) error {
+	streamAdapter := &optionChainStreamAdapter{
+		stream: stream,
+		ctx:    ctx,
+	}
+
+	return toConnectError(h.grpcHandler.StreamOptionChain(req.Msg, streamAdapter))
+}
+
+// GetYieldCurve implements the Connect handler for GetYieldCurve (unary RPC)
+func (h *MarketDataConnectAdapter) GetYieldCurve(
+	ctx context.Context,
+	req *connect.Request[proto.GetYieldCurveRequest],
+) (*connect.Response[proto.YieldCurve], error) {
+	resp, err := h.grpcHandler.GetYieldCurve(ctx, req.Msg)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// RecoverPriceUpdates implements the Connect handler for RecoverPriceUpdates (unary RPC)
+func (h *MarketDataConnectAdapter) RecoverPriceUpdates(
+	ctx context.Context,
+	req *connect.Request[proto.RecoverPriceUpdatesRequest],
+) (*connect.Response[proto.RecoverPriceUpdatesResponse], error) {
+	resp, err := h.grpcHandler.RecoverPriceUpdates(ctx, req.Msg)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// ReportTrade implements the Connect handler for ReportTrade (unary RPC)
+func (h *MarketDataConnectAdapter) ReportTrade(
+	ctx context.Context,
+	req *connect.Request[proto.TradeReport],
+) (*connect.Response[proto.TradeReportResponse], error) {
+	resp, err := h.grpcHandler.ReportTrade(ctx, req.Msg)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// GetReferencePrices implements the Connect handler for GetReferencePrices (unary RPC)
+func (h *MarketDataConnectAdapter) GetReferencePrices(
+	ctx context.Context,
+	req *connect.Request[proto.GetReferencePricesRequest],
+) (*connect.Response[proto.ReferencePricesResponse], error) {
+	resp, err := h.grpcHandler.GetReferencePrices(ctx, req.Msg)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// ListInstruments implements the Connect handler for ListInstruments (unary RPC)
+func (h *MarketDataConnectAdapter) ListInstruments(
+	ctx context.Context,
+	req *connect.Request[proto.ListInstrumentsRequest],
+) (*connect.Response[proto.ListInstrumentsResponse], error) {
+	resp, err := h.grpcHandler.ListInstruments(ctx, req.Msg)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// GetInstrument implements the Connect handler for GetInstrument (unary RPC)
+func (h *MarketDataConnectAdapter) GetInstrument(
+	ctx context.Context,
+	req *connect.Request[proto.GetInstrumentRequest],
+) (*connect.Response[proto.Instrument], error) {
+	resp, err := h.grpcHandler.GetInstrument(ctx, req.Msg)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
 // HealthCheck implements the Connect handler for HealthCheck (unary RPC)
 func (h *MarketDataConnectAdapter) HealthCheck(
 	ctx context.Context,
@@ -93,6 +221,28 @@ func (h *MarketDataConnectAdapter) HealthCheck(
 	return connect.NewResponse(resp), nil
 }
 
+// toConnectError maps gRPC status errors from the handler onto Connect errors
+// so browser clients see the same code (NOT_FOUND, INVALID_ARGUMENT, ...)
+func toConnectError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if st, ok := status.FromError(err); ok {
+		return connect.NewError(connect.Code(st.Code()), errors.New(st.Message()))
+	}
+	return err
+}
+
+// withRequestMetadata exposes Connect request headers to the gRPC handler as
+// incoming metadata, so client identity (x-client) is read the same way
+func withRequestMetadata(ctx context.Context, header http.Header) context.Context {
+	md := metadata.MD{}
+	for key, values := range header {
+		md.Append(key, values...)
+	}
+	return metadata.NewIncomingContext(ctx, md)
+}
+
 // priceStreamAdapter adapts Connect ServerStream to gRPC streaming interface for PriceUpdate
 type priceStreamAdapter struct {
 	stream *connect.ServerStream[proto.PriceUpdate]
@@ -104,6 +254,11 @@ func (s *priceStreamAdapter) Send(msg *proto.PriceUpdate) error {
 	return s.stream.Send(msg)
 }
 
+// SendBatch writes a flushed batch of updates in as few frames as possible
+func (s *priceStreamAdapter) SendBatch(batch []*proto.PriceUpdate) error {
+	return sendBatched(s.ctx, batch, s.Send)
+}
+
 // Context implements grpc.ServerStream.Context
 func (s *priceStreamAdapter) Context() context.Context {
 	return s.ctx
@@ -138,6 +293,49 @@ func (s *priceStreamAdapter) RecvMsg(m interface{}) error {
 	return nil
 }
 
+// priceBatchStreamAdapter adapts Connect ServerStream to gRPC streaming interface for PriceUpdateBatch
+type priceBatchStreamAdapter struct {
+	stream *connect.ServerStream[proto.PriceUpdateBatch]
+	ctx    context.Context
+}
+
+// Send implements grpc.ServerStream.SendMsg for PriceUpdateBatch
+func (s *priceBatchStreamAdapter) Send(msg *proto.PriceUpdateBatch) error {
+	return s.stream.Send(msg)
+}
+
+// Context implements grpc.ServerStream.Context
+func (s *priceBatchStreamAdapter) Context() context.Context {
+	return s.ctx
+}
+
+// SetHeader implements grpc.ServerStream.SetHeader
+func (s *priceBatchStreamAdapter) SetHeader(md metadata.MD) error {
+	return nil
+}
+
+// SendHeader implements grpc.ServerStream.SendHeader
+func (s *priceBatchStreamAdapter) SendHeader(md metadata.MD) error {
+	return nil
+}
+
+// SetTrailer implements grpc.ServerStream.SetTrailer
+func (s *priceBatchStreamAdapter) SetTrailer(md metadata.MD) {
+}
+
+// SendMsg implements grpc.ServerStream.SendMsg
+func (s *priceBatchStreamAdapter) SendMsg(m interface{}) error {
+	if msg, ok := m.(*proto.PriceUpdateBatch); ok {
+		return s.Send(msg)
+	}
+	return nil
+}
+
+// RecvMsg implements grpc.ServerStream.RecvMsg (not used for server streaming)
+func (s *priceBatchStreamAdapter) RecvMsg(m interface{}) error {
+	return nil
+}
+
 // scenarioStreamAdapter adapts Connect ServerStream to gRPC streaming interface for ScenarioRequest
 type scenarioStreamAdapter struct {
 	stream *connect.ServerStream[proto.PriceUpdate]
@@ -180,3 +378,99 @@ func (s *scenarioStreamAdapter) SendMsg(m interface{}) error {
 func (s *scenarioStreamAdapter) RecvMsg(m interface{}) error {
 	return nil
 }
+
+// optionChainStreamAdapter adapts Connect ServerStream to gRPC streaming interface for OptionChain
+type optionChainStreamAdapter struct {
+	stream *connect.ServerStream[proto.OptionChain]
+	ctx    context.Context
+}
+
+// Send implements grpc.ServerStream.SendMsg for OptionChain
+func (s *optionChainStreamAdapter) Send(msg *proto.OptionChain) error {
+	return s.stream.Send(msg)
+}
+
+// Context implements grpc.ServerStream.Context
+func (s *optionChainStreamAdapter) Context() context.Context {
+	return s.ctx
+}
+
+// SetHeader implements grpc.ServerStream.SetHeader
+func (s *optionChainStreamAdapter) SetHeader(md metadata.MD) error {
+	return nil
+}
+
+// SendHeader implements grpc.ServerStream.SendHeader
+func (s *optionChainStreamAdapter) SendHeader(md metadata.MD) error {
+	return nil
+}
+
+// SetTrailer implements grpc.ServerStream.SetTrailer
+func (s *optionChainStreamAdapter) SetTrailer(md metadata.MD) {
+}
+
+// SendMsg implements grpc.ServerStream.SendMsg
+func (s *optionChainStreamAdapter) SendMsg(m interface{}) error {
+	if msg, ok := m.(*proto.OptionChain); ok {
+		return s.Send(msg)
+	}
+	return nil
+}
+
+// RecvMsg implements grpc.ServerStream.RecvMsg (not used for server streaming)
+func (s *optionChainStreamAdapter) RecvMsg(m interface{}) error {
+	return nil
+}
+
+// subscribeStreamAdapter adapts Connect BidiStream to gRPC bidirectional streaming interface for Subscribe
+type subscribeStreamAdapter struct {
+	stream *connect.BidiStream[proto.SubscriptionRequest, proto.PriceUpdate]
+	ctx    context.Context
+}
+
+// Recv implements grpc.BidiStreamingServer.Recv for subscription requests
+func (s *subscribeStreamAdapter) Recv() (*proto.SubscriptionRequest, error) {
+	return s.stream.Receive()
+}
+
+// Send implements grpc.BidiStreamingServer.Send for PriceUpdate
+func (s *subscribeStreamAdapter) Send(msg *proto.PriceUpdate) error {
+	return s.stream.Send(msg)
+}
+
+// SendBatch writes a flushed batch of updates in as few frames as possible
+func (s *subscribeStreamAdapter) SendBatch(batch []*proto.PriceUpdate) error {
+	return sendBatched(s.ctx, batch, s.Send)
+}
+
+// Context implements grpc.ServerStream.Context
+func (s *subscribeStreamAdapter) Context() context.Context {
+	return s.ctx
+}
+
+// SetHeader implements grpc.ServerStream.SetHeader
+func (s *subscribeStreamAdapter) SetHeader(md metadata.MD) error {
+	return nil
+}
+
+// SendHeader implements grpc.ServerStream.SendHeader
+func (s *subscribeStreamAdapter) SendHeader(md metadata.MD) error {
+	return nil
+}
+
+// SetTrailer implements grpc.ServerStream.SetTrailer
+func (s *subscribeStreamAdapter) SetTrailer(md metadata.MD) {
+}
+
+// SendMsg implements grpc.ServerStream.SendMsg
+func (s *subscribeStreamAdapter) SendMsg(m interface{}) error {
+	if msg, ok := m.(*proto.PriceUpdate); ok {
+		return s.Send(msg)
+	}
+	return nil
+}
+
+// RecvMsg implements grpc.ServerStream.RecvMsg
+func (s *subscribeStreamAdapter) RecvMsg(m interface{}) error {
+	return s.stream.Conn().Receive(m)
+}
@@ -80,12 +80,14 @@ func (SimulationType) EnumDescriptor() ([]byte, []int) {
 type ScenarioType int32
 
 const (
-	ScenarioType_RALLY            ScenarioType = 0
-	ScenarioType_CRASH            ScenarioType = 1
-	ScenarioType_DIVERGENCE       ScenarioType = 2
-	ScenarioType_MEAN_REVERTING   ScenarioType = 3
-	ScenarioType_VOLATILITY_SPIKE ScenarioType = 4
-	ScenarioType_CONSOLIDATION    ScenarioType = 5
+	ScenarioType_RALLY             ScenarioType = 0
+	ScenarioType_CRASH             ScenarioType = 1
+	ScenarioType_DIVERGENCE        ScenarioType = 2
+	ScenarioType_MEAN_REVERTING    ScenarioType = 3
+	ScenarioType_VOLATILITY_SPIKE  ScenarioType = 4
+	ScenarioType_CONSOLIDATION     ScenarioType = 5
+	ScenarioType_LIQUIDITY_DROUGHT ScenarioType = 6 // Depth and trading dry up and spreads widen, then recover
+	ScenarioType_STABLECOIN_DEPEG  ScenarioType = 7 // Pinned near 1.0, then breaks the peg on heavy volume and thin books and recovers fully or partly
 )
 
 // Enum value maps for ScenarioType.
@@ -97,14 +99,18 @@ var (
 		3: "MEAN_REVERTING",
 		4: "VOLATILITY_SPIKE",
 		5: "CONSOLIDATION",
+		6: "LIQUIDITY_DROUGHT",
+		7: "STABLECOIN_DEPEG",
 	}
 	ScenarioType_value = map[string]int32{
-		"RALLY":            0,
-		"CRASH":            1,
-		"DIVERGENCE":       2,
-		"MEAN_REVERTING":   3,
-		"VOLATILITY_SPIKE": 4,
-		"CONSOLIDATION":    5,
+		"RALLY":             0,
+		"CRASH":             1,
+		"DIVERGENCE":        2,
+		"MEAN_REVERTING":    3,
+		"VOLATILITY_SPIKE":  4,
+		"CONSOLIDATION":     5,
+		"LIQUIDITY_DROUGHT": 6,
+		"STABLECOIN_DEPEG":  7,
 	}
 )
 
@@ -135,80 +141,3795 @@ func (ScenarioType) EnumDescriptor() ([]byte, []int) {
 	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{1}
 }
 
-type HealthStatus int32
+type SubscriptionAction int32
 
 const (
-	HealthStatus_UNKNOWN         HealthStatus = 0
-	HealthStatus_SERVING         HealthStatus = 1
-	HealthStatus_NOT_SERVING     HealthStatus = 2
-	HealthStatus_SERVICE_UNKNOWN HealthStatus = 3
+	SubscriptionAction_ADD_SYMBOLS         SubscriptionAction = 0
+	SubscriptionAction_REMOVE_SYMBOLS      SubscriptionAction = 1
+	SubscriptionAction_SET_INTERVAL        SubscriptionAction = 2
+	SubscriptionAction_SNAPSHOT            SubscriptionAction = 3 // Current price of the given symbols, or all subscribed when empty
+	SubscriptionAction_SET_DELIVERY_POLICY SubscriptionAction = 4
 )
 
-// Enum value maps for HealthStatus.
+// Enum value maps for SubscriptionAction.
 var (
-	HealthStatus_name = map[int32]string{
-		0: "UNKNOWN",
-		1: "SERVING",
-		2: "NOT_SERVING",
-		3: "SERVICE_UNKNOWN",
+	SubscriptionAction_name = map[int32]string{
+		0: "ADD_SYMBOLS",
+		1: "REMOVE_SYMBOLS",
+		2: "SET_INTERVAL",
+		3: "SNAPSHOT",
+		4: "SET_DELIVERY_POLICY",
 	}
-	HealthStatus_value = map[string]int32{
-		"UNKNOWN":         0,
-		"SERVING":         1,
-		"NOT_SERVING":     2,
-		"SERVICE_UNKNOWN": 3,
+	SubscriptionAction_value = map[string]int32{
+		"ADD_SYMBOLS":         0,
+		"REMOVE_SYMBOLS":      1,
+		"SET_INTERVAL":        2,
+		"SNAPSHOT":            3,
+		"SET_DELIVERY_POLICY": 4,
+	}
+)
+
+func (x SubscriptionAction) Enum() *SubscriptionAction {
+	p := new(SubscriptionAction)
+	*p = x
+	return p
+}
+
+func (x SubscriptionAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SubscriptionAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_proto_marketdata_proto_enumTypes[2].Descriptor()
+}
+
+func (SubscriptionAction) Type() protoreflect.EnumType {
+	return &file_internal_proto_marketdata_proto_enumTypes[2]
+}
+
+func (x SubscriptionAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SubscriptionAction.Descriptor instead.
+func (SubscriptionAction) EnumDescriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{2}
+}
+
+type DeliveryPolicy int32
+
+const (
+	DeliveryPolicy_EVERY_TICK DeliveryPolicy = 0
+	DeliveryPolicy_CONFLATED  DeliveryPolicy = 1 // Latest value per symbol, at most once per max_rate_ms
+	DeliveryPolicy_ON_CHANGE  DeliveryPolicy = 2 // Only when the price moved beyond change_threshold_percent
+)
+
+// Enum value maps for DeliveryPolicy.
+var (
+	DeliveryPolicy_name = map[int32]string{
+		0: "EVERY_TICK",
+		1: "CONFLATED",
+		2: "ON_CHANGE",
+	}
+	DeliveryPolicy_value = map[string]int32{
+		"EVERY_TICK": 0,
+		"CONFLATED":  1,
+		"ON_CHANGE":  2,
+	}
+)
+
+func (x DeliveryPolicy) Enum() *DeliveryPolicy {
+	p := new(DeliveryPolicy)
+	*p = x
+	return p
+}
+
+func (x DeliveryPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeliveryPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_proto_marketdata_proto_enumTypes[3].Descriptor()
+}
+
+func (DeliveryPolicy) Type() protoreflect.EnumType {
+	return &file_internal_proto_marketdata_proto_enumTypes[3]
+}
+
+func (x DeliveryPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeliveryPolicy.Descriptor instead.
+func (DeliveryPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{3}
+}
+
+type OverflowPolicy int32
+
+const (
+	OverflowPolicy_OVERFLOW_DEFAULT OverflowPolicy = 0
+	OverflowPolicy_DROP_OLDEST      OverflowPolicy = 1 // Discard the oldest queued update; the client sees a sequence gap
+	OverflowPolicy_CONFLATE         OverflowPolicy = 2 // Discard the queued update for the same symbol
+	OverflowPolicy_DISCONNECT       OverflowPolicy = 3 // End the stream with RESOURCE_EXHAUSTED
+)
+
+// Enum value maps for OverflowPolicy.
+var (
+	OverflowPolicy_name = map[int32]string{
+		0: "OVERFLOW_DEFAULT",
+		1: "DROP_OLDEST",
+		2: "CONFLATE",
+		3: "DISCONNECT",
+	}
+	OverflowPolicy_value = map[string]int32{
+		"OVERFLOW_DEFAULT": 0,
+		"DROP_OLDEST":      1,
+		"CONFLATE":         2,
+		"DISCONNECT":       3,
+	}
+)
+
+func (x OverflowPolicy) Enum() *OverflowPolicy {
+	p := new(OverflowPolicy)
+	*p = x
+	return p
+}
+
+func (x OverflowPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OverflowPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_proto_marketdata_proto_enumTypes[4].Descriptor()
+}
+
+func (OverflowPolicy) Type() protoreflect.EnumType {
+	return &file_internal_proto_marketdata_proto_enumTypes[4]
+}
+
+func (x OverflowPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OverflowPolicy.Descriptor instead.
+func (OverflowPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{4}
+}
+
+type TradingStatus int32
+
+const (
+	TradingStatus_TRADING    TradingStatus = 0
+	TradingStatus_HALTED     TradingStatus = 1
+	TradingStatus_LIMIT_UP   TradingStatus = 2 // Held at the upper band
+	TradingStatus_LIMIT_DOWN TradingStatus = 3 // Held at the lower band
+)
+
+// Enum value maps for TradingStatus.
+var (
+	TradingStatus_name = map[int32]string{
+		0: "TRADING",
+		1: "HALTED",
+		2: "LIMIT_UP",
+		3: "LIMIT_DOWN",
+	}
+	TradingStatus_value = map[string]int32{
+		"TRADING":    0,
+		"HALTED":     1,
+		"LIMIT_UP":   2,
+		"LIMIT_DOWN": 3,
+	}
+)
+
+func (x TradingStatus) Enum() *TradingStatus {
+	p := new(TradingStatus)
+	*p = x
+	return p
+}
+
+func (x TradingStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TradingStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_proto_marketdata_proto_enumTypes[5].Descriptor()
+}
+
+func (TradingStatus) Type() protoreflect.EnumType {
+	return &file_internal_proto_marketdata_proto_enumTypes[5]
+}
+
+func (x TradingStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TradingStatus.Descriptor instead.
+func (TradingStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{5}
+}
+
+type MarketEventType int32
+
+const (
+	MarketEventType_MARKET_EVENT_UNSPECIFIED MarketEventType = 0
+	MarketEventType_HALT                     MarketEventType = 1
+	MarketEventType_RESUME                   MarketEventType = 2
+	MarketEventType_LIMIT_REACHED            MarketEventType = 3
+	MarketEventType_LIMIT_RELEASED           MarketEventType = 4
+	MarketEventType_PHASE_CHANGE             MarketEventType = 5
+	MarketEventType_FUNDING                  MarketEventType = 6 // A perpetual's funding settled, see PerpetualInfo.last_funding_rate
+	MarketEventType_SETTLEMENT               MarketEventType = 7 // A dated future expired and settled, see FutureInfo.settlement_price
+	MarketEventType_RATE_SHOCK               MarketEventType = 8 // The yield curve jumped in parallel, see BondInfo.curve
+)
+
+// Enum value maps for MarketEventType.
+var (
+	MarketEventType_name = map[int32]string{
+		0: "MARKET_EVENT_UNSPECIFIED",
+		1: "HALT",
+		2: "RESUME",
+		3: "LIMIT_REACHED",
+		4: "LIMIT_RELEASED",
+		5: "PHASE_CHANGE",
+		6: "FUNDING",
+		7: "SETTLEMENT",
+		8: "RATE_SHOCK",
+	}
+	MarketEventType_value = map[string]int32{
+		"MARKET_EVENT_UNSPECIFIED": 0,
+		"HALT":                     1,
+		"RESUME":                   2,
+		"LIMIT_REACHED":            3,
+		"LIMIT_RELEASED":           4,
+		"PHASE_CHANGE":             5,
+		"FUNDING":                  6,
+		"SETTLEMENT":               7,
+		"RATE_SHOCK":               8,
+	}
+)
+
+func (x MarketEventType) Enum() *MarketEventType {
+	p := new(MarketEventType)
+	*p = x
+	return p
+}
+
+func (x MarketEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MarketEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_proto_marketdata_proto_enumTypes[6].Descriptor()
+}
+
+func (MarketEventType) Type() protoreflect.EnumType {
+	return &file_internal_proto_marketdata_proto_enumTypes[6]
+}
+
+func (x MarketEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MarketEventType.Descriptor instead.
+func (MarketEventType) EnumDescriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{6}
+}
+
+type TradingPhase int32
+
+const (
+	TradingPhase_CONTINUOUS      TradingPhase = 0 // Instruments without a trading schedule are always continuous
+	TradingPhase_PRE_OPEN        TradingPhase = 1
+	TradingPhase_OPENING_AUCTION TradingPhase = 2
+	TradingPhase_CLOSING_AUCTION TradingPhase = 3
+	TradingPhase_CLOSED          TradingPhase = 4
+)
+
+// Enum value maps for TradingPhase.
+var (
+	TradingPhase_name = map[int32]string{
+		0: "CONTINUOUS",
+		1: "PRE_OPEN",
+		2: "OPENING_AUCTION",
+		3: "CLOSING_AUCTION",
+		4: "CLOSED",
+	}
+	TradingPhase_value = map[string]int32{
+		"CONTINUOUS":      0,
+		"PRE_OPEN":        1,
+		"OPENING_AUCTION": 2,
+		"CLOSING_AUCTION": 3,
+		"CLOSED":          4,
+	}
+)
+
+func (x TradingPhase) Enum() *TradingPhase {
+	p := new(TradingPhase)
+	*p = x
+	return p
+}
+
+func (x TradingPhase) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TradingPhase) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_proto_marketdata_proto_enumTypes[7].Descriptor()
+}
+
+func (TradingPhase) Type() protoreflect.EnumType {
+	return &file_internal_proto_marketdata_proto_enumTypes[7]
+}
+
+func (x TradingPhase) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TradingPhase.Descriptor instead.
+func (TradingPhase) EnumDescriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{7}
+}
+
+type ReferencePriceType int32
+
+const (
+	ReferencePriceType_REFERENCE_PRICE_UNSPECIFIED ReferencePriceType = 0
+	ReferencePriceType_NBBO                        ReferencePriceType = 1 // Midpoint of the best bid and offer across venues
+	ReferencePriceType_INDEX                       ReferencePriceType = 2 // Volume-weighted average of venue mids
+	ReferencePriceType_MARK                        ReferencePriceType = 3 // Average of venue mids after rejecting outliers
+)
+
+// Enum value maps for ReferencePriceType.
+var (
+	ReferencePriceType_name = map[int32]string{
+		0: "REFERENCE_PRICE_UNSPECIFIED",
+		1: "NBBO",
+		2: "INDEX",
+		3: "MARK",
+	}
+	ReferencePriceType_value = map[string]int32{
+		"REFERENCE_PRICE_UNSPECIFIED": 0,
+		"NBBO":                        1,
+		"INDEX":                       2,
+		"MARK":                        3,
+	}
+)
+
+func (x ReferencePriceType) Enum() *ReferencePriceType {
+	p := new(ReferencePriceType)
+	*p = x
+	return p
+}
+
+func (x ReferencePriceType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReferencePriceType) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_proto_marketdata_proto_enumTypes[8].Descriptor()
+}
+
+func (ReferencePriceType) Type() protoreflect.EnumType {
+	return &file_internal_proto_marketdata_proto_enumTypes[8]
+}
+
+func (x ReferencePriceType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReferencePriceType.Descriptor instead.
+func (ReferencePriceType) EnumDescriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{8}
+}
+
+// ApiVersion selects the price representation. API_V1 carries prices and
+// sizes as doubles only; API_V2 adds exact Decimal fields alongside them
+// while clients migrate. Unspecified means API_V1.
+type ApiVersion int32
+
+const (
+	ApiVersion_API_VERSION_UNSPECIFIED ApiVersion = 0
+	ApiVersion_API_V1                  ApiVersion = 1
+	ApiVersion_API_V2                  ApiVersion = 2
+)
+
+// Enum value maps for ApiVersion.
+var (
+	ApiVersion_name = map[int32]string{
+		0: "API_VERSION_UNSPECIFIED",
+		1: "API_V1",
+		2: "API_V2",
+	}
+	ApiVersion_value = map[string]int32{
+		"API_VERSION_UNSPECIFIED": 0,
+		"API_V1":                  1,
+		"API_V2":                  2,
+	}
+)
+
+func (x ApiVersion) Enum() *ApiVersion {
+	p := new(ApiVersion)
+	*p = x
+	return p
+}
+
+func (x ApiVersion) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ApiVersion) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_proto_marketdata_proto_enumTypes[9].Descriptor()
+}
+
+func (ApiVersion) Type() protoreflect.EnumType {
+	return &file_internal_proto_marketdata_proto_enumTypes[9]
+}
+
+func (x ApiVersion) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ApiVersion.Descriptor instead.
+func (ApiVersion) EnumDescriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{9}
+}
+
+type OptionType int32
+
+const (
+	OptionType_OPTION_TYPE_UNSPECIFIED OptionType = 0
+	OptionType_CALL                    OptionType = 1
+	OptionType_PUT                     OptionType = 2
+)
+
+// Enum value maps for OptionType.
+var (
+	OptionType_name = map[int32]string{
+		0: "OPTION_TYPE_UNSPECIFIED",
+		1: "CALL",
+		2: "PUT",
+	}
+	OptionType_value = map[string]int32{
+		"OPTION_TYPE_UNSPECIFIED": 0,
+		"CALL":                    1,
+		"PUT":                     2,
+	}
+)
+
+func (x OptionType) Enum() *OptionType {
+	p := new(OptionType)
+	*p = x
+	return p
+}
+
+func (x OptionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OptionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_proto_marketdata_proto_enumTypes[10].Descriptor()
+}
+
+func (OptionType) Type() protoreflect.EnumType {
+	return &file_internal_proto_marketdata_proto_enumTypes[10]
+}
+
+func (x OptionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OptionType.Descriptor instead.
+func (OptionType) EnumDescriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{10}
+}
+
+type TradeSide int32
+
+const (
+	TradeSide_TRADE_SIDE_UNSPECIFIED TradeSide = 0
+	TradeSide_BUY                    TradeSide = 1
+	TradeSide_SELL                   TradeSide = 2
+)
+
+// Enum value maps for TradeSide.
+var (
+	TradeSide_name = map[int32]string{
+		0: "TRADE_SIDE_UNSPECIFIED",
+		1: "BUY",
+		2: "SELL",
+	}
+	TradeSide_value = map[string]int32{
+		"TRADE_SIDE_UNSPECIFIED": 0,
+		"BUY":                    1,
+		"SELL":                   2,
+	}
+)
+
+func (x TradeSide) Enum() *TradeSide {
+	p := new(TradeSide)
+	*p = x
+	return p
+}
+
+func (x TradeSide) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TradeSide) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_proto_marketdata_proto_enumTypes[11].Descriptor()
+}
+
+func (TradeSide) Type() protoreflect.EnumType {
+	return &file_internal_proto_marketdata_proto_enumTypes[11]
+}
+
+func (x TradeSide) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TradeSide.Descriptor instead.
+func (TradeSide) EnumDescriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{11}
+}
+
+type HealthStatus int32
+
+const (
+	HealthStatus_UNKNOWN         HealthStatus = 0
+	HealthStatus_SERVING         HealthStatus = 1
+	HealthStatus_NOT_SERVING     HealthStatus = 2
+	HealthStatus_SERVICE_UNKNOWN HealthStatus = 3
+)
+
+// Enum value maps for HealthStatus.
+var (
+	HealthStatus_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "SERVING",
+		2: "NOT_SERVING",
+		3: "SERVICE_UNKNOWN",
+	}
+	HealthStatus_value = map[string]int32{
+		"UNKNOWN":         0,
+		"SERVING":         1,
+		"NOT_SERVING":     2,
+		"SERVICE_UNKNOWN": 3,
+	}
+)
+
+func (x HealthStatus) Enum() *HealthStatus {
+	p := new(HealthStatus)
+	*p = x
+	return p
+}
+
+func (x HealthStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HealthStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_proto_marketdata_proto_enumTypes[12].Descriptor()
+}
+
+func (HealthStatus) Type() protoreflect.EnumType {
+	return &file_internal_proto_marketdata_proto_enumTypes[12]
+}
+
+func (x HealthStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HealthStatus.Descriptor instead.
+func (HealthStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{12}
+}
+
+type GetPriceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	ApiVersion    ApiVersion             `protobuf:"varint,2,opt,name=api_version,json=apiVersion,proto3,enum=marketdata.ApiVersion" json:"api_version,omitempty"`
+	QuoteCurrency string                 `protobuf:"bytes,3,opt,name=quote_currency,json=quoteCurrency,proto3" json:"quote_currency,omitempty"` // Optional: convert the price into this currency, e.g. "EUR"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceRequest) Reset() {
+	*x = GetPriceRequest{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceRequest) ProtoMessage() {}
+
+func (x *GetPriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceRequest.ProtoReflect.Descriptor instead.
+func (*GetPriceRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetPriceRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *GetPriceRequest) GetApiVersion() ApiVersion {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ApiVersion_API_VERSION_UNSPECIFIED
+}
+
+func (x *GetPriceRequest) GetQuoteCurrency() string {
+	if x != nil {
+		return x.QuoteCurrency
+	}
+	return ""
+}
+
+type GetPriceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Price         float64                `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Source        string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	PriceDecimal  *Decimal               `protobuf:"bytes,5,opt,name=price_decimal,json=priceDecimal,proto3" json:"price_decimal,omitempty"` // API_V2
+	Conversion    *ConversionInfo        `protobuf:"bytes,6,opt,name=conversion,proto3" json:"conversion,omitempty"`                         // Set when the price was converted into the requested quote currency
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceResponse) Reset() {
+	*x = GetPriceResponse{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceResponse) ProtoMessage() {}
+
+func (x *GetPriceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceResponse.ProtoReflect.Descriptor instead.
+func (*GetPriceResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetPriceResponse) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *GetPriceResponse) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *GetPriceResponse) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *GetPriceResponse) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *GetPriceResponse) GetPriceDecimal() *Decimal {
+	if x != nil {
+		return x.PriceDecimal
+	}
+	return nil
+}
+
+func (x *GetPriceResponse) GetConversion() *ConversionInfo {
+	if x != nil {
+		return x.Conversion
+	}
+	return nil
+}
+
+// ConversionInfo states how a price was converted into another quote
+// currency: converted price = original price × rate
+type ConversionInfo struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	FromCurrency         string                 `protobuf:"bytes,1,opt,name=from_currency,json=fromCurrency,proto3" json:"from_currency,omitempty"`
+	ToCurrency           string                 `protobuf:"bytes,2,opt,name=to_currency,json=toCurrency,proto3" json:"to_currency,omitempty"`
+	RateSymbol           string                 `protobuf:"bytes,3,opt,name=rate_symbol,json=rateSymbol,proto3" json:"rate_symbol,omitempty"` // Simulated pair the rate is taken from, into the pivot when one is set
+	RateSymbolPrice      float64                `protobuf:"fixed64,4,opt,name=rate_symbol_price,json=rateSymbolPrice,proto3" json:"rate_symbol_price,omitempty"`
+	Rate                 float64                `protobuf:"fixed64,5,opt,name=rate,proto3" json:"rate,omitempty"`                                              // Each pair's price, or its inverse when it quotes the other way, multiplied
+	OriginalPrice        float64                `protobuf:"fixed64,6,opt,name=original_price,json=originalPrice,proto3" json:"original_price,omitempty"`       // Before conversion
+	PivotCurrency        string                 `protobuf:"bytes,7,opt,name=pivot_currency,json=pivotCurrency,proto3" json:"pivot_currency,omitempty"`         // Set when no pair converts directly
+	PivotRateSymbol      string                 `protobuf:"bytes,8,opt,name=pivot_rate_symbol,json=pivotRateSymbol,proto3" json:"pivot_rate_symbol,omitempty"` // Simulated pair converting out of the pivot
+	PivotRateSymbolPrice float64                `protobuf:"fixed64,9,opt,name=pivot_rate_symbol_price,json=pivotRateSymbolPrice,proto3" json:"pivot_rate_symbol_price,omitempty"`
+	TickSize             float64                `protobuf:"fixed64,10,opt,name=tick_size,json=tickSize,proto3" json:"tick_size,omitempty"`                  // Converted prices are rounded to this tick in to_currency
+	PricePrecision       int32                  `protobuf:"varint,11,opt,name=price_precision,json=pricePrecision,proto3" json:"price_precision,omitempty"` // Decimal places of converted prices
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ConversionInfo) Reset() {
+	*x = ConversionInfo{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConversionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConversionInfo) ProtoMessage() {}
+
+func (x *ConversionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConversionInfo.ProtoReflect.Descriptor instead.
+func (*ConversionInfo) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ConversionInfo) GetFromCurrency() string {
+	if x != nil {
+		return x.FromCurrency
+	}
+	return ""
+}
+
+func (x *ConversionInfo) GetToCurrency() string {
+	if x != nil {
+		return x.ToCurrency
+	}
+	return ""
+}
+
+func (x *ConversionInfo) GetRateSymbol() string {
+	if x != nil {
+		return x.RateSymbol
+	}
+	return ""
+}
+
+func (x *ConversionInfo) GetRateSymbolPrice() float64 {
+	if x != nil {
+		return x.RateSymbolPrice
+	}
+	return 0
+}
+
+func (x *ConversionInfo) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *ConversionInfo) GetOriginalPrice() float64 {
+	if x != nil {
+		return x.OriginalPrice
+	}
+	return 0
+}
+
+func (x *ConversionInfo) GetPivotCurrency() string {
+	if x != nil {
+		return x.PivotCurrency
+	}
+	return ""
+}
+
+func (x *ConversionInfo) GetPivotRateSymbol() string {
+	if x != nil {
+		return x.PivotRateSymbol
+	}
+	return ""
+}
+
+func (x *ConversionInfo) GetPivotRateSymbolPrice() float64 {
+	if x != nil {
+		return x.PivotRateSymbolPrice
+	}
+	return 0
+}
+
+func (x *ConversionInfo) GetTickSize() float64 {
+	if x != nil {
+		return x.TickSize
+	}
+	return 0
+}
+
+func (x *ConversionInfo) GetPricePrecision() int32 {
+	if x != nil {
+		return x.PricePrecision
+	}
+	return 0
+}
+
+// Decimal is an exact decimal number: units × 10^-scale. The scale is the
+// instrument's price precision for prices and its lot size decimals for sizes.
+type Decimal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Units         int64                  `protobuf:"varint,1,opt,name=units,proto3" json:"units,omitempty"`
+	Scale         int32                  `protobuf:"varint,2,opt,name=scale,proto3" json:"scale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Decimal) Reset() {
+	*x = Decimal{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Decimal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Decimal) ProtoMessage() {}
+
+func (x *Decimal) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Decimal.ProtoReflect.Descriptor instead.
+func (*Decimal) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Decimal) GetUnits() int64 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
+}
+
+func (x *Decimal) GetScale() int32 {
+	if x != nil {
+		return x.Scale
+	}
+	return 0
+}
+
+type StreamPricesRequest struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Symbols                []string               `protobuf:"bytes,1,rep,name=symbols,proto3" json:"symbols,omitempty"`                                              // Symbols, wildcard patterns ("BTC-*", "*-USD") or named groups ("@majors")
+	UpdateIntervalMs       int32                  `protobuf:"varint,2,opt,name=update_interval_ms,json=updateIntervalMs,proto3" json:"update_interval_ms,omitempty"` // milliseconds
+	ResumeSessionId        string                 `protobuf:"bytes,3,opt,name=resume_session_id,json=resumeSessionId,proto3" json:"resume_session_id,omitempty"`     // Resume a dropped session instead of starting a new one
+	LastSequence           uint64                 `protobuf:"varint,4,opt,name=last_sequence,json=lastSequence,proto3" json:"last_sequence,omitempty"`               // Last sequence the client received on the resumed session
+	DeliveryPolicy         DeliveryPolicy         `protobuf:"varint,5,opt,name=delivery_policy,json=deliveryPolicy,proto3,enum=marketdata.DeliveryPolicy" json:"delivery_policy,omitempty"`
+	MaxRateMs              int32                  `protobuf:"varint,6,opt,name=max_rate_ms,json=maxRateMs,proto3" json:"max_rate_ms,omitempty"`                                             // CONFLATED: minimum time between updates of one symbol
+	ChangeThresholdPercent float64                `protobuf:"fixed64,7,opt,name=change_threshold_percent,json=changeThresholdPercent,proto3" json:"change_threshold_percent,omitempty"`     // ON_CHANGE: minimum move since the last delivered price
+	OverflowPolicy         OverflowPolicy         `protobuf:"varint,8,opt,name=overflow_policy,json=overflowPolicy,proto3,enum=marketdata.OverflowPolicy" json:"overflow_policy,omitempty"` // Slow-consumer handling; OVERFLOW_DEFAULT uses the server setting
+	UpdateIntervalUs       int64                  `protobuf:"varint,9,opt,name=update_interval_us,json=updateIntervalUs,proto3" json:"update_interval_us,omitempty"`                        // High-frequency mode: interval in microseconds, overrides update_interval_ms
+	Venues                 []string               `protobuf:"bytes,10,rep,name=venues,proto3" json:"venues,omitempty"`                                                                      // Stream these venues' quotes ("*" for every venue) instead of the fair value
+	Consolidated           bool                   `protobuf:"varint,11,opt,name=consolidated,proto3" json:"consolidated,omitempty"`                                                         // Stream the consolidated best bid/offer across all venues
+	ReferencePrices        bool                   `protobuf:"varint,12,opt,name=reference_prices,json=referencePrices,proto3" json:"reference_prices,omitempty"`                            // Stream NBBO, index and mark prices computed across all venues
+	ApiVersion             ApiVersion             `protobuf:"varint,13,opt,name=api_version,json=apiVersion,proto3,enum=marketdata.ApiVersion" json:"api_version,omitempty"`
+	QuoteCurrency          string                 `protobuf:"bytes,14,opt,name=quote_currency,json=quoteCurrency,proto3" json:"quote_currency,omitempty"` // Optional: convert prices into this currency at the shared market's FX rates, through the FX pivot when no pair converts directly. Symbols matched by a pattern or group that no rate reaches stay unconverted.
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *StreamPricesRequest) Reset() {
+	*x = StreamPricesRequest{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamPricesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamPricesRequest) ProtoMessage() {}
+
+func (x *StreamPricesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamPricesRequest.ProtoReflect.Descriptor instead.
+func (*StreamPricesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StreamPricesRequest) GetSymbols() []string {
+	if x != nil {
+		return x.Symbols
+	}
+	return nil
+}
+
+func (x *StreamPricesRequest) GetUpdateIntervalMs() int32 {
+	if x != nil {
+		return x.UpdateIntervalMs
+	}
+	return 0
+}
+
+func (x *StreamPricesRequest) GetResumeSessionId() string {
+	if x != nil {
+		return x.ResumeSessionId
+	}
+	return ""
+}
+
+func (x *StreamPricesRequest) GetLastSequence() uint64 {
+	if x != nil {
+		return x.LastSequence
+	}
+	return 0
+}
+
+func (x *StreamPricesRequest) GetDeliveryPolicy() DeliveryPolicy {
+	if x != nil {
+		return x.DeliveryPolicy
+	}
+	return DeliveryPolicy_EVERY_TICK
+}
+
+func (x *StreamPricesRequest) GetMaxRateMs() int32 {
+	if x != nil {
+		return x.MaxRateMs
+	}
+	return 0
+}
+
+func (x *StreamPricesRequest) GetChangeThresholdPercent() float64 {
+	if x != nil {
+		return x.ChangeThresholdPercent
+	}
+	return 0
+}
+
+func (x *StreamPricesRequest) GetOverflowPolicy() OverflowPolicy {
+	if x != nil {
+		return x.OverflowPolicy
+	}
+	return OverflowPolicy_OVERFLOW_DEFAULT
+}
+
+func (x *StreamPricesRequest) GetUpdateIntervalUs() int64 {
+	if x != nil {
+		return x.UpdateIntervalUs
+	}
+	return 0
+}
+
+func (x *StreamPricesRequest) GetVenues() []string {
+	if x != nil {
+		return x.Venues
+	}
+	return nil
+}
+
+func (x *StreamPricesRequest) GetConsolidated() bool {
+	if x != nil {
+		return x.Consolidated
+	}
+	return false
+}
+
+func (x *StreamPricesRequest) GetReferencePrices() bool {
+	if x != nil {
+		return x.ReferencePrices
+	}
+	return false
+}
+
+func (x *StreamPricesRequest) GetApiVersion() ApiVersion {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ApiVersion_API_VERSION_UNSPECIFIED
+}
+
+func (x *StreamPricesRequest) GetQuoteCurrency() string {
+	if x != nil {
+		return x.QuoteCurrency
+	}
+	return ""
+}
+
+type PriceUpdate struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Symbol          string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Price           float64                `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	Volume          float64                `protobuf:"fixed64,3,opt,name=volume,proto3" json:"volume,omitempty"`
+	Timestamp       *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Source          string                 `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
+	ChangeInfo      *PriceChangeInfo       `protobuf:"bytes,6,opt,name=change_info,json=changeInfo,proto3" json:"change_info,omitempty"`
+	SessionId       string                 `protobuf:"bytes,7,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Sequence        uint64                 `protobuf:"varint,8,opt,name=sequence,proto3" json:"sequence,omitempty"`                                    // Per-stream, starts at 1; a gap means dropped updates, see RecoverPriceUpdates
+	SymbolSequence  uint64                 `protobuf:"varint,9,opt,name=symbol_sequence,json=symbolSequence,proto3" json:"symbol_sequence,omitempty"`  // Per-symbol within the stream
+	Snapshot        bool                   `protobuf:"varint,10,opt,name=snapshot,proto3" json:"snapshot,omitempty"`                                   // Sent in response to a snapshot request rather than a tick
+	ConflatedCount  uint32                 `protobuf:"varint,11,opt,name=conflated_count,json=conflatedCount,proto3" json:"conflated_count,omitempty"` // Ticks of this symbol suppressed by the delivery policy since the previous update
+	Quote           *Quote                 `protobuf:"bytes,12,opt,name=quote,proto3" json:"quote,omitempty"`
+	OrderBook       *OrderBook             `protobuf:"bytes,13,opt,name=order_book,json=orderBook,proto3" json:"order_book,omitempty"`
+	TradeCount      uint32                 `protobuf:"varint,14,opt,name=trade_count,json=tradeCount,proto3" json:"trade_count,omitempty"` // Trades printed since the previous tick
+	TradingStatus   TradingStatus          `protobuf:"varint,15,opt,name=trading_status,json=tradingStatus,proto3,enum=marketdata.TradingStatus" json:"trading_status,omitempty"`
+	Event           *MarketEvent           `protobuf:"bytes,16,opt,name=event,proto3" json:"event,omitempty"` // Set when the trading status or phase changes
+	TradingPhase    TradingPhase           `protobuf:"varint,17,opt,name=trading_phase,json=tradingPhase,proto3,enum=marketdata.TradingPhase" json:"trading_phase,omitempty"`
+	Auction         *AuctionInfo           `protobuf:"bytes,18,opt,name=auction,proto3" json:"auction,omitempty"`                                        // During auctions; price is then the indicative price
+	Venue           string                 `protobuf:"bytes,19,opt,name=venue,proto3" json:"venue,omitempty"`                                            // Quoting venue, "CONSOLIDATED" for the best bid/offer across venues, empty for the fair value
+	ReferencePrices []*ReferencePrice      `protobuf:"bytes,20,rep,name=reference_prices,json=referencePrices,proto3" json:"reference_prices,omitempty"` // Set on "REFERENCE" updates, whose price is the mark price
+	Composite       *CompositeInfo         `protobuf:"bytes,21,opt,name=composite,proto3" json:"composite,omitempty"`                                    // Set for composite symbols
+	PriceDecimal    *Decimal               `protobuf:"bytes,22,opt,name=price_decimal,json=priceDecimal,proto3" json:"price_decimal,omitempty"`          // API_V2
+	VolumeDecimal   *Decimal               `protobuf:"bytes,23,opt,name=volume_decimal,json=volumeDecimal,proto3" json:"volume_decimal,omitempty"`       // API_V2
+	Perpetual       *PerpetualInfo         `protobuf:"bytes,24,opt,name=perpetual,proto3" json:"perpetual,omitempty"`                                    // Set for perpetual swaps, whose price is the last trade
+	Future          *FutureInfo            `protobuf:"bytes,25,opt,name=future,proto3" json:"future,omitempty"`                                          // Set for dated futures
+	Cross           *CrossInfo             `protobuf:"bytes,26,opt,name=cross,proto3" json:"cross,omitempty"`                                            // Set for FX crosses
+	Bond            *BondInfo              `protobuf:"bytes,27,opt,name=bond,proto3" json:"bond,omitempty"`                                              // Set for bonds, whose price is per 100 face value
+	Conversion      *ConversionInfo        `protobuf:"bytes,28,opt,name=conversion,proto3" json:"conversion,omitempty"`                                  // Set on streams with a quote currency; prices the update was derived from (legs, factors, curve) stay unconverted
+	Option          *OptionInfo            `protobuf:"bytes,29,opt,name=option,proto3" json:"option,omitempty"`                                          // Set for listed options, whose price is the mark
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PriceUpdate) Reset() {
+	*x = PriceUpdate{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceUpdate) ProtoMessage() {}
+
+func (x *PriceUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceUpdate.ProtoReflect.Descriptor instead.
+func (*PriceUpdate) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PriceUpdate) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *PriceUpdate) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PriceUpdate) GetVolume() float64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *PriceUpdate) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *PriceUpdate) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *PriceUpdate) GetChangeInfo() *PriceChangeInfo {
+	if x != nil {
+		return x.ChangeInfo
+	}
+	return nil
+}
+
+func (x *PriceUpdate) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *PriceUpdate) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *PriceUpdate) GetSymbolSequence() uint64 {
+	if x != nil {
+		return x.SymbolSequence
+	}
+	return 0
+}
+
+func (x *PriceUpdate) GetSnapshot() bool {
+	if x != nil {
+		return x.Snapshot
+	}
+	return false
+}
+
+func (x *PriceUpdate) GetConflatedCount() uint32 {
+	if x != nil {
+		return x.ConflatedCount
+	}
+	return 0
+}
+
+func (x *PriceUpdate) GetQuote() *Quote {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+func (x *PriceUpdate) GetOrderBook() *OrderBook {
+	if x != nil {
+		return x.OrderBook
+	}
+	return nil
+}
+
+func (x *PriceUpdate) GetTradeCount() uint32 {
+	if x != nil {
+		return x.TradeCount
+	}
+	return 0
+}
+
+func (x *PriceUpdate) GetTradingStatus() TradingStatus {
+	if x != nil {
+		return x.TradingStatus
+	}
+	return TradingStatus_TRADING
+}
+
+func (x *PriceUpdate) GetEvent() *MarketEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *PriceUpdate) GetTradingPhase() TradingPhase {
+	if x != nil {
+		return x.TradingPhase
+	}
+	return TradingPhase_CONTINUOUS
+}
+
+func (x *PriceUpdate) GetAuction() *AuctionInfo {
+	if x != nil {
+		return x.Auction
+	}
+	return nil
+}
+
+func (x *PriceUpdate) GetVenue() string {
+	if x != nil {
+		return x.Venue
+	}
+	return ""
+}
+
+func (x *PriceUpdate) GetReferencePrices() []*ReferencePrice {
+	if x != nil {
+		return x.ReferencePrices
+	}
+	return nil
+}
+
+func (x *PriceUpdate) GetComposite() *CompositeInfo {
+	if x != nil {
+		return x.Composite
+	}
+	return nil
+}
+
+func (x *PriceUpdate) GetPriceDecimal() *Decimal {
+	if x != nil {
+		return x.PriceDecimal
+	}
+	return nil
+}
+
+func (x *PriceUpdate) GetVolumeDecimal() *Decimal {
+	if x != nil {
+		return x.VolumeDecimal
+	}
+	return nil
+}
+
+func (x *PriceUpdate) GetPerpetual() *PerpetualInfo {
+	if x != nil {
+		return x.Perpetual
+	}
+	return nil
+}
+
+func (x *PriceUpdate) GetFuture() *FutureInfo {
+	if x != nil {
+		return x.Future
+	}
+	return nil
+}
+
+func (x *PriceUpdate) GetCross() *CrossInfo {
+	if x != nil {
+		return x.Cross
+	}
+	return nil
+}
+
+func (x *PriceUpdate) GetBond() *BondInfo {
+	if x != nil {
+		return x.Bond
+	}
+	return nil
+}
+
+func (x *PriceUpdate) GetConversion() *ConversionInfo {
+	if x != nil {
+		return x.Conversion
+	}
+	return nil
+}
+
+func (x *PriceUpdate) GetOption() *OptionInfo {
+	if x != nil {
+		return x.Option
+	}
+	return nil
+}
+
+// OptionInfo prices a listed option off its underlying in the shared market.
+// The update's quote is the volatility spread around the mark. Forwards,
+// volatilities and greeks are model outputs and stay doubles in API_V2.
+type OptionInfo struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Series                 string                 `protobuf:"bytes,1,opt,name=series,proto3" json:"series,omitempty"`
+	UnderlyingSymbol       string                 `protobuf:"bytes,2,opt,name=underlying_symbol,json=underlyingSymbol,proto3" json:"underlying_symbol,omitempty"`
+	UnderlyingPrice        float64                `protobuf:"fixed64,3,opt,name=underlying_price,json=underlyingPrice,proto3" json:"underlying_price,omitempty"`
+	Expiry                 *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	Type                   OptionType             `protobuf:"varint,5,opt,name=type,proto3,enum=marketdata.OptionType" json:"type,omitempty"`
+	Strike                 float64                `protobuf:"fixed64,6,opt,name=strike,proto3" json:"strike,omitempty"`
+	Forward                float64                `protobuf:"fixed64,7,opt,name=forward,proto3" json:"forward,omitempty"`
+	AtmVolatility          float64                `protobuf:"fixed64,8,opt,name=atm_volatility,json=atmVolatility,proto3" json:"atm_volatility,omitempty"`             // Implied volatility at the forward
+	ImpliedVolatility      float64                `protobuf:"fixed64,9,opt,name=implied_volatility,json=impliedVolatility,proto3" json:"implied_volatility,omitempty"` // At the strike, annualized, as a fraction
+	Greeks                 *OptionGreeks          `protobuf:"bytes,10,opt,name=greeks,proto3" json:"greeks,omitempty"`
+	UnderlyingPriceDecimal *Decimal               `protobuf:"bytes,11,opt,name=underlying_price_decimal,json=underlyingPriceDecimal,proto3" json:"underlying_price_decimal,omitempty"` // API_V2, at the underlying's precision
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *OptionInfo) Reset() {
+	*x = OptionInfo{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OptionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptionInfo) ProtoMessage() {}
+
+func (x *OptionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptionInfo.ProtoReflect.Descriptor instead.
+func (*OptionInfo) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *OptionInfo) GetSeries() string {
+	if x != nil {
+		return x.Series
+	}
+	return ""
+}
+
+func (x *OptionInfo) GetUnderlyingSymbol() string {
+	if x != nil {
+		return x.UnderlyingSymbol
+	}
+	return ""
+}
+
+func (x *OptionInfo) GetUnderlyingPrice() float64 {
+	if x != nil {
+		return x.UnderlyingPrice
+	}
+	return 0
+}
+
+func (x *OptionInfo) GetExpiry() *timestamp.Timestamp {
+	if x != nil {
+		return x.Expiry
+	}
+	return nil
+}
+
+func (x *OptionInfo) GetType() OptionType {
+	if x != nil {
+		return x.Type
+	}
+	return OptionType_OPTION_TYPE_UNSPECIFIED
+}
+
+func (x *OptionInfo) GetStrike() float64 {
+	if x != nil {
+		return x.Strike
+	}
+	return 0
+}
+
+func (x *OptionInfo) GetForward() float64 {
+	if x != nil {
+		return x.Forward
+	}
+	return 0
+}
+
+func (x *OptionInfo) GetAtmVolatility() float64 {
+	if x != nil {
+		return x.AtmVolatility
+	}
+	return 0
+}
+
+func (x *OptionInfo) GetImpliedVolatility() float64 {
+	if x != nil {
+		return x.ImpliedVolatility
+	}
+	return 0
+}
+
+func (x *OptionInfo) GetGreeks() *OptionGreeks {
+	if x != nil {
+		return x.Greeks
+	}
+	return nil
+}
+
+func (x *OptionInfo) GetUnderlyingPriceDecimal() *Decimal {
+	if x != nil {
+		return x.UnderlyingPriceDecimal
+	}
+	return nil
+}
+
+// BondInfo values a bond off the shared yield curve. Yields, duration and
+// curve factors are model outputs, not prices on a tick, so they stay
+// doubles in API_V2; the bond's price has PriceUpdate.price_decimal.
+type BondInfo struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	MaturityYears    float64                `protobuf:"fixed64,1,opt,name=maturity_years,json=maturityYears,proto3" json:"maturity_years,omitempty"`
+	CouponPercent    float64                `protobuf:"fixed64,2,opt,name=coupon_percent,json=couponPercent,proto3" json:"coupon_percent,omitempty"`
+	YieldPercent     float64                `protobuf:"fixed64,3,opt,name=yield_percent,json=yieldPercent,proto3" json:"yield_percent,omitempty"`            // Yield to maturity, continuously compounded
+	ZeroRatePercent  float64                `protobuf:"fixed64,4,opt,name=zero_rate_percent,json=zeroRatePercent,proto3" json:"zero_rate_percent,omitempty"` // Curve rate at maturity
+	ModifiedDuration float64                `protobuf:"fixed64,5,opt,name=modified_duration,json=modifiedDuration,proto3" json:"modified_duration,omitempty"`
+	Curve            *CurveFactors          `protobuf:"bytes,6,opt,name=curve,proto3" json:"curve,omitempty"` // The curve the bond was priced off
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *BondInfo) Reset() {
+	*x = BondInfo{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BondInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BondInfo) ProtoMessage() {}
+
+func (x *BondInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BondInfo.ProtoReflect.Descriptor instead.
+func (*BondInfo) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BondInfo) GetMaturityYears() float64 {
+	if x != nil {
+		return x.MaturityYears
+	}
+	return 0
+}
+
+func (x *BondInfo) GetCouponPercent() float64 {
+	if x != nil {
+		return x.CouponPercent
+	}
+	return 0
+}
+
+func (x *BondInfo) GetYieldPercent() float64 {
+	if x != nil {
+		return x.YieldPercent
+	}
+	return 0
+}
+
+func (x *BondInfo) GetZeroRatePercent() float64 {
+	if x != nil {
+		return x.ZeroRatePercent
+	}
+	return 0
+}
+
+func (x *BondInfo) GetModifiedDuration() float64 {
+	if x != nil {
+		return x.ModifiedDuration
+	}
+	return 0
+}
+
+func (x *BondInfo) GetCurve() *CurveFactors {
+	if x != nil {
+		return x.Curve
+	}
+	return nil
+}
+
+// CurveFactors are the Nelson-Siegel factors of a yield curve, in percent
+type CurveFactors struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Level         float64                `protobuf:"fixed64,1,opt,name=level,proto3" json:"level,omitempty"`         // Long end
+	Slope         float64                `protobuf:"fixed64,2,opt,name=slope,proto3" json:"slope,omitempty"`         // Short end minus the long end
+	Curvature     float64                `protobuf:"fixed64,3,opt,name=curvature,proto3" json:"curvature,omitempty"` // Hump around lambda_years
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CurveFactors) Reset() {
+	*x = CurveFactors{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurveFactors) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurveFactors) ProtoMessage() {}
+
+func (x *CurveFactors) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurveFactors.ProtoReflect.Descriptor instead.
+func (*CurveFactors) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CurveFactors) GetLevel() float64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *CurveFactors) GetSlope() float64 {
+	if x != nil {
+		return x.Slope
+	}
+	return 0
+}
+
+func (x *CurveFactors) GetCurvature() float64 {
+	if x != nil {
+		return x.Curvature
+	}
+	return 0
+}
+
+// CrossInfo shows how an FX cross was triangulated from the pairs of its
+// currencies against the pivot currency
+type CrossInfo struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	PivotCurrency            string                 `protobuf:"bytes,1,opt,name=pivot_currency,json=pivotCurrency,proto3" json:"pivot_currency,omitempty"`
+	BaseFactorSymbol         string                 `protobuf:"bytes,2,opt,name=base_factor_symbol,json=baseFactorSymbol,proto3" json:"base_factor_symbol,omitempty"` // Empty when the base currency is the pivot
+	BaseFactorPrice          float64                `protobuf:"fixed64,3,opt,name=base_factor_price,json=baseFactorPrice,proto3" json:"base_factor_price,omitempty"`
+	QuoteFactorSymbol        string                 `protobuf:"bytes,4,opt,name=quote_factor_symbol,json=quoteFactorSymbol,proto3" json:"quote_factor_symbol,omitempty"` // Empty when the quote currency is the pivot
+	QuoteFactorPrice         float64                `protobuf:"fixed64,5,opt,name=quote_factor_price,json=quoteFactorPrice,proto3" json:"quote_factor_price,omitempty"`
+	TriangulatedPrice        float64                `protobuf:"fixed64,6,opt,name=triangulated_price,json=triangulatedPrice,proto3" json:"triangulated_price,omitempty"`                      // Rate implied by the factors
+	DislocationBps           float64                `protobuf:"fixed64,7,opt,name=dislocation_bps,json=dislocationBps,proto3" json:"dislocation_bps,omitempty"`                               // Price over the triangulated rate, zero unless dislocations are configured
+	TriangulatedPriceDecimal *Decimal               `protobuf:"bytes,8,opt,name=triangulated_price_decimal,json=triangulatedPriceDecimal,proto3" json:"triangulated_price_decimal,omitempty"` // API_V2
+	BaseFactorPriceDecimal   *Decimal               `protobuf:"bytes,9,opt,name=base_factor_price_decimal,json=baseFactorPriceDecimal,proto3" json:"base_factor_price_decimal,omitempty"`     // At the factor's own precision
+	QuoteFactorPriceDecimal  *Decimal               `protobuf:"bytes,10,opt,name=quote_factor_price_decimal,json=quoteFactorPriceDecimal,proto3" json:"quote_factor_price_decimal,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *CrossInfo) Reset() {
+	*x = CrossInfo{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CrossInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrossInfo) ProtoMessage() {}
+
+func (x *CrossInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrossInfo.ProtoReflect.Descriptor instead.
+func (*CrossInfo) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CrossInfo) GetPivotCurrency() string {
+	if x != nil {
+		return x.PivotCurrency
+	}
+	return ""
+}
+
+func (x *CrossInfo) GetBaseFactorSymbol() string {
+	if x != nil {
+		return x.BaseFactorSymbol
+	}
+	return ""
+}
+
+func (x *CrossInfo) GetBaseFactorPrice() float64 {
+	if x != nil {
+		return x.BaseFactorPrice
+	}
+	return 0
+}
+
+func (x *CrossInfo) GetQuoteFactorSymbol() string {
+	if x != nil {
+		return x.QuoteFactorSymbol
+	}
+	return ""
+}
+
+func (x *CrossInfo) GetQuoteFactorPrice() float64 {
+	if x != nil {
+		return x.QuoteFactorPrice
+	}
+	return 0
+}
+
+func (x *CrossInfo) GetTriangulatedPrice() float64 {
+	if x != nil {
+		return x.TriangulatedPrice
+	}
+	return 0
+}
+
+func (x *CrossInfo) GetDislocationBps() float64 {
+	if x != nil {
+		return x.DislocationBps
+	}
+	return 0
+}
+
+func (x *CrossInfo) GetTriangulatedPriceDecimal() *Decimal {
+	if x != nil {
+		return x.TriangulatedPriceDecimal
+	}
+	return nil
+}
+
+func (x *CrossInfo) GetBaseFactorPriceDecimal() *Decimal {
+	if x != nil {
+		return x.BaseFactorPriceDecimal
+	}
+	return nil
+}
+
+func (x *CrossInfo) GetQuoteFactorPriceDecimal() *Decimal {
+	if x != nil {
+		return x.QuoteFactorPriceDecimal
+	}
+	return nil
+}
+
+// FutureInfo links a dated future to its underlying. A contract's last
+// update carries a SETTLEMENT event; it is not streamed afterwards.
+type FutureInfo struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	UnderlyingSymbol       string                 `protobuf:"bytes,1,opt,name=underlying_symbol,json=underlyingSymbol,proto3" json:"underlying_symbol,omitempty"`
+	UnderlyingPrice        float64                `protobuf:"fixed64,2,opt,name=underlying_price,json=underlyingPrice,proto3" json:"underlying_price,omitempty"`
+	Expiry                 *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	DaysToExpiry           float64                `protobuf:"fixed64,4,opt,name=days_to_expiry,json=daysToExpiry,proto3" json:"days_to_expiry,omitempty"`
+	BasisBps               float64                `protobuf:"fixed64,5,opt,name=basis_bps,json=basisBps,proto3" json:"basis_bps,omitempty"`                                             // Price over the underlying
+	AnnualizedBasisPercent float64                `protobuf:"fixed64,6,opt,name=annualized_basis_percent,json=annualizedBasisPercent,proto3" json:"annualized_basis_percent,omitempty"` // Positive in contango, negative in backwardation
+	Settled                bool                   `protobuf:"varint,7,opt,name=settled,proto3" json:"settled,omitempty"`
+	SettlementPrice        float64                `protobuf:"fixed64,8,opt,name=settlement_price,json=settlementPrice,proto3" json:"settlement_price,omitempty"`                      // The underlying's price at expiry, once settled
+	UnderlyingPriceDecimal *Decimal               `protobuf:"bytes,9,opt,name=underlying_price_decimal,json=underlyingPriceDecimal,proto3" json:"underlying_price_decimal,omitempty"` // API_V2, at the underlying's precision
+	SettlementPriceDecimal *Decimal               `protobuf:"bytes,10,opt,name=settlement_price_decimal,json=settlementPriceDecimal,proto3" json:"settlement_price_decimal,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *FutureInfo) Reset() {
+	*x = FutureInfo{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FutureInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FutureInfo) ProtoMessage() {}
+
+func (x *FutureInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FutureInfo.ProtoReflect.Descriptor instead.
+func (*FutureInfo) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *FutureInfo) GetUnderlyingSymbol() string {
+	if x != nil {
+		return x.UnderlyingSymbol
+	}
+	return ""
+}
+
+func (x *FutureInfo) GetUnderlyingPrice() float64 {
+	if x != nil {
+		return x.UnderlyingPrice
+	}
+	return 0
+}
+
+func (x *FutureInfo) GetExpiry() *timestamp.Timestamp {
+	if x != nil {
+		return x.Expiry
+	}
+	return nil
+}
+
+func (x *FutureInfo) GetDaysToExpiry() float64 {
+	if x != nil {
+		return x.DaysToExpiry
+	}
+	return 0
+}
+
+func (x *FutureInfo) GetBasisBps() float64 {
+	if x != nil {
+		return x.BasisBps
+	}
+	return 0
+}
+
+func (x *FutureInfo) GetAnnualizedBasisPercent() float64 {
+	if x != nil {
+		return x.AnnualizedBasisPercent
+	}
+	return 0
+}
+
+func (x *FutureInfo) GetSettled() bool {
+	if x != nil {
+		return x.Settled
+	}
+	return false
+}
+
+func (x *FutureInfo) GetSettlementPrice() float64 {
+	if x != nil {
+		return x.SettlementPrice
+	}
+	return 0
+}
+
+func (x *FutureInfo) GetUnderlyingPriceDecimal() *Decimal {
+	if x != nil {
+		return x.UnderlyingPriceDecimal
+	}
+	return nil
+}
+
+func (x *FutureInfo) GetSettlementPriceDecimal() *Decimal {
+	if x != nil {
+		return x.SettlementPriceDecimal
+	}
+	return nil
+}
+
+// PerpetualInfo carries a perpetual swap's index, mark price and funding.
+// Funding rates are per funding interval; positive rates mean longs pay shorts.
+type PerpetualInfo struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	IndexSymbol            string                 `protobuf:"bytes,1,opt,name=index_symbol,json=indexSymbol,proto3" json:"index_symbol,omitempty"`
+	IndexPrice             float64                `protobuf:"fixed64,2,opt,name=index_price,json=indexPrice,proto3" json:"index_price,omitempty"`
+	MarkPrice              float64                `protobuf:"fixed64,3,opt,name=mark_price,json=markPrice,proto3" json:"mark_price,omitempty"`       // Index plus the predicted funding still to accrue; use for margin and liquidation
+	BasisBps               float64                `protobuf:"fixed64,4,opt,name=basis_bps,json=basisBps,proto3" json:"basis_bps,omitempty"`          // Last price over the index
+	FundingRate            float64                `protobuf:"fixed64,5,opt,name=funding_rate,json=fundingRate,proto3" json:"funding_rate,omitempty"` // Predicted rate of the next funding, from the premium so far this interval
+	NextFundingTime        *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=next_funding_time,json=nextFundingTime,proto3" json:"next_funding_time,omitempty"`
+	FundingIntervalSeconds int64                  `protobuf:"varint,7,opt,name=funding_interval_seconds,json=fundingIntervalSeconds,proto3" json:"funding_interval_seconds,omitempty"`
+	LastFundingRate        float64                `protobuf:"fixed64,8,opt,name=last_funding_rate,json=lastFundingRate,proto3" json:"last_funding_rate,omitempty"`   // Rate settled at last_funding_time
+	LastFundingTime        *timestamp.Timestamp   `protobuf:"bytes,9,opt,name=last_funding_time,json=lastFundingTime,proto3" json:"last_funding_time,omitempty"`     // Unset before the stream's first funding
+	MarkPriceDecimal       *Decimal               `protobuf:"bytes,10,opt,name=mark_price_decimal,json=markPriceDecimal,proto3" json:"mark_price_decimal,omitempty"` // API_V2
+	IndexPriceDecimal      *Decimal               `protobuf:"bytes,11,opt,name=index_price_decimal,json=indexPriceDecimal,proto3" json:"index_price_decimal,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *PerpetualInfo) Reset() {
+	*x = PerpetualInfo{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PerpetualInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PerpetualInfo) ProtoMessage() {}
+
+func (x *PerpetualInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PerpetualInfo.ProtoReflect.Descriptor instead.
+func (*PerpetualInfo) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PerpetualInfo) GetIndexSymbol() string {
+	if x != nil {
+		return x.IndexSymbol
+	}
+	return ""
+}
+
+func (x *PerpetualInfo) GetIndexPrice() float64 {
+	if x != nil {
+		return x.IndexPrice
+	}
+	return 0
+}
+
+func (x *PerpetualInfo) GetMarkPrice() float64 {
+	if x != nil {
+		return x.MarkPrice
+	}
+	return 0
+}
+
+func (x *PerpetualInfo) GetBasisBps() float64 {
+	if x != nil {
+		return x.BasisBps
+	}
+	return 0
+}
+
+func (x *PerpetualInfo) GetFundingRate() float64 {
+	if x != nil {
+		return x.FundingRate
+	}
+	return 0
+}
+
+func (x *PerpetualInfo) GetNextFundingTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.NextFundingTime
+	}
+	return nil
+}
+
+func (x *PerpetualInfo) GetFundingIntervalSeconds() int64 {
+	if x != nil {
+		return x.FundingIntervalSeconds
+	}
+	return 0
+}
+
+func (x *PerpetualInfo) GetLastFundingRate() float64 {
+	if x != nil {
+		return x.LastFundingRate
+	}
+	return 0
+}
+
+func (x *PerpetualInfo) GetLastFundingTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastFundingTime
+	}
+	return nil
+}
+
+func (x *PerpetualInfo) GetMarkPriceDecimal() *Decimal {
+	if x != nil {
+		return x.MarkPriceDecimal
+	}
+	return nil
+}
+
+func (x *PerpetualInfo) GetIndexPriceDecimal() *Decimal {
+	if x != nil {
+		return x.IndexPriceDecimal
+	}
+	return nil
+}
+
+// CompositeInfo shows how a composite symbol's price was derived
+type CompositeInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"` // basket, spread, ratio or fx
+	Legs          []*CompositeLeg        `protobuf:"bytes,2,rep,name=legs,proto3" json:"legs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompositeInfo) Reset() {
+	*x = CompositeInfo{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompositeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompositeInfo) ProtoMessage() {}
+
+func (x *CompositeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompositeInfo.ProtoReflect.Descriptor instead.
+func (*CompositeInfo) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CompositeInfo) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CompositeInfo) GetLegs() []*CompositeLeg {
+	if x != nil {
+		return x.Legs
+	}
+	return nil
+}
+
+type CompositeLeg struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Weight        float64                `protobuf:"fixed64,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`                                 // Leg price the composite was computed from
+	PriceDecimal  *Decimal               `protobuf:"bytes,4,opt,name=price_decimal,json=priceDecimal,proto3" json:"price_decimal,omitempty"` // API_V2, at the leg's precision
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompositeLeg) Reset() {
+	*x = CompositeLeg{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompositeLeg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompositeLeg) ProtoMessage() {}
+
+func (x *CompositeLeg) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompositeLeg.ProtoReflect.Descriptor instead.
+func (*CompositeLeg) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CompositeLeg) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *CompositeLeg) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *CompositeLeg) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CompositeLeg) GetPriceDecimal() *Decimal {
+	if x != nil {
+		return x.PriceDecimal
+	}
+	return nil
+}
+
+// ReferencePrice is a price derived from venue quotes, with how it was computed
+type ReferencePrice struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          ReferencePriceType     `protobuf:"varint,1,opt,name=type,proto3,enum=marketdata.ReferencePriceType" json:"type,omitempty"`
+	Price         float64                `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	Methodology   string                 `protobuf:"bytes,3,opt,name=methodology,proto3" json:"methodology,omitempty"`
+	Constituents  []string               `protobuf:"bytes,4,rep,name=constituents,proto3" json:"constituents,omitempty"`                     // Venues the price was computed from
+	Excluded      []string               `protobuf:"bytes,5,rep,name=excluded,proto3" json:"excluded,omitempty"`                             // Venues rejected as outliers
+	PriceDecimal  *Decimal               `protobuf:"bytes,6,opt,name=price_decimal,json=priceDecimal,proto3" json:"price_decimal,omitempty"` // API_V2
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReferencePrice) Reset() {
+	*x = ReferencePrice{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReferencePrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReferencePrice) ProtoMessage() {}
+
+func (x *ReferencePrice) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReferencePrice.ProtoReflect.Descriptor instead.
+func (*ReferencePrice) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ReferencePrice) GetType() ReferencePriceType {
+	if x != nil {
+		return x.Type
+	}
+	return ReferencePriceType_REFERENCE_PRICE_UNSPECIFIED
+}
+
+func (x *ReferencePrice) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ReferencePrice) GetMethodology() string {
+	if x != nil {
+		return x.Methodology
+	}
+	return ""
+}
+
+func (x *ReferencePrice) GetConstituents() []string {
+	if x != nil {
+		return x.Constituents
+	}
+	return nil
+}
+
+func (x *ReferencePrice) GetExcluded() []string {
+	if x != nil {
+		return x.Excluded
+	}
+	return nil
+}
+
+func (x *ReferencePrice) GetPriceDecimal() *Decimal {
+	if x != nil {
+		return x.PriceDecimal
+	}
+	return nil
+}
+
+type GetReferencePricesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	ApiVersion    ApiVersion             `protobuf:"varint,2,opt,name=api_version,json=apiVersion,proto3,enum=marketdata.ApiVersion" json:"api_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReferencePricesRequest) Reset() {
+	*x = GetReferencePricesRequest{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReferencePricesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReferencePricesRequest) ProtoMessage() {}
+
+func (x *GetReferencePricesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReferencePricesRequest.ProtoReflect.Descriptor instead.
+func (*GetReferencePricesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetReferencePricesRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *GetReferencePricesRequest) GetApiVersion() ApiVersion {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ApiVersion_API_VERSION_UNSPECIFIED
+}
+
+type ReferencePricesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Prices        []*ReferencePrice      `protobuf:"bytes,2,rep,name=prices,proto3" json:"prices,omitempty"`
+	Nbbo          *Quote                 `protobuf:"bytes,3,opt,name=nbbo,proto3" json:"nbbo,omitempty"`
+	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReferencePricesResponse) Reset() {
+	*x = ReferencePricesResponse{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReferencePricesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReferencePricesResponse) ProtoMessage() {}
+
+func (x *ReferencePricesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReferencePricesResponse.ProtoReflect.Descriptor instead.
+func (*ReferencePricesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReferencePricesResponse) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *ReferencePricesResponse) GetPrices() []*ReferencePrice {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+func (x *ReferencePricesResponse) GetNbbo() *Quote {
+	if x != nil {
+		return x.Nbbo
+	}
+	return nil
+}
+
+func (x *ReferencePricesResponse) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type AuctionInfo struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	IndicativePrice         float64                `protobuf:"fixed64,1,opt,name=indicative_price,json=indicativePrice,proto3" json:"indicative_price,omitempty"`
+	IndicativeVolume        float64                `protobuf:"fixed64,2,opt,name=indicative_volume,json=indicativeVolume,proto3" json:"indicative_volume,omitempty"` // Quantity that would match at the indicative price
+	Imbalance               float64                `protobuf:"fixed64,3,opt,name=imbalance,proto3" json:"imbalance,omitempty"`                                       // Unmatched quantity at the indicative price, positive for a buy surplus
+	UncrossAt               *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=uncross_at,json=uncrossAt,proto3" json:"uncross_at,omitempty"`
+	IndicativePriceDecimal  *Decimal               `protobuf:"bytes,5,opt,name=indicative_price_decimal,json=indicativePriceDecimal,proto3" json:"indicative_price_decimal,omitempty"` // API_V2
+	IndicativeVolumeDecimal *Decimal               `protobuf:"bytes,6,opt,name=indicative_volume_decimal,json=indicativeVolumeDecimal,proto3" json:"indicative_volume_decimal,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *AuctionInfo) Reset() {
+	*x = AuctionInfo{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuctionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuctionInfo) ProtoMessage() {}
+
+func (x *AuctionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuctionInfo.ProtoReflect.Descriptor instead.
+func (*AuctionInfo) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AuctionInfo) GetIndicativePrice() float64 {
+	if x != nil {
+		return x.IndicativePrice
+	}
+	return 0
+}
+
+func (x *AuctionInfo) GetIndicativeVolume() float64 {
+	if x != nil {
+		return x.IndicativeVolume
+	}
+	return 0
+}
+
+func (x *AuctionInfo) GetImbalance() float64 {
+	if x != nil {
+		return x.Imbalance
+	}
+	return 0
+}
+
+func (x *AuctionInfo) GetUncrossAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UncrossAt
+	}
+	return nil
+}
+
+func (x *AuctionInfo) GetIndicativePriceDecimal() *Decimal {
+	if x != nil {
+		return x.IndicativePriceDecimal
+	}
+	return nil
+}
+
+func (x *AuctionInfo) GetIndicativeVolumeDecimal() *Decimal {
+	if x != nil {
+		return x.IndicativeVolumeDecimal
+	}
+	return nil
+}
+
+// MarketEvent reports a circuit breaker acting on a symbol, a change of
+// trading phase, a perpetual's funding, a future's expiry or a rate shock
+type MarketEvent struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Type                  MarketEventType        `protobuf:"varint,1,opt,name=type,proto3,enum=marketdata.MarketEventType" json:"type,omitempty"`
+	Reason                string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	ReferencePrice        float64                `protobuf:"fixed64,3,opt,name=reference_price,json=referencePrice,proto3" json:"reference_price,omitempty"` // Start of the band window
+	BandLow               float64                `protobuf:"fixed64,4,opt,name=band_low,json=bandLow,proto3" json:"band_low,omitempty"`
+	BandHigh              float64                `protobuf:"fixed64,5,opt,name=band_high,json=bandHigh,proto3" json:"band_high,omitempty"`
+	ResumeAt              *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=resume_at,json=resumeAt,proto3" json:"resume_at,omitempty"`                                          // HALT only
+	Phase                 TradingPhase           `protobuf:"varint,7,opt,name=phase,proto3,enum=marketdata.TradingPhase" json:"phase,omitempty"`                                  // PHASE_CHANGE only: the phase being entered
+	ReferencePriceDecimal *Decimal               `protobuf:"bytes,8,opt,name=reference_price_decimal,json=referencePriceDecimal,proto3" json:"reference_price_decimal,omitempty"` // API_V2
+	BandLowDecimal        *Decimal               `protobuf:"bytes,9,opt,name=band_low_decimal,json=bandLowDecimal,proto3" json:"band_low_decimal,omitempty"`
+	BandHighDecimal       *Decimal               `protobuf:"bytes,10,opt,name=band_high_decimal,json=bandHighDecimal,proto3" json:"band_high_decimal,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *MarketEvent) Reset() {
+	*x = MarketEvent{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarketEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketEvent) ProtoMessage() {}
+
+func (x *MarketEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketEvent.ProtoReflect.Descriptor instead.
+func (*MarketEvent) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *MarketEvent) GetType() MarketEventType {
+	if x != nil {
+		return x.Type
+	}
+	return MarketEventType_MARKET_EVENT_UNSPECIFIED
+}
+
+func (x *MarketEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *MarketEvent) GetReferencePrice() float64 {
+	if x != nil {
+		return x.ReferencePrice
+	}
+	return 0
+}
+
+func (x *MarketEvent) GetBandLow() float64 {
+	if x != nil {
+		return x.BandLow
+	}
+	return 0
+}
+
+func (x *MarketEvent) GetBandHigh() float64 {
+	if x != nil {
+		return x.BandHigh
+	}
+	return 0
+}
+
+func (x *MarketEvent) GetResumeAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ResumeAt
+	}
+	return nil
+}
+
+func (x *MarketEvent) GetPhase() TradingPhase {
+	if x != nil {
+		return x.Phase
+	}
+	return TradingPhase_CONTINUOUS
+}
+
+func (x *MarketEvent) GetReferencePriceDecimal() *Decimal {
+	if x != nil {
+		return x.ReferencePriceDecimal
+	}
+	return nil
+}
+
+func (x *MarketEvent) GetBandLowDecimal() *Decimal {
+	if x != nil {
+		return x.BandLowDecimal
+	}
+	return nil
+}
+
+func (x *MarketEvent) GetBandHighDecimal() *Decimal {
+	if x != nil {
+		return x.BandHighDecimal
+	}
+	return nil
+}
+
+type Quote struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Bid            float64                `protobuf:"fixed64,1,opt,name=bid,proto3" json:"bid,omitempty"`
+	Ask            float64                `protobuf:"fixed64,2,opt,name=ask,proto3" json:"ask,omitempty"`
+	BidSize        float64                `protobuf:"fixed64,3,opt,name=bid_size,json=bidSize,proto3" json:"bid_size,omitempty"`
+	AskSize        float64                `protobuf:"fixed64,4,opt,name=ask_size,json=askSize,proto3" json:"ask_size,omitempty"`
+	SpreadBps      float64                `protobuf:"fixed64,5,opt,name=spread_bps,json=spreadBps,proto3" json:"spread_bps,omitempty"`
+	BidVenue       string                 `protobuf:"bytes,6,opt,name=bid_venue,json=bidVenue,proto3" json:"bid_venue,omitempty"` // Consolidated quotes: venue showing the best bid
+	AskVenue       string                 `protobuf:"bytes,7,opt,name=ask_venue,json=askVenue,proto3" json:"ask_venue,omitempty"`
+	BidDecimal     *Decimal               `protobuf:"bytes,8,opt,name=bid_decimal,json=bidDecimal,proto3" json:"bid_decimal,omitempty"` // API_V2
+	AskDecimal     *Decimal               `protobuf:"bytes,9,opt,name=ask_decimal,json=askDecimal,proto3" json:"ask_decimal,omitempty"`
+	BidSizeDecimal *Decimal               `protobuf:"bytes,10,opt,name=bid_size_decimal,json=bidSizeDecimal,proto3" json:"bid_size_decimal,omitempty"`
+	AskSizeDecimal *Decimal               `protobuf:"bytes,11,opt,name=ask_size_decimal,json=askSizeDecimal,proto3" json:"ask_size_decimal,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Quote) Reset() {
+	*x = Quote{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Quote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Quote) ProtoMessage() {}
+
+func (x *Quote) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Quote.ProtoReflect.Descriptor instead.
+func (*Quote) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Quote) GetBid() float64 {
+	if x != nil {
+		return x.Bid
+	}
+	return 0
+}
+
+func (x *Quote) GetAsk() float64 {
+	if x != nil {
+		return x.Ask
+	}
+	return 0
+}
+
+func (x *Quote) GetBidSize() float64 {
+	if x != nil {
+		return x.BidSize
+	}
+	return 0
+}
+
+func (x *Quote) GetAskSize() float64 {
+	if x != nil {
+		return x.AskSize
+	}
+	return 0
+}
+
+func (x *Quote) GetSpreadBps() float64 {
+	if x != nil {
+		return x.SpreadBps
+	}
+	return 0
+}
+
+func (x *Quote) GetBidVenue() string {
+	if x != nil {
+		return x.BidVenue
+	}
+	return ""
+}
+
+func (x *Quote) GetAskVenue() string {
+	if x != nil {
+		return x.AskVenue
+	}
+	return ""
+}
+
+func (x *Quote) GetBidDecimal() *Decimal {
+	if x != nil {
+		return x.BidDecimal
+	}
+	return nil
+}
+
+func (x *Quote) GetAskDecimal() *Decimal {
+	if x != nil {
+		return x.AskDecimal
+	}
+	return nil
+}
+
+func (x *Quote) GetBidSizeDecimal() *Decimal {
+	if x != nil {
+		return x.BidSizeDecimal
+	}
+	return nil
+}
+
+func (x *Quote) GetAskSizeDecimal() *Decimal {
+	if x != nil {
+		return x.AskSizeDecimal
+	}
+	return nil
+}
+
+type OrderBook struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bids          []*OrderBookLevel      `protobuf:"bytes,1,rep,name=bids,proto3" json:"bids,omitempty"` // Best first
+	Asks          []*OrderBookLevel      `protobuf:"bytes,2,rep,name=asks,proto3" json:"asks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderBook) Reset() {
+	*x = OrderBook{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderBook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderBook) ProtoMessage() {}
+
+func (x *OrderBook) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderBook.ProtoReflect.Descriptor instead.
+func (*OrderBook) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *OrderBook) GetBids() []*OrderBookLevel {
+	if x != nil {
+		return x.Bids
+	}
+	return nil
+}
+
+func (x *OrderBook) GetAsks() []*OrderBookLevel {
+	if x != nil {
+		return x.Asks
+	}
+	return nil
+}
+
+type OrderBookLevel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Price         float64                `protobuf:"fixed64,1,opt,name=price,proto3" json:"price,omitempty"`
+	Size          float64                `protobuf:"fixed64,2,opt,name=size,proto3" json:"size,omitempty"`
+	PriceDecimal  *Decimal               `protobuf:"bytes,3,opt,name=price_decimal,json=priceDecimal,proto3" json:"price_decimal,omitempty"` // API_V2
+	SizeDecimal   *Decimal               `protobuf:"bytes,4,opt,name=size_decimal,json=sizeDecimal,proto3" json:"size_decimal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderBookLevel) Reset() {
+	*x = OrderBookLevel{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderBookLevel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderBookLevel) ProtoMessage() {}
+
+func (x *OrderBookLevel) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderBookLevel.ProtoReflect.Descriptor instead.
+func (*OrderBookLevel) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *OrderBookLevel) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *OrderBookLevel) GetSize() float64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *OrderBookLevel) GetPriceDecimal() *Decimal {
+	if x != nil {
+		return x.PriceDecimal
+	}
+	return nil
+}
+
+func (x *OrderBookLevel) GetSizeDecimal() *Decimal {
+	if x != nil {
+		return x.SizeDecimal
+	}
+	return nil
+}
+
+// PriceUpdateBatch carries several updates in one frame. Regular streams send
+// one batch per tick; high-frequency streams send every tick generated in one
+// wake-up, at most 1000 updates per batch.
+type PriceUpdateBatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Updates       []*PriceUpdate         `protobuf:"bytes,2,rep,name=updates,proto3" json:"updates,omitempty"`
+	FirstSequence uint64                 `protobuf:"varint,3,opt,name=first_sequence,json=firstSequence,proto3" json:"first_sequence,omitempty"`
+	LastSequence  uint64                 `protobuf:"varint,4,opt,name=last_sequence,json=lastSequence,proto3" json:"last_sequence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceUpdateBatch) Reset() {
+	*x = PriceUpdateBatch{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceUpdateBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceUpdateBatch) ProtoMessage() {}
+
+func (x *PriceUpdateBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceUpdateBatch.ProtoReflect.Descriptor instead.
+func (*PriceUpdateBatch) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PriceUpdateBatch) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *PriceUpdateBatch) GetUpdates() []*PriceUpdate {
+	if x != nil {
+		return x.Updates
+	}
+	return nil
+}
+
+func (x *PriceUpdateBatch) GetFirstSequence() uint64 {
+	if x != nil {
+		return x.FirstSequence
+	}
+	return 0
+}
+
+func (x *PriceUpdateBatch) GetLastSequence() uint64 {
+	if x != nil {
+		return x.LastSequence
+	}
+	return 0
+}
+
+type SubscriptionRequest struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Action                 SubscriptionAction     `protobuf:"varint,1,opt,name=action,proto3,enum=marketdata.SubscriptionAction" json:"action,omitempty"`
+	Symbols                []string               `protobuf:"bytes,2,rep,name=symbols,proto3" json:"symbols,omitempty"`                                                                     // Same forms as StreamPricesRequest.symbols
+	UpdateIntervalMs       int32                  `protobuf:"varint,3,opt,name=update_interval_ms,json=updateIntervalMs,proto3" json:"update_interval_ms,omitempty"`                        // Used by SET_INTERVAL
+	DeliveryPolicy         DeliveryPolicy         `protobuf:"varint,4,opt,name=delivery_policy,json=deliveryPolicy,proto3,enum=marketdata.DeliveryPolicy" json:"delivery_policy,omitempty"` // Used by SET_DELIVERY_POLICY
+	MaxRateMs              int32                  `protobuf:"varint,5,opt,name=max_rate_ms,json=maxRateMs,proto3" json:"max_rate_ms,omitempty"`
+	ChangeThresholdPercent float64                `protobuf:"fixed64,6,opt,name=change_threshold_percent,json=changeThresholdPercent,proto3" json:"change_threshold_percent,omitempty"`
+	UpdateIntervalUs       int64                  `protobuf:"varint,7,opt,name=update_interval_us,json=updateIntervalUs,proto3" json:"update_interval_us,omitempty"`        // Used by SET_INTERVAL in high-frequency mode
+	ApiVersion             ApiVersion             `protobuf:"varint,8,opt,name=api_version,json=apiVersion,proto3,enum=marketdata.ApiVersion" json:"api_version,omitempty"` // Applies from this request on; unset keeps the current version
+	QuoteCurrency          string                 `protobuf:"bytes,9,opt,name=quote_currency,json=quoteCurrency,proto3" json:"quote_currency,omitempty"`                    // As StreamPricesRequest.quote_currency, from this request on; unset keeps the current currency
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *SubscriptionRequest) Reset() {
+	*x = SubscriptionRequest{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionRequest) ProtoMessage() {}
+
+func (x *SubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*SubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SubscriptionRequest) GetAction() SubscriptionAction {
+	if x != nil {
+		return x.Action
+	}
+	return SubscriptionAction_ADD_SYMBOLS
+}
+
+func (x *SubscriptionRequest) GetSymbols() []string {
+	if x != nil {
+		return x.Symbols
+	}
+	return nil
+}
+
+func (x *SubscriptionRequest) GetUpdateIntervalMs() int32 {
+	if x != nil {
+		return x.UpdateIntervalMs
+	}
+	return 0
+}
+
+func (x *SubscriptionRequest) GetDeliveryPolicy() DeliveryPolicy {
+	if x != nil {
+		return x.DeliveryPolicy
+	}
+	return DeliveryPolicy_EVERY_TICK
+}
+
+func (x *SubscriptionRequest) GetMaxRateMs() int32 {
+	if x != nil {
+		return x.MaxRateMs
+	}
+	return 0
+}
+
+func (x *SubscriptionRequest) GetChangeThresholdPercent() float64 {
+	if x != nil {
+		return x.ChangeThresholdPercent
+	}
+	return 0
+}
+
+func (x *SubscriptionRequest) GetUpdateIntervalUs() int64 {
+	if x != nil {
+		return x.UpdateIntervalUs
+	}
+	return 0
+}
+
+func (x *SubscriptionRequest) GetApiVersion() ApiVersion {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ApiVersion_API_VERSION_UNSPECIFIED
+}
+
+func (x *SubscriptionRequest) GetQuoteCurrency() string {
+	if x != nil {
+		return x.QuoteCurrency
+	}
+	return ""
+}
+
+type RecoverPriceUpdatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	FromSequence  uint64                 `protobuf:"varint,2,opt,name=from_sequence,json=fromSequence,proto3" json:"from_sequence,omitempty"` // Inclusive
+	ToSequence    uint64                 `protobuf:"varint,3,opt,name=to_sequence,json=toSequence,proto3" json:"to_sequence,omitempty"`       // Inclusive, 0 means latest
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecoverPriceUpdatesRequest) Reset() {
+	*x = RecoverPriceUpdatesRequest{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecoverPriceUpdatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoverPriceUpdatesRequest) ProtoMessage() {}
+
+func (x *RecoverPriceUpdatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoverPriceUpdatesRequest.ProtoReflect.Descriptor instead.
+func (*RecoverPriceUpdatesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RecoverPriceUpdatesRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *RecoverPriceUpdatesRequest) GetFromSequence() uint64 {
+	if x != nil {
+		return x.FromSequence
+	}
+	return 0
+}
+
+func (x *RecoverPriceUpdatesRequest) GetToSequence() uint64 {
+	if x != nil {
+		return x.ToSequence
+	}
+	return 0
+}
+
+type RecoverPriceUpdatesResponse struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	SessionId              string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Updates                []*PriceUpdate         `protobuf:"bytes,2,rep,name=updates,proto3" json:"updates,omitempty"`
+	FirstAvailableSequence uint64                 `protobuf:"varint,3,opt,name=first_available_sequence,json=firstAvailableSequence,proto3" json:"first_available_sequence,omitempty"`
+	LastSequence           uint64                 `protobuf:"varint,4,opt,name=last_sequence,json=lastSequence,proto3" json:"last_sequence,omitempty"`
+	Complete               bool                   `protobuf:"varint,5,opt,name=complete,proto3" json:"complete,omitempty"` // False when part of the range was evicted from the buffer
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *RecoverPriceUpdatesResponse) Reset() {
+	*x = RecoverPriceUpdatesResponse{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecoverPriceUpdatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoverPriceUpdatesResponse) ProtoMessage() {}
+
+func (x *RecoverPriceUpdatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoverPriceUpdatesResponse.ProtoReflect.Descriptor instead.
+func (*RecoverPriceUpdatesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RecoverPriceUpdatesResponse) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *RecoverPriceUpdatesResponse) GetUpdates() []*PriceUpdate {
+	if x != nil {
+		return x.Updates
+	}
+	return nil
+}
+
+func (x *RecoverPriceUpdatesResponse) GetFirstAvailableSequence() uint64 {
+	if x != nil {
+		return x.FirstAvailableSequence
+	}
+	return 0
+}
+
+func (x *RecoverPriceUpdatesResponse) GetLastSequence() uint64 {
+	if x != nil {
+		return x.LastSequence
+	}
+	return 0
+}
+
+func (x *RecoverPriceUpdatesResponse) GetComplete() bool {
+	if x != nil {
+		return x.Complete
+	}
+	return false
+}
+
+type PriceChangeInfo struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ChangeAmount        float64                `protobuf:"fixed64,1,opt,name=change_amount,json=changeAmount,proto3" json:"change_amount,omitempty"`
+	ChangePercentage    float64                `protobuf:"fixed64,2,opt,name=change_percentage,json=changePercentage,proto3" json:"change_percentage,omitempty"`
+	DailyHigh           float64                `protobuf:"fixed64,3,opt,name=daily_high,json=dailyHigh,proto3" json:"daily_high,omitempty"`
+	DailyLow            float64                `protobuf:"fixed64,4,opt,name=daily_low,json=dailyLow,proto3" json:"daily_low,omitempty"`
+	DailyVolume         float64                `protobuf:"fixed64,5,opt,name=daily_volume,json=dailyVolume,proto3" json:"daily_volume,omitempty"`
+	ChangeAmountDecimal *Decimal               `protobuf:"bytes,6,opt,name=change_amount_decimal,json=changeAmountDecimal,proto3" json:"change_amount_decimal,omitempty"` // API_V2; change_percentage is a ratio and stays a double
+	DailyHighDecimal    *Decimal               `protobuf:"bytes,7,opt,name=daily_high_decimal,json=dailyHighDecimal,proto3" json:"daily_high_decimal,omitempty"`
+	DailyLowDecimal     *Decimal               `protobuf:"bytes,8,opt,name=daily_low_decimal,json=dailyLowDecimal,proto3" json:"daily_low_decimal,omitempty"`
+	DailyVolumeDecimal  *Decimal               `protobuf:"bytes,9,opt,name=daily_volume_decimal,json=dailyVolumeDecimal,proto3" json:"daily_volume_decimal,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *PriceChangeInfo) Reset() {
+	*x = PriceChangeInfo{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceChangeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceChangeInfo) ProtoMessage() {}
+
+func (x *PriceChangeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceChangeInfo.ProtoReflect.Descriptor instead.
+func (*PriceChangeInfo) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PriceChangeInfo) GetChangeAmount() float64 {
+	if x != nil {
+		return x.ChangeAmount
+	}
+	return 0
+}
+
+func (x *PriceChangeInfo) GetChangePercentage() float64 {
+	if x != nil {
+		return x.ChangePercentage
+	}
+	return 0
+}
+
+func (x *PriceChangeInfo) GetDailyHigh() float64 {
+	if x != nil {
+		return x.DailyHigh
+	}
+	return 0
+}
+
+func (x *PriceChangeInfo) GetDailyLow() float64 {
+	if x != nil {
+		return x.DailyLow
+	}
+	return 0
+}
+
+func (x *PriceChangeInfo) GetDailyVolume() float64 {
+	if x != nil {
+		return x.DailyVolume
+	}
+	return 0
+}
+
+func (x *PriceChangeInfo) GetChangeAmountDecimal() *Decimal {
+	if x != nil {
+		return x.ChangeAmountDecimal
+	}
+	return nil
+}
+
+func (x *PriceChangeInfo) GetDailyHighDecimal() *Decimal {
+	if x != nil {
+		return x.DailyHighDecimal
+	}
+	return nil
+}
+
+func (x *PriceChangeInfo) GetDailyLowDecimal() *Decimal {
+	if x != nil {
+		return x.DailyLowDecimal
+	}
+	return nil
+}
+
+func (x *PriceChangeInfo) GetDailyVolumeDecimal() *Decimal {
+	if x != nil {
+		return x.DailyVolumeDecimal
+	}
+	return nil
+}
+
+type SimulationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Symbol         string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	StartTime      *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime        *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	SimulationType SimulationType         `protobuf:"varint,4,opt,name=simulation_type,json=simulationType,proto3,enum=marketdata.SimulationType" json:"simulation_type,omitempty"`
+	Parameters     *SimulationParameters  `protobuf:"bytes,5,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	ApiVersion     ApiVersion             `protobuf:"varint,6,opt,name=api_version,json=apiVersion,proto3,enum=marketdata.ApiVersion" json:"api_version,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SimulationRequest) Reset() {
+	*x = SimulationRequest{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulationRequest) ProtoMessage() {}
+
+func (x *SimulationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulationRequest.ProtoReflect.Descriptor instead.
+func (*SimulationRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SimulationRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *SimulationRequest) GetStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *SimulationRequest) GetEndTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *SimulationRequest) GetSimulationType() SimulationType {
+	if x != nil {
+		return x.SimulationType
+	}
+	return SimulationType_STATISTICAL_SIMILARITY
+}
+
+func (x *SimulationRequest) GetParameters() *SimulationParameters {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *SimulationRequest) GetApiVersion() ApiVersion {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ApiVersion_API_VERSION_UNSPECIFIED
+}
+
+type SimulationResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Symbol            string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	HistoricalData    []*PricePoint          `protobuf:"bytes,2,rep,name=historical_data,json=historicalData,proto3" json:"historical_data,omitempty"`
+	SimulatedData     []*PricePoint          `protobuf:"bytes,3,rep,name=simulated_data,json=simulatedData,proto3" json:"simulated_data,omitempty"`
+	SimilarityMetrics *StatisticalMetrics    `protobuf:"bytes,4,opt,name=similarity_metrics,json=similarityMetrics,proto3" json:"similarity_metrics,omitempty"`
+	SimulationId      string                 `protobuf:"bytes,5,opt,name=simulation_id,json=simulationId,proto3" json:"simulation_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SimulationResponse) Reset() {
+	*x = SimulationResponse{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulationResponse) ProtoMessage() {}
+
+func (x *SimulationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulationResponse.ProtoReflect.Descriptor instead.
+func (*SimulationResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SimulationResponse) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *SimulationResponse) GetHistoricalData() []*PricePoint {
+	if x != nil {
+		return x.HistoricalData
+	}
+	return nil
+}
+
+func (x *SimulationResponse) GetSimulatedData() []*PricePoint {
+	if x != nil {
+		return x.SimulatedData
+	}
+	return nil
+}
+
+func (x *SimulationResponse) GetSimilarityMetrics() *StatisticalMetrics {
+	if x != nil {
+		return x.SimilarityMetrics
+	}
+	return nil
+}
+
+func (x *SimulationResponse) GetSimulationId() string {
+	if x != nil {
+		return x.SimulationId
+	}
+	return ""
+}
+
+type ScenarioRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Symbol          string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	ScenarioType    ScenarioType           `protobuf:"varint,2,opt,name=scenario_type,json=scenarioType,proto3,enum=marketdata.ScenarioType" json:"scenario_type,omitempty"`
+	Parameters      *ScenarioParameters    `protobuf:"bytes,3,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	StartTime       *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	DurationMinutes int32                  `protobuf:"varint,5,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ScenarioRequest) Reset() {
+	*x = ScenarioRequest{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScenarioRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScenarioRequest) ProtoMessage() {}
+
+func (x *ScenarioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScenarioRequest.ProtoReflect.Descriptor instead.
+func (*ScenarioRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ScenarioRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *ScenarioRequest) GetScenarioType() ScenarioType {
+	if x != nil {
+		return x.ScenarioType
+	}
+	return ScenarioType_RALLY
+}
+
+func (x *ScenarioRequest) GetParameters() *ScenarioParameters {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *ScenarioRequest) GetStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ScenarioRequest) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+type PricePoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Open          float64                `protobuf:"fixed64,2,opt,name=open,proto3" json:"open,omitempty"`
+	High          float64                `protobuf:"fixed64,3,opt,name=high,proto3" json:"high,omitempty"`
+	Low           float64                `protobuf:"fixed64,4,opt,name=low,proto3" json:"low,omitempty"`
+	Close         float64                `protobuf:"fixed64,5,opt,name=close,proto3" json:"close,omitempty"`
+	Volume        float64                `protobuf:"fixed64,6,opt,name=volume,proto3" json:"volume,omitempty"`
+	OpenDecimal   *Decimal               `protobuf:"bytes,7,opt,name=open_decimal,json=openDecimal,proto3" json:"open_decimal,omitempty"` // API_V2
+	HighDecimal   *Decimal               `protobuf:"bytes,8,opt,name=high_decimal,json=highDecimal,proto3" json:"high_decimal,omitempty"`
+	LowDecimal    *Decimal               `protobuf:"bytes,9,opt,name=low_decimal,json=lowDecimal,proto3" json:"low_decimal,omitempty"`
+	CloseDecimal  *Decimal               `protobuf:"bytes,10,opt,name=close_decimal,json=closeDecimal,proto3" json:"close_decimal,omitempty"`
+	VolumeDecimal *Decimal               `protobuf:"bytes,11,opt,name=volume_decimal,json=volumeDecimal,proto3" json:"volume_decimal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PricePoint) Reset() {
+	*x = PricePoint{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PricePoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PricePoint) ProtoMessage() {}
+
+func (x *PricePoint) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PricePoint.ProtoReflect.Descriptor instead.
+func (*PricePoint) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *PricePoint) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *PricePoint) GetOpen() float64 {
+	if x != nil {
+		return x.Open
+	}
+	return 0
+}
+
+func (x *PricePoint) GetHigh() float64 {
+	if x != nil {
+		return x.High
+	}
+	return 0
+}
+
+func (x *PricePoint) GetLow() float64 {
+	if x != nil {
+		return x.Low
+	}
+	return 0
+}
+
+func (x *PricePoint) GetClose() float64 {
+	if x != nil {
+		return x.Close
+	}
+	return 0
+}
+
+func (x *PricePoint) GetVolume() float64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *PricePoint) GetOpenDecimal() *Decimal {
+	if x != nil {
+		return x.OpenDecimal
+	}
+	return nil
+}
+
+func (x *PricePoint) GetHighDecimal() *Decimal {
+	if x != nil {
+		return x.HighDecimal
+	}
+	return nil
+}
+
+func (x *PricePoint) GetLowDecimal() *Decimal {
+	if x != nil {
+		return x.LowDecimal
+	}
+	return nil
+}
+
+func (x *PricePoint) GetCloseDecimal() *Decimal {
+	if x != nil {
+		return x.CloseDecimal
+	}
+	return nil
+}
+
+func (x *PricePoint) GetVolumeDecimal() *Decimal {
+	if x != nil {
+		return x.VolumeDecimal
+	}
+	return nil
+}
+
+type StatisticalMetrics struct {
+	state                        protoimpl.MessageState `protogen:"open.v1"`
+	CorrelationCoefficient       float64                `protobuf:"fixed64,1,opt,name=correlation_coefficient,json=correlationCoefficient,proto3" json:"correlation_coefficient,omitempty"`
+	VolatilitySimilarity         float64                `protobuf:"fixed64,2,opt,name=volatility_similarity,json=volatilitySimilarity,proto3" json:"volatility_similarity,omitempty"`
+	ReturnDistributionSimilarity float64                `protobuf:"fixed64,3,opt,name=return_distribution_similarity,json=returnDistributionSimilarity,proto3" json:"return_distribution_similarity,omitempty"`
+	TrendSimilarity              float64                `protobuf:"fixed64,4,opt,name=trend_similarity,json=trendSimilarity,proto3" json:"trend_similarity,omitempty"`
+	ConfidenceScore              float64                `protobuf:"fixed64,5,opt,name=confidence_score,json=confidenceScore,proto3" json:"confidence_score,omitempty"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *StatisticalMetrics) Reset() {
+	*x = StatisticalMetrics{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatisticalMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatisticalMetrics) ProtoMessage() {}
+
+func (x *StatisticalMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatisticalMetrics.ProtoReflect.Descriptor instead.
+func (*StatisticalMetrics) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *StatisticalMetrics) GetCorrelationCoefficient() float64 {
+	if x != nil {
+		return x.CorrelationCoefficient
+	}
+	return 0
+}
+
+func (x *StatisticalMetrics) GetVolatilitySimilarity() float64 {
+	if x != nil {
+		return x.VolatilitySimilarity
+	}
+	return 0
+}
+
+func (x *StatisticalMetrics) GetReturnDistributionSimilarity() float64 {
+	if x != nil {
+		return x.ReturnDistributionSimilarity
+	}
+	return 0
+}
+
+func (x *StatisticalMetrics) GetTrendSimilarity() float64 {
+	if x != nil {
+		return x.TrendSimilarity
+	}
+	return 0
+}
+
+func (x *StatisticalMetrics) GetConfidenceScore() float64 {
+	if x != nil {
+		return x.ConfidenceScore
+	}
+	return 0
+}
+
+type SimulationParameters struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	VolatilityFactor float64                `protobuf:"fixed64,1,opt,name=volatility_factor,json=volatilityFactor,proto3" json:"volatility_factor,omitempty"`
+	TrendFactor      float64                `protobuf:"fixed64,2,opt,name=trend_factor,json=trendFactor,proto3" json:"trend_factor,omitempty"`
+	DataPoints       int32                  `protobuf:"varint,3,opt,name=data_points,json=dataPoints,proto3" json:"data_points,omitempty"`
+	IncludeNoise     bool                   `protobuf:"varint,4,opt,name=include_noise,json=includeNoise,proto3" json:"include_noise,omitempty"`
+	NoiseLevel       float64                `protobuf:"fixed64,5,opt,name=noise_level,json=noiseLevel,proto3" json:"noise_level,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SimulationParameters) Reset() {
+	*x = SimulationParameters{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulationParameters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulationParameters) ProtoMessage() {}
+
+func (x *SimulationParameters) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulationParameters.ProtoReflect.Descriptor instead.
+func (*SimulationParameters) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SimulationParameters) GetVolatilityFactor() float64 {
+	if x != nil {
+		return x.VolatilityFactor
+	}
+	return 0
+}
+
+func (x *SimulationParameters) GetTrendFactor() float64 {
+	if x != nil {
+		return x.TrendFactor
+	}
+	return 0
+}
+
+func (x *SimulationParameters) GetDataPoints() int32 {
+	if x != nil {
+		return x.DataPoints
+	}
+	return 0
+}
+
+func (x *SimulationParameters) GetIncludeNoise() bool {
+	if x != nil {
+		return x.IncludeNoise
+	}
+	return false
+}
+
+func (x *SimulationParameters) GetNoiseLevel() float64 {
+	if x != nil {
+		return x.NoiseLevel
+	}
+	return 0
+}
+
+type ScenarioParameters struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Intensity            float64                `protobuf:"fixed64,1,opt,name=intensity,proto3" json:"intensity,omitempty"`                                                           // 0.1 to 2.0
+	DurationFactor       float64                `protobuf:"fixed64,2,opt,name=duration_factor,json=durationFactor,proto3" json:"duration_factor,omitempty"`                           // How long the scenario lasts
+	RecoveryFactor       float64                `protobuf:"fixed64,3,opt,name=recovery_factor,json=recoveryFactor,proto3" json:"recovery_factor,omitempty"`                           // How quickly it recovers
+	GradualTransition    bool                   `protobuf:"varint,4,opt,name=gradual_transition,json=gradualTransition,proto3" json:"gradual_transition,omitempty"`                   // Gradual vs sudden onset
+	DepegDepthPercent    float64                `protobuf:"fixed64,5,opt,name=depeg_depth_percent,json=depegDepthPercent,proto3" json:"depeg_depth_percent,omitempty"`                // STABLECOIN_DEPEG: how far below the peg the price bottoms, default 13
+	DepegDurationMinutes int32                  `protobuf:"varint,6,opt,name=depeg_duration_minutes,json=depegDurationMinutes,proto3" json:"depeg_duration_minutes,omitempty"`        // STABLECOIN_DEPEG: from the break to the end of the recovery, default 30% of the scenario
+	DepegRecoveryPercent *float64               `protobuf:"fixed64,7,opt,name=depeg_recovery_percent,json=depegRecoveryPercent,proto3,oneof" json:"depeg_recovery_percent,omitempty"` // STABLECOIN_DEPEG: share of the drop recovered, default 100
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ScenarioParameters) Reset() {
+	*x = ScenarioParameters{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScenarioParameters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScenarioParameters) ProtoMessage() {}
+
+func (x *ScenarioParameters) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-)
+	return mi.MessageOf(x)
+}
 
-func (x HealthStatus) Enum() *HealthStatus {
-	p := new(HealthStatus)
-	*p = x
-	return p
+// Deprecated: Use ScenarioParameters.ProtoReflect.Descriptor instead.
+func (*ScenarioParameters) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{33}
 }
 
-func (x HealthStatus) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x *ScenarioParameters) GetIntensity() float64 {
+	if x != nil {
+		return x.Intensity
+	}
+	return 0
 }
 
-func (HealthStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_internal_proto_marketdata_proto_enumTypes[2].Descriptor()
+func (x *ScenarioParameters) GetDurationFactor() float64 {
+	if x != nil {
+		return x.DurationFactor
+	}
+	return 0
 }
 
-func (HealthStatus) Type() protoreflect.EnumType {
-	return &file_internal_proto_marketdata_proto_enumTypes[2]
+func (x *ScenarioParameters) GetRecoveryFactor() float64 {
+	if x != nil {
+		return x.RecoveryFactor
+	}
+	return 0
 }
 
-func (x HealthStatus) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *ScenarioParameters) GetGradualTransition() bool {
+	if x != nil {
+		return x.GradualTransition
+	}
+	return false
 }
 
-// Deprecated: Use HealthStatus.Descriptor instead.
-func (HealthStatus) EnumDescriptor() ([]byte, []int) {
-	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{2}
+func (x *ScenarioParameters) GetDepegDepthPercent() float64 {
+	if x != nil {
+		return x.DepegDepthPercent
+	}
+	return 0
 }
 
-type GetPriceRequest struct {
+func (x *ScenarioParameters) GetDepegDurationMinutes() int32 {
+	if x != nil {
+		return x.DepegDurationMinutes
+	}
+	return 0
+}
+
+func (x *ScenarioParameters) GetDepegRecoveryPercent() float64 {
+	if x != nil && x.DepegRecoveryPercent != nil {
+		return *x.DepegRecoveryPercent
+	}
+	return 0
+}
+
+type TradeReport struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Side          TradeSide              `protobuf:"varint,2,opt,name=side,proto3,enum=marketdata.TradeSide" json:"side,omitempty"` // Aggressor side
+	Quantity      float64                `protobuf:"fixed64,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"` // Execution price, informational
+	TradeId       string                 `protobuf:"bytes,5,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
+	Source        string                 `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"` // Reporting service, e.g. "exchange-simulator"
+	ExecutedAt    *timestamp.Timestamp   `protobuf:"bytes,7,opt,name=executed_at,json=executedAt,proto3" json:"executed_at,omitempty"`
+	ApiVersion    ApiVersion             `protobuf:"varint,8,opt,name=api_version,json=apiVersion,proto3,enum=marketdata.ApiVersion" json:"api_version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPriceRequest) Reset() {
-	*x = GetPriceRequest{}
-	mi := &file_internal_proto_marketdata_proto_msgTypes[0]
+func (x *TradeReport) Reset() {
+	*x = TradeReport{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPriceRequest) String() string {
+func (x *TradeReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPriceRequest) ProtoMessage() {}
+func (*TradeReport) ProtoMessage() {}
 
-func (x *GetPriceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_marketdata_proto_msgTypes[0]
+func (x *TradeReport) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -219,43 +3940,287 @@ func (x *GetPriceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPriceRequest.ProtoReflect.Descriptor instead.
-func (*GetPriceRequest) Descriptor() ([]byte, []int) {
-	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use TradeReport.ProtoReflect.Descriptor instead.
+func (*TradeReport) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *GetPriceRequest) GetSymbol() string {
+func (x *TradeReport) GetSymbol() string {
 	if x != nil {
 		return x.Symbol
 	}
 	return ""
 }
 
-type GetPriceResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	Price         float64                `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
-	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Source        string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *TradeReport) GetSide() TradeSide {
+	if x != nil {
+		return x.Side
+	}
+	return TradeSide_TRADE_SIDE_UNSPECIFIED
 }
 
-func (x *GetPriceResponse) Reset() {
-	*x = GetPriceResponse{}
-	mi := &file_internal_proto_marketdata_proto_msgTypes[1]
+func (x *TradeReport) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *TradeReport) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *TradeReport) GetTradeId() string {
+	if x != nil {
+		return x.TradeId
+	}
+	return ""
+}
+
+func (x *TradeReport) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *TradeReport) GetExecutedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExecutedAt
+	}
+	return nil
+}
+
+func (x *TradeReport) GetApiVersion() ApiVersion {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ApiVersion_API_VERSION_UNSPECIFIED
+}
+
+type TradeReportResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Symbol             string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	PriceBefore        float64                `protobuf:"fixed64,2,opt,name=price_before,json=priceBefore,proto3" json:"price_before,omitempty"` // Reference price before and after impact
+	PriceAfter         float64                `protobuf:"fixed64,3,opt,name=price_after,json=priceAfter,proto3" json:"price_after,omitempty"`
+	PermanentImpactBps float64                `protobuf:"fixed64,4,opt,name=permanent_impact_bps,json=permanentImpactBps,proto3" json:"permanent_impact_bps,omitempty"`
+	TemporaryImpactBps float64                `protobuf:"fixed64,5,opt,name=temporary_impact_bps,json=temporaryImpactBps,proto3" json:"temporary_impact_bps,omitempty"` // At trade time; decays with the configured half-life
+	PriceBeforeDecimal *Decimal               `protobuf:"bytes,6,opt,name=price_before_decimal,json=priceBeforeDecimal,proto3" json:"price_before_decimal,omitempty"`   // API_V2
+	PriceAfterDecimal  *Decimal               `protobuf:"bytes,7,opt,name=price_after_decimal,json=priceAfterDecimal,proto3" json:"price_after_decimal,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *TradeReportResponse) Reset() {
+	*x = TradeReportResponse{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPriceResponse) String() string {
+func (x *TradeReportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPriceResponse) ProtoMessage() {}
+func (*TradeReportResponse) ProtoMessage() {}
 
-func (x *GetPriceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_marketdata_proto_msgTypes[1]
+func (x *TradeReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradeReportResponse.ProtoReflect.Descriptor instead.
+func (*TradeReportResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *TradeReportResponse) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *TradeReportResponse) GetPriceBefore() float64 {
+	if x != nil {
+		return x.PriceBefore
+	}
+	return 0
+}
+
+func (x *TradeReportResponse) GetPriceAfter() float64 {
+	if x != nil {
+		return x.PriceAfter
+	}
+	return 0
+}
+
+func (x *TradeReportResponse) GetPermanentImpactBps() float64 {
+	if x != nil {
+		return x.PermanentImpactBps
+	}
+	return 0
+}
+
+func (x *TradeReportResponse) GetTemporaryImpactBps() float64 {
+	if x != nil {
+		return x.TemporaryImpactBps
+	}
+	return 0
+}
+
+func (x *TradeReportResponse) GetPriceBeforeDecimal() *Decimal {
+	if x != nil {
+		return x.PriceBeforeDecimal
+	}
+	return nil
+}
+
+func (x *TradeReportResponse) GetPriceAfterDecimal() *Decimal {
+	if x != nil {
+		return x.PriceAfterDecimal
+	}
+	return nil
+}
+
+type Instrument struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Symbol         string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	AssetClass     string                 `protobuf:"bytes,2,opt,name=asset_class,json=assetClass,proto3" json:"asset_class,omitempty"` // crypto, fx, equity, composite, ...
+	BaseCurrency   string                 `protobuf:"bytes,3,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	QuoteCurrency  string                 `protobuf:"bytes,4,opt,name=quote_currency,json=quoteCurrency,proto3" json:"quote_currency,omitempty"`
+	TickSize       float64                `protobuf:"fixed64,5,opt,name=tick_size,json=tickSize,proto3" json:"tick_size,omitempty"`
+	LotSize        float64                `protobuf:"fixed64,6,opt,name=lot_size,json=lotSize,proto3" json:"lot_size,omitempty"`
+	PricePrecision int32                  `protobuf:"varint,7,opt,name=price_precision,json=pricePrecision,proto3" json:"price_precision,omitempty"` // Decimal places
+	TradingHours   *TradingHours          `protobuf:"bytes,8,opt,name=trading_hours,json=tradingHours,proto3" json:"trading_hours,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Instrument) Reset() {
+	*x = Instrument{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Instrument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Instrument) ProtoMessage() {}
+
+func (x *Instrument) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Instrument.ProtoReflect.Descriptor instead.
+func (*Instrument) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Instrument) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Instrument) GetAssetClass() string {
+	if x != nil {
+		return x.AssetClass
+	}
+	return ""
+}
+
+func (x *Instrument) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
+func (x *Instrument) GetQuoteCurrency() string {
+	if x != nil {
+		return x.QuoteCurrency
+	}
+	return ""
+}
+
+func (x *Instrument) GetTickSize() float64 {
+	if x != nil {
+		return x.TickSize
+	}
+	return 0
+}
+
+func (x *Instrument) GetLotSize() float64 {
+	if x != nil {
+		return x.LotSize
+	}
+	return 0
+}
+
+func (x *Instrument) GetPricePrecision() int32 {
+	if x != nil {
+		return x.PricePrecision
+	}
+	return 0
+}
+
+func (x *Instrument) GetTradingHours() *TradingHours {
+	if x != nil {
+		return x.TradingHours
+	}
+	return nil
+}
+
+type TradingHours struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AlwaysOpen     bool                   `protobuf:"varint,1,opt,name=always_open,json=alwaysOpen,proto3" json:"always_open,omitempty"` // Trades 24/7; the other fields are empty
+	TimeZone       string                 `protobuf:"bytes,2,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`        // IANA zone the times below are in, weekdays only
+	PreOpen        string                 `protobuf:"bytes,3,opt,name=pre_open,json=preOpen,proto3" json:"pre_open,omitempty"`           // HH:MM
+	OpeningAuction string                 `protobuf:"bytes,4,opt,name=opening_auction,json=openingAuction,proto3" json:"opening_auction,omitempty"`
+	Continuous     string                 `protobuf:"bytes,5,opt,name=continuous,proto3" json:"continuous,omitempty"`
+	ClosingAuction string                 `protobuf:"bytes,6,opt,name=closing_auction,json=closingAuction,proto3" json:"closing_auction,omitempty"`
+	Close          string                 `protobuf:"bytes,7,opt,name=close,proto3" json:"close,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TradingHours) Reset() {
+	*x = TradingHours{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TradingHours) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradingHours) ProtoMessage() {}
+
+func (x *TradingHours) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -263,65 +4228,86 @@ func (x *GetPriceResponse) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradingHours.ProtoReflect.Descriptor instead.
+func (*TradingHours) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *TradingHours) GetAlwaysOpen() bool {
+	if x != nil {
+		return x.AlwaysOpen
+	}
+	return false
+}
+
+func (x *TradingHours) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
 }
 
-// Deprecated: Use GetPriceResponse.ProtoReflect.Descriptor instead.
-func (*GetPriceResponse) Descriptor() ([]byte, []int) {
-	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{1}
+func (x *TradingHours) GetPreOpen() string {
+	if x != nil {
+		return x.PreOpen
+	}
+	return ""
 }
 
-func (x *GetPriceResponse) GetSymbol() string {
+func (x *TradingHours) GetOpeningAuction() string {
 	if x != nil {
-		return x.Symbol
+		return x.OpeningAuction
 	}
 	return ""
 }
 
-func (x *GetPriceResponse) GetPrice() float64 {
+func (x *TradingHours) GetContinuous() string {
 	if x != nil {
-		return x.Price
+		return x.Continuous
 	}
-	return 0
+	return ""
 }
 
-func (x *GetPriceResponse) GetTimestamp() *timestamp.Timestamp {
+func (x *TradingHours) GetClosingAuction() string {
 	if x != nil {
-		return x.Timestamp
+		return x.ClosingAuction
 	}
-	return nil
+	return ""
 }
 
-func (x *GetPriceResponse) GetSource() string {
+func (x *TradingHours) GetClose() string {
 	if x != nil {
-		return x.Source
+		return x.Close
 	}
 	return ""
 }
 
-type StreamPricesRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Symbols          []string               `protobuf:"bytes,1,rep,name=symbols,proto3" json:"symbols,omitempty"`
-	UpdateIntervalMs int32                  `protobuf:"varint,2,opt,name=update_interval_ms,json=updateIntervalMs,proto3" json:"update_interval_ms,omitempty"` // milliseconds
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type ListInstrumentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssetClass    string                 `protobuf:"bytes,1,opt,name=asset_class,json=assetClass,proto3" json:"asset_class,omitempty"`          // Optional filter
+	QuoteCurrency string                 `protobuf:"bytes,2,opt,name=quote_currency,json=quoteCurrency,proto3" json:"quote_currency,omitempty"` // Optional filter
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StreamPricesRequest) Reset() {
-	*x = StreamPricesRequest{}
-	mi := &file_internal_proto_marketdata_proto_msgTypes[2]
+func (x *ListInstrumentsRequest) Reset() {
+	*x = ListInstrumentsRequest{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamPricesRequest) String() string {
+func (x *ListInstrumentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamPricesRequest) ProtoMessage() {}
+func (*ListInstrumentsRequest) ProtoMessage() {}
 
-func (x *StreamPricesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_marketdata_proto_msgTypes[2]
+func (x *ListInstrumentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -332,52 +4318,47 @@ func (x *StreamPricesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamPricesRequest.ProtoReflect.Descriptor instead.
-func (*StreamPricesRequest) Descriptor() ([]byte, []int) {
-	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ListInstrumentsRequest.ProtoReflect.Descriptor instead.
+func (*ListInstrumentsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *StreamPricesRequest) GetSymbols() []string {
+func (x *ListInstrumentsRequest) GetAssetClass() string {
 	if x != nil {
-		return x.Symbols
+		return x.AssetClass
 	}
-	return nil
+	return ""
 }
 
-func (x *StreamPricesRequest) GetUpdateIntervalMs() int32 {
+func (x *ListInstrumentsRequest) GetQuoteCurrency() string {
 	if x != nil {
-		return x.UpdateIntervalMs
+		return x.QuoteCurrency
 	}
-	return 0
+	return ""
 }
 
-type PriceUpdate struct {
+type ListInstrumentsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	Price         float64                `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
-	Volume        float64                `protobuf:"fixed64,3,opt,name=volume,proto3" json:"volume,omitempty"`
-	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Source        string                 `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
-	ChangeInfo    *PriceChangeInfo       `protobuf:"bytes,6,opt,name=change_info,json=changeInfo,proto3" json:"change_info,omitempty"`
+	Instruments   []*Instrument          `protobuf:"bytes,1,rep,name=instruments,proto3" json:"instruments,omitempty"` // Sorted by symbol
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PriceUpdate) Reset() {
-	*x = PriceUpdate{}
-	mi := &file_internal_proto_marketdata_proto_msgTypes[3]
+func (x *ListInstrumentsResponse) Reset() {
+	*x = ListInstrumentsResponse{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PriceUpdate) String() string {
+func (x *ListInstrumentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PriceUpdate) ProtoMessage() {}
+func (*ListInstrumentsResponse) ProtoMessage() {}
 
-func (x *PriceUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_marketdata_proto_msgTypes[3]
+func (x *ListInstrumentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -388,79 +4369,86 @@ func (x *PriceUpdate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PriceUpdate.ProtoReflect.Descriptor instead.
-func (*PriceUpdate) Descriptor() ([]byte, []int) {
-	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ListInstrumentsResponse.ProtoReflect.Descriptor instead.
+func (*ListInstrumentsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *PriceUpdate) GetSymbol() string {
+func (x *ListInstrumentsResponse) GetInstruments() []*Instrument {
 	if x != nil {
-		return x.Symbol
+		return x.Instruments
 	}
-	return ""
+	return nil
 }
 
-func (x *PriceUpdate) GetPrice() float64 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
+type GetInstrumentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PriceUpdate) GetVolume() float64 {
-	if x != nil {
-		return x.Volume
-	}
-	return 0
+func (x *GetInstrumentRequest) Reset() {
+	*x = GetInstrumentRequest{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *PriceUpdate) GetTimestamp() *timestamp.Timestamp {
-	if x != nil {
-		return x.Timestamp
-	}
-	return nil
+func (x *GetInstrumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *PriceUpdate) GetSource() string {
+func (*GetInstrumentRequest) ProtoMessage() {}
+
+func (x *GetInstrumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[40]
 	if x != nil {
-		return x.Source
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *PriceUpdate) GetChangeInfo() *PriceChangeInfo {
+// Deprecated: Use GetInstrumentRequest.ProtoReflect.Descriptor instead.
+func (*GetInstrumentRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetInstrumentRequest) GetSymbol() string {
 	if x != nil {
-		return x.ChangeInfo
+		return x.Symbol
 	}
-	return nil
+	return ""
 }
 
-type PriceChangeInfo struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	ChangeAmount     float64                `protobuf:"fixed64,1,opt,name=change_amount,json=changeAmount,proto3" json:"change_amount,omitempty"`
-	ChangePercentage float64                `protobuf:"fixed64,2,opt,name=change_percentage,json=changePercentage,proto3" json:"change_percentage,omitempty"`
-	DailyHigh        float64                `protobuf:"fixed64,3,opt,name=daily_high,json=dailyHigh,proto3" json:"daily_high,omitempty"`
-	DailyLow         float64                `protobuf:"fixed64,4,opt,name=daily_low,json=dailyLow,proto3" json:"daily_low,omitempty"`
-	DailyVolume      float64                `protobuf:"fixed64,5,opt,name=daily_volume,json=dailyVolume,proto3" json:"daily_volume,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type GetOptionChainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Series        string                 `protobuf:"bytes,1,opt,name=series,proto3" json:"series,omitempty"` // Option series root, e.g. "BTC-OPT"
+	Expiry        *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=expiry,proto3" json:"expiry,omitempty"` // Optional: only this expiry
+	ApiVersion    ApiVersion             `protobuf:"varint,3,opt,name=api_version,json=apiVersion,proto3,enum=marketdata.ApiVersion" json:"api_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PriceChangeInfo) Reset() {
-	*x = PriceChangeInfo{}
-	mi := &file_internal_proto_marketdata_proto_msgTypes[4]
+func (x *GetOptionChainRequest) Reset() {
+	*x = GetOptionChainRequest{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PriceChangeInfo) String() string {
+func (x *GetOptionChainRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PriceChangeInfo) ProtoMessage() {}
+func (*GetOptionChainRequest) ProtoMessage() {}
 
-func (x *PriceChangeInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_marketdata_proto_msgTypes[4]
+func (x *GetOptionChainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -471,72 +4459,56 @@ func (x *PriceChangeInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PriceChangeInfo.ProtoReflect.Descriptor instead.
-func (*PriceChangeInfo) Descriptor() ([]byte, []int) {
-	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *PriceChangeInfo) GetChangeAmount() float64 {
-	if x != nil {
-		return x.ChangeAmount
-	}
-	return 0
-}
-
-func (x *PriceChangeInfo) GetChangePercentage() float64 {
-	if x != nil {
-		return x.ChangePercentage
-	}
-	return 0
+// Deprecated: Use GetOptionChainRequest.ProtoReflect.Descriptor instead.
+func (*GetOptionChainRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *PriceChangeInfo) GetDailyHigh() float64 {
+func (x *GetOptionChainRequest) GetSeries() string {
 	if x != nil {
-		return x.DailyHigh
+		return x.Series
 	}
-	return 0
+	return ""
 }
 
-func (x *PriceChangeInfo) GetDailyLow() float64 {
+func (x *GetOptionChainRequest) GetExpiry() *timestamp.Timestamp {
 	if x != nil {
-		return x.DailyLow
+		return x.Expiry
 	}
-	return 0
+	return nil
 }
 
-func (x *PriceChangeInfo) GetDailyVolume() float64 {
+func (x *GetOptionChainRequest) GetApiVersion() ApiVersion {
 	if x != nil {
-		return x.DailyVolume
+		return x.ApiVersion
 	}
-	return 0
+	return ApiVersion_API_VERSION_UNSPECIFIED
 }
 
-type SimulationRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Symbol         string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	StartTime      *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime        *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	SimulationType SimulationType         `protobuf:"varint,4,opt,name=simulation_type,json=simulationType,proto3,enum=marketdata.SimulationType" json:"simulation_type,omitempty"`
-	Parameters     *SimulationParameters  `protobuf:"bytes,5,opt,name=parameters,proto3" json:"parameters,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type StreamOptionChainRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Series           string                 `protobuf:"bytes,1,opt,name=series,proto3" json:"series,omitempty"`
+	UpdateIntervalMs int32                  `protobuf:"varint,2,opt,name=update_interval_ms,json=updateIntervalMs,proto3" json:"update_interval_ms,omitempty"`
+	ApiVersion       ApiVersion             `protobuf:"varint,3,opt,name=api_version,json=apiVersion,proto3,enum=marketdata.ApiVersion" json:"api_version,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *SimulationRequest) Reset() {
-	*x = SimulationRequest{}
-	mi := &file_internal_proto_marketdata_proto_msgTypes[5]
+func (x *StreamOptionChainRequest) Reset() {
+	*x = StreamOptionChainRequest{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SimulationRequest) String() string {
+func (x *StreamOptionChainRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SimulationRequest) ProtoMessage() {}
+func (*StreamOptionChainRequest) ProtoMessage() {}
 
-func (x *SimulationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_marketdata_proto_msgTypes[5]
+func (x *StreamOptionChainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -547,72 +4519,66 @@ func (x *SimulationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SimulationRequest.ProtoReflect.Descriptor instead.
-func (*SimulationRequest) Descriptor() ([]byte, []int) {
-	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use StreamOptionChainRequest.ProtoReflect.Descriptor instead.
+func (*StreamOptionChainRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *SimulationRequest) GetSymbol() string {
+func (x *StreamOptionChainRequest) GetSeries() string {
 	if x != nil {
-		return x.Symbol
+		return x.Series
 	}
 	return ""
 }
 
-func (x *SimulationRequest) GetStartTime() *timestamp.Timestamp {
-	if x != nil {
-		return x.StartTime
-	}
-	return nil
-}
-
-func (x *SimulationRequest) GetEndTime() *timestamp.Timestamp {
-	if x != nil {
-		return x.EndTime
-	}
-	return nil
-}
-
-func (x *SimulationRequest) GetSimulationType() SimulationType {
+func (x *StreamOptionChainRequest) GetUpdateIntervalMs() int32 {
 	if x != nil {
-		return x.SimulationType
+		return x.UpdateIntervalMs
 	}
-	return SimulationType_STATISTICAL_SIMILARITY
+	return 0
 }
 
-func (x *SimulationRequest) GetParameters() *SimulationParameters {
+func (x *StreamOptionChainRequest) GetApiVersion() ApiVersion {
 	if x != nil {
-		return x.Parameters
+		return x.ApiVersion
 	}
-	return nil
-}
-
-type SimulationResponse struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Symbol            string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	HistoricalData    []*PricePoint          `protobuf:"bytes,2,rep,name=historical_data,json=historicalData,proto3" json:"historical_data,omitempty"`
-	SimulatedData     []*PricePoint          `protobuf:"bytes,3,rep,name=simulated_data,json=simulatedData,proto3" json:"simulated_data,omitempty"`
-	SimilarityMetrics *StatisticalMetrics    `protobuf:"bytes,4,opt,name=similarity_metrics,json=similarityMetrics,proto3" json:"similarity_metrics,omitempty"`
-	SimulationId      string                 `protobuf:"bytes,5,opt,name=simulation_id,json=simulationId,proto3" json:"simulation_id,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
-}
-
-func (x *SimulationResponse) Reset() {
-	*x = SimulationResponse{}
-	mi := &file_internal_proto_marketdata_proto_msgTypes[6]
+	return ApiVersion_API_VERSION_UNSPECIFIED
+}
+
+// OptionChain is every listed option of a series, strikes × expiries, priced
+// off one underlying price in the shared market. Strikes are listed around
+// the underlying when an expiry is listed and stay fixed until it expires.
+// Streamed chains are the option updates of one tick, so options are left
+// out while the underlying is halted or not trading continuously. Forwards,
+// volatilities and greeks are model outputs and stay doubles in API_V2.
+type OptionChain struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Series                 string                 `protobuf:"bytes,1,opt,name=series,proto3" json:"series,omitempty"`
+	UnderlyingSymbol       string                 `protobuf:"bytes,2,opt,name=underlying_symbol,json=underlyingSymbol,proto3" json:"underlying_symbol,omitempty"`
+	UnderlyingPrice        float64                `protobuf:"fixed64,3,opt,name=underlying_price,json=underlyingPrice,proto3" json:"underlying_price,omitempty"`
+	Timestamp              *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Expiries               []*OptionExpiry        `protobuf:"bytes,5,rep,name=expiries,proto3" json:"expiries,omitempty"`                                                             // Nearest first
+	Sequence               uint64                 `protobuf:"varint,6,opt,name=sequence,proto3" json:"sequence,omitempty"`                                                            // StreamOptionChain only: the stream sequence of the chain's last option; a gap means dropped updates
+	UnderlyingPriceDecimal *Decimal               `protobuf:"bytes,7,opt,name=underlying_price_decimal,json=underlyingPriceDecimal,proto3" json:"underlying_price_decimal,omitempty"` // API_V2
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *OptionChain) Reset() {
+	*x = OptionChain{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SimulationResponse) String() string {
+func (x *OptionChain) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SimulationResponse) ProtoMessage() {}
+func (*OptionChain) ProtoMessage() {}
 
-func (x *SimulationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_marketdata_proto_msgTypes[6]
+func (x *OptionChain) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -623,72 +4589,85 @@ func (x *SimulationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SimulationResponse.ProtoReflect.Descriptor instead.
-func (*SimulationResponse) Descriptor() ([]byte, []int) {
-	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use OptionChain.ProtoReflect.Descriptor instead.
+func (*OptionChain) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *SimulationResponse) GetSymbol() string {
+func (x *OptionChain) GetSeries() string {
 	if x != nil {
-		return x.Symbol
+		return x.Series
 	}
 	return ""
 }
 
-func (x *SimulationResponse) GetHistoricalData() []*PricePoint {
+func (x *OptionChain) GetUnderlyingSymbol() string {
 	if x != nil {
-		return x.HistoricalData
+		return x.UnderlyingSymbol
 	}
-	return nil
+	return ""
 }
 
-func (x *SimulationResponse) GetSimulatedData() []*PricePoint {
+func (x *OptionChain) GetUnderlyingPrice() float64 {
 	if x != nil {
-		return x.SimulatedData
+		return x.UnderlyingPrice
+	}
+	return 0
+}
+
+func (x *OptionChain) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
 	}
 	return nil
 }
 
-func (x *SimulationResponse) GetSimilarityMetrics() *StatisticalMetrics {
+func (x *OptionChain) GetExpiries() []*OptionExpiry {
 	if x != nil {
-		return x.SimilarityMetrics
+		return x.Expiries
 	}
 	return nil
 }
 
-func (x *SimulationResponse) GetSimulationId() string {
+func (x *OptionChain) GetSequence() uint64 {
 	if x != nil {
-		return x.SimulationId
+		return x.Sequence
 	}
-	return ""
+	return 0
 }
 
-type ScenarioRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Symbol          string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	ScenarioType    ScenarioType           `protobuf:"varint,2,opt,name=scenario_type,json=scenarioType,proto3,enum=marketdata.ScenarioType" json:"scenario_type,omitempty"`
-	Parameters      *ScenarioParameters    `protobuf:"bytes,3,opt,name=parameters,proto3" json:"parameters,omitempty"`
-	StartTime       *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	DurationMinutes int32                  `protobuf:"varint,5,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *OptionChain) GetUnderlyingPriceDecimal() *Decimal {
+	if x != nil {
+		return x.UnderlyingPriceDecimal
+	}
+	return nil
 }
 
-func (x *ScenarioRequest) Reset() {
-	*x = ScenarioRequest{}
-	mi := &file_internal_proto_marketdata_proto_msgTypes[7]
+type OptionExpiry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Expiry        *timestamp.Timestamp   `protobuf:"bytes,1,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	Forward       float64                `protobuf:"fixed64,2,opt,name=forward,proto3" json:"forward,omitempty"`
+	AtmVolatility float64                `protobuf:"fixed64,3,opt,name=atm_volatility,json=atmVolatility,proto3" json:"atm_volatility,omitempty"` // Implied volatility at the forward
+	Options       []*OptionQuote         `protobuf:"bytes,4,rep,name=options,proto3" json:"options,omitempty"`                                    // By strike, call before put
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OptionExpiry) Reset() {
+	*x = OptionExpiry{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ScenarioRequest) String() string {
+func (x *OptionExpiry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScenarioRequest) ProtoMessage() {}
+func (*OptionExpiry) ProtoMessage() {}
 
-func (x *ScenarioRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_marketdata_proto_msgTypes[7]
+func (x *OptionExpiry) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -699,73 +4678,71 @@ func (x *ScenarioRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScenarioRequest.ProtoReflect.Descriptor instead.
-func (*ScenarioRequest) Descriptor() ([]byte, []int) {
-	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use OptionExpiry.ProtoReflect.Descriptor instead.
+func (*OptionExpiry) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *ScenarioRequest) GetSymbol() string {
+func (x *OptionExpiry) GetExpiry() *timestamp.Timestamp {
 	if x != nil {
-		return x.Symbol
+		return x.Expiry
 	}
-	return ""
+	return nil
 }
 
-func (x *ScenarioRequest) GetScenarioType() ScenarioType {
+func (x *OptionExpiry) GetForward() float64 {
 	if x != nil {
-		return x.ScenarioType
+		return x.Forward
 	}
-	return ScenarioType_RALLY
+	return 0
 }
 
-func (x *ScenarioRequest) GetParameters() *ScenarioParameters {
+func (x *OptionExpiry) GetAtmVolatility() float64 {
 	if x != nil {
-		return x.Parameters
+		return x.AtmVolatility
 	}
-	return nil
+	return 0
 }
 
-func (x *ScenarioRequest) GetStartTime() *timestamp.Timestamp {
+func (x *OptionExpiry) GetOptions() []*OptionQuote {
 	if x != nil {
-		return x.StartTime
+		return x.Options
 	}
 	return nil
 }
 
-func (x *ScenarioRequest) GetDurationMinutes() int32 {
-	if x != nil {
-		return x.DurationMinutes
-	}
-	return 0
-}
-
-type PricePoint struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Open          float64                `protobuf:"fixed64,2,opt,name=open,proto3" json:"open,omitempty"`
-	High          float64                `protobuf:"fixed64,3,opt,name=high,proto3" json:"high,omitempty"`
-	Low           float64                `protobuf:"fixed64,4,opt,name=low,proto3" json:"low,omitempty"`
-	Close         float64                `protobuf:"fixed64,5,opt,name=close,proto3" json:"close,omitempty"`
-	Volume        float64                `protobuf:"fixed64,6,opt,name=volume,proto3" json:"volume,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type OptionQuote struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Symbol            string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"` // ROOT-YYYYMMDD-STRIKE-C or -P
+	Type              OptionType             `protobuf:"varint,2,opt,name=type,proto3,enum=marketdata.OptionType" json:"type,omitempty"`
+	Strike            float64                `protobuf:"fixed64,3,opt,name=strike,proto3" json:"strike,omitempty"`
+	Bid               float64                `protobuf:"fixed64,4,opt,name=bid,proto3" json:"bid,omitempty"`
+	Ask               float64                `protobuf:"fixed64,5,opt,name=ask,proto3" json:"ask,omitempty"`
+	Mark              float64                `protobuf:"fixed64,6,opt,name=mark,proto3" json:"mark,omitempty"`                                                    // Theoretical value at the surface's volatility
+	ImpliedVolatility float64                `protobuf:"fixed64,7,opt,name=implied_volatility,json=impliedVolatility,proto3" json:"implied_volatility,omitempty"` // Annualized, as a fraction
+	Greeks            *OptionGreeks          `protobuf:"bytes,8,opt,name=greeks,proto3" json:"greeks,omitempty"`
+	BidDecimal        *Decimal               `protobuf:"bytes,9,opt,name=bid_decimal,json=bidDecimal,proto3" json:"bid_decimal,omitempty"` // API_V2, at the underlying's precision
+	AskDecimal        *Decimal               `protobuf:"bytes,10,opt,name=ask_decimal,json=askDecimal,proto3" json:"ask_decimal,omitempty"`
+	MarkDecimal       *Decimal               `protobuf:"bytes,11,opt,name=mark_decimal,json=markDecimal,proto3" json:"mark_decimal,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *PricePoint) Reset() {
-	*x = PricePoint{}
-	mi := &file_internal_proto_marketdata_proto_msgTypes[8]
+func (x *OptionQuote) Reset() {
+	*x = OptionQuote{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PricePoint) String() string {
+func (x *OptionQuote) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PricePoint) ProtoMessage() {}
+func (*OptionQuote) ProtoMessage() {}
 
-func (x *PricePoint) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_marketdata_proto_msgTypes[8]
+func (x *OptionQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -776,79 +4753,116 @@ func (x *PricePoint) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PricePoint.ProtoReflect.Descriptor instead.
-func (*PricePoint) Descriptor() ([]byte, []int) {
-	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use OptionQuote.ProtoReflect.Descriptor instead.
+func (*OptionQuote) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *PricePoint) GetTimestamp() *timestamp.Timestamp {
+func (x *OptionQuote) GetSymbol() string {
 	if x != nil {
-		return x.Timestamp
+		return x.Symbol
 	}
-	return nil
+	return ""
 }
 
-func (x *PricePoint) GetOpen() float64 {
+func (x *OptionQuote) GetType() OptionType {
 	if x != nil {
-		return x.Open
+		return x.Type
+	}
+	return OptionType_OPTION_TYPE_UNSPECIFIED
+}
+
+func (x *OptionQuote) GetStrike() float64 {
+	if x != nil {
+		return x.Strike
 	}
 	return 0
 }
 
-func (x *PricePoint) GetHigh() float64 {
+func (x *OptionQuote) GetBid() float64 {
 	if x != nil {
-		return x.High
+		return x.Bid
 	}
 	return 0
 }
 
-func (x *PricePoint) GetLow() float64 {
+func (x *OptionQuote) GetAsk() float64 {
 	if x != nil {
-		return x.Low
+		return x.Ask
 	}
 	return 0
 }
 
-func (x *PricePoint) GetClose() float64 {
+func (x *OptionQuote) GetMark() float64 {
 	if x != nil {
-		return x.Close
+		return x.Mark
 	}
 	return 0
 }
 
-func (x *PricePoint) GetVolume() float64 {
+func (x *OptionQuote) GetImpliedVolatility() float64 {
 	if x != nil {
-		return x.Volume
+		return x.ImpliedVolatility
 	}
 	return 0
 }
 
-type StatisticalMetrics struct {
-	state                        protoimpl.MessageState `protogen:"open.v1"`
-	CorrelationCoefficient       float64                `protobuf:"fixed64,1,opt,name=correlation_coefficient,json=correlationCoefficient,proto3" json:"correlation_coefficient,omitempty"`
-	VolatilitySimilarity         float64                `protobuf:"fixed64,2,opt,name=volatility_similarity,json=volatilitySimilarity,proto3" json:"volatility_similarity,omitempty"`
-	ReturnDistributionSimilarity float64                `protobuf:"fixed64,3,opt,name=return_distribution_similarity,json=returnDistributionSimilarity,proto3" json:"return_distribution_similarity,omitempty"`
-	TrendSimilarity              float64                `protobuf:"fixed64,4,opt,name=trend_similarity,json=trendSimilarity,proto3" json:"trend_similarity,omitempty"`
-	ConfidenceScore              float64                `protobuf:"fixed64,5,opt,name=confidence_score,json=confidenceScore,proto3" json:"confidence_score,omitempty"`
-	unknownFields                protoimpl.UnknownFields
-	sizeCache                    protoimpl.SizeCache
+func (x *OptionQuote) GetGreeks() *OptionGreeks {
+	if x != nil {
+		return x.Greeks
+	}
+	return nil
 }
 
-func (x *StatisticalMetrics) Reset() {
-	*x = StatisticalMetrics{}
-	mi := &file_internal_proto_marketdata_proto_msgTypes[9]
+func (x *OptionQuote) GetBidDecimal() *Decimal {
+	if x != nil {
+		return x.BidDecimal
+	}
+	return nil
+}
+
+func (x *OptionQuote) GetAskDecimal() *Decimal {
+	if x != nil {
+		return x.AskDecimal
+	}
+	return nil
+}
+
+func (x *OptionQuote) GetMarkDecimal() *Decimal {
+	if x != nil {
+		return x.MarkDecimal
+	}
+	return nil
+}
+
+// OptionGreeks are Black-Scholes sensitivities: vega per volatility point,
+// theta per calendar day and rho per percentage point of interest
+type OptionGreeks struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Delta         float64                `protobuf:"fixed64,1,opt,name=delta,proto3" json:"delta,omitempty"`
+	Gamma         float64                `protobuf:"fixed64,2,opt,name=gamma,proto3" json:"gamma,omitempty"`
+	Vega          float64                `protobuf:"fixed64,3,opt,name=vega,proto3" json:"vega,omitempty"`
+	Theta         float64                `protobuf:"fixed64,4,opt,name=theta,proto3" json:"theta,omitempty"`
+	Rho           float64                `protobuf:"fixed64,5,opt,name=rho,proto3" json:"rho,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OptionGreeks) Reset() {
+	*x = OptionGreeks{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StatisticalMetrics) String() string {
+func (x *OptionGreeks) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatisticalMetrics) ProtoMessage() {}
+func (*OptionGreeks) ProtoMessage() {}
 
-func (x *StatisticalMetrics) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_marketdata_proto_msgTypes[9]
+func (x *OptionGreeks) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -859,72 +4873,68 @@ func (x *StatisticalMetrics) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatisticalMetrics.ProtoReflect.Descriptor instead.
-func (*StatisticalMetrics) Descriptor() ([]byte, []int) {
-	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use OptionGreeks.ProtoReflect.Descriptor instead.
+func (*OptionGreeks) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *StatisticalMetrics) GetCorrelationCoefficient() float64 {
+func (x *OptionGreeks) GetDelta() float64 {
 	if x != nil {
-		return x.CorrelationCoefficient
+		return x.Delta
 	}
 	return 0
 }
 
-func (x *StatisticalMetrics) GetVolatilitySimilarity() float64 {
+func (x *OptionGreeks) GetGamma() float64 {
 	if x != nil {
-		return x.VolatilitySimilarity
+		return x.Gamma
 	}
 	return 0
 }
 
-func (x *StatisticalMetrics) GetReturnDistributionSimilarity() float64 {
+func (x *OptionGreeks) GetVega() float64 {
 	if x != nil {
-		return x.ReturnDistributionSimilarity
+		return x.Vega
 	}
 	return 0
 }
 
-func (x *StatisticalMetrics) GetTrendSimilarity() float64 {
+func (x *OptionGreeks) GetTheta() float64 {
 	if x != nil {
-		return x.TrendSimilarity
+		return x.Theta
 	}
 	return 0
 }
 
-func (x *StatisticalMetrics) GetConfidenceScore() float64 {
+func (x *OptionGreeks) GetRho() float64 {
 	if x != nil {
-		return x.ConfidenceScore
+		return x.Rho
 	}
 	return 0
 }
 
-type SimulationParameters struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	VolatilityFactor float64                `protobuf:"fixed64,1,opt,name=volatility_factor,json=volatilityFactor,proto3" json:"volatility_factor,omitempty"`
-	TrendFactor      float64                `protobuf:"fixed64,2,opt,name=trend_factor,json=trendFactor,proto3" json:"trend_factor,omitempty"`
-	DataPoints       int32                  `protobuf:"varint,3,opt,name=data_points,json=dataPoints,proto3" json:"data_points,omitempty"`
-	IncludeNoise     bool                   `protobuf:"varint,4,opt,name=include_noise,json=includeNoise,proto3" json:"include_noise,omitempty"`
-	NoiseLevel       float64                `protobuf:"fixed64,5,opt,name=noise_level,json=noiseLevel,proto3" json:"noise_level,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type GetYieldCurveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenorsYears   []float64              `protobuf:"fixed64,1,rep,packed,name=tenors_years,json=tenorsYears,proto3" json:"tenors_years,omitempty"` // Default 0.25, 0.5, 1, 2, 3, 5, 7, 10, 20 and 30
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SimulationParameters) Reset() {
-	*x = SimulationParameters{}
-	mi := &file_internal_proto_marketdata_proto_msgTypes[10]
+func (x *GetYieldCurveRequest) Reset() {
+	*x = GetYieldCurveRequest{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SimulationParameters) String() string {
+func (x *GetYieldCurveRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SimulationParameters) ProtoMessage() {}
+func (*GetYieldCurveRequest) ProtoMessage() {}
 
-func (x *SimulationParameters) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_marketdata_proto_msgTypes[10]
+func (x *GetYieldCurveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -935,71 +4945,118 @@ func (x *SimulationParameters) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SimulationParameters.ProtoReflect.Descriptor instead.
-func (*SimulationParameters) Descriptor() ([]byte, []int) {
-	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use GetYieldCurveRequest.ProtoReflect.Descriptor instead.
+func (*GetYieldCurveRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *SimulationParameters) GetVolatilityFactor() float64 {
+func (x *GetYieldCurveRequest) GetTenorsYears() []float64 {
 	if x != nil {
-		return x.VolatilityFactor
+		return x.TenorsYears
 	}
-	return 0
+	return nil
 }
 
-func (x *SimulationParameters) GetTrendFactor() float64 {
+type YieldCurve struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currency      string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Factors       *CurveFactors          `protobuf:"bytes,2,opt,name=factors,proto3" json:"factors,omitempty"`
+	LambdaYears   float64                `protobuf:"fixed64,3,opt,name=lambda_years,json=lambdaYears,proto3" json:"lambda_years,omitempty"`
+	Points        []*YieldCurvePoint     `protobuf:"bytes,4,rep,name=points,proto3" json:"points,omitempty"` // In the order requested
+	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *YieldCurve) Reset() {
+	*x = YieldCurve{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *YieldCurve) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*YieldCurve) ProtoMessage() {}
+
+func (x *YieldCurve) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[48]
 	if x != nil {
-		return x.TrendFactor
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *SimulationParameters) GetDataPoints() int32 {
+// Deprecated: Use YieldCurve.ProtoReflect.Descriptor instead.
+func (*YieldCurve) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *YieldCurve) GetCurrency() string {
 	if x != nil {
-		return x.DataPoints
+		return x.Currency
 	}
-	return 0
+	return ""
 }
 
-func (x *SimulationParameters) GetIncludeNoise() bool {
+func (x *YieldCurve) GetFactors() *CurveFactors {
 	if x != nil {
-		return x.IncludeNoise
+		return x.Factors
 	}
-	return false
+	return nil
 }
 
-func (x *SimulationParameters) GetNoiseLevel() float64 {
+func (x *YieldCurve) GetLambdaYears() float64 {
 	if x != nil {
-		return x.NoiseLevel
+		return x.LambdaYears
 	}
 	return 0
 }
 
-type ScenarioParameters struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Intensity         float64                `protobuf:"fixed64,1,opt,name=intensity,proto3" json:"intensity,omitempty"`                                         // 0.1 to 2.0
-	DurationFactor    float64                `protobuf:"fixed64,2,opt,name=duration_factor,json=durationFactor,proto3" json:"duration_factor,omitempty"`         // How long the scenario lasts
-	RecoveryFactor    float64                `protobuf:"fixed64,3,opt,name=recovery_factor,json=recoveryFactor,proto3" json:"recovery_factor,omitempty"`         // How quickly it recovers
-	GradualTransition bool                   `protobuf:"varint,4,opt,name=gradual_transition,json=gradualTransition,proto3" json:"gradual_transition,omitempty"` // Gradual vs sudden onset
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (x *YieldCurve) GetPoints() []*YieldCurvePoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
 }
 
-func (x *ScenarioParameters) Reset() {
-	*x = ScenarioParameters{}
-	mi := &file_internal_proto_marketdata_proto_msgTypes[11]
+func (x *YieldCurve) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type YieldCurvePoint struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TenorYears      float64                `protobuf:"fixed64,1,opt,name=tenor_years,json=tenorYears,proto3" json:"tenor_years,omitempty"`
+	ZeroRatePercent float64                `protobuf:"fixed64,2,opt,name=zero_rate_percent,json=zeroRatePercent,proto3" json:"zero_rate_percent,omitempty"` // Continuously compounded
+	DiscountFactor  float64                `protobuf:"fixed64,3,opt,name=discount_factor,json=discountFactor,proto3" json:"discount_factor,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *YieldCurvePoint) Reset() {
+	*x = YieldCurvePoint{}
+	mi := &file_internal_proto_marketdata_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ScenarioParameters) String() string {
+func (x *YieldCurvePoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScenarioParameters) ProtoMessage() {}
+func (*YieldCurvePoint) ProtoMessage() {}
 
-func (x *ScenarioParameters) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_marketdata_proto_msgTypes[11]
+func (x *YieldCurvePoint) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_marketdata_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1010,39 +5067,32 @@ func (x *ScenarioParameters) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScenarioParameters.ProtoReflect.Descriptor instead.
-func (*ScenarioParameters) Descriptor() ([]byte, []int) {
-	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use YieldCurvePoint.ProtoReflect.Descriptor instead.
+func (*YieldCurvePoint) Descriptor() ([]byte, []int) {
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *ScenarioParameters) GetIntensity() float64 {
+func (x *YieldCurvePoint) GetTenorYears() float64 {
 	if x != nil {
-		return x.Intensity
+		return x.TenorYears
 	}
 	return 0
 }
 
-func (x *ScenarioParameters) GetDurationFactor() float64 {
+func (x *YieldCurvePoint) GetZeroRatePercent() float64 {
 	if x != nil {
-		return x.DurationFactor
+		return x.ZeroRatePercent
 	}
 	return 0
 }
 
-func (x *ScenarioParameters) GetRecoveryFactor() float64 {
+func (x *YieldCurvePoint) GetDiscountFactor() float64 {
 	if x != nil {
-		return x.RecoveryFactor
+		return x.DiscountFactor
 	}
 	return 0
 }
 
-func (x *ScenarioParameters) GetGradualTransition() bool {
-	if x != nil {
-		return x.GradualTransition
-	}
-	return false
-}
-
 type HealthCheckRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Service       string                 `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
@@ -1052,7 +5102,7 @@ type HealthCheckRequest struct {
 
 func (x *HealthCheckRequest) Reset() {
 	*x = HealthCheckRequest{}
-	mi := &file_internal_proto_marketdata_proto_msgTypes[12]
+	mi := &file_internal_proto_marketdata_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1064,7 +5114,7 @@ func (x *HealthCheckRequest) String() string {
 func (*HealthCheckRequest) ProtoMessage() {}
 
 func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_marketdata_proto_msgTypes[12]
+	mi := &file_internal_proto_marketdata_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1077,7 +5127,7 @@ func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthCheckRequest.ProtoReflect.Descriptor instead.
 func (*HealthCheckRequest) Descriptor() ([]byte, []int) {
-	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{12}
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *HealthCheckRequest) GetService() string {
@@ -1099,7 +5149,7 @@ type HealthCheckResponse struct {
 
 func (x *HealthCheckResponse) Reset() {
 	*x = HealthCheckResponse{}
-	mi := &file_internal_proto_marketdata_proto_msgTypes[13]
+	mi := &file_internal_proto_marketdata_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1111,7 +5161,7 @@ func (x *HealthCheckResponse) String() string {
 func (*HealthCheckResponse) ProtoMessage() {}
 
 func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_marketdata_proto_msgTypes[13]
+	mi := &file_internal_proto_marketdata_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1124,7 +5174,7 @@ func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthCheckResponse.ProtoReflect.Descriptor instead.
 func (*HealthCheckResponse) Descriptor() ([]byte, []int) {
-	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{13}
+	return file_internal_proto_marketdata_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *HealthCheckResponse) GetStatus() HealthStatus {
@@ -1160,17 +5210,57 @@ var File_internal_proto_marketdata_proto protoreflect.FileDescriptor
 const file_internal_proto_marketdata_proto_rawDesc = "" +
 	"\n" +
 	"\x1finternal/proto/marketdata.proto\x12\n" +
-	"marketdata\x1a\x1fgoogle/protobuf/timestamp.proto\")\n" +
+	"marketdata\x1a\x1fgoogle/protobuf/timestamp.proto\"\x89\x01\n" +
 	"\x0fGetPriceRequest\x12\x16\n" +
-	"\x06symbol\x18\x01 \x01(\tR\x06symbol\"\x92\x01\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x127\n" +
+	"\vapi_version\x18\x02 \x01(\x0e2\x16.marketdata.ApiVersionR\n" +
+	"apiVersion\x12%\n" +
+	"\x0equote_currency\x18\x03 \x01(\tR\rquoteCurrency\"\x88\x02\n" +
 	"\x10GetPriceResponse\x12\x16\n" +
 	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x14\n" +
 	"\x05price\x18\x02 \x01(\x01R\x05price\x128\n" +
 	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x16\n" +
-	"\x06source\x18\x04 \x01(\tR\x06source\"]\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\x128\n" +
+	"\rprice_decimal\x18\x05 \x01(\v2\x13.marketdata.DecimalR\fpriceDecimal\x12:\n" +
+	"\n" +
+	"conversion\x18\x06 \x01(\v2\x1a.marketdata.ConversionInfoR\n" +
+	"conversion\"\xae\x03\n" +
+	"\x0eConversionInfo\x12#\n" +
+	"\rfrom_currency\x18\x01 \x01(\tR\ffromCurrency\x12\x1f\n" +
+	"\vto_currency\x18\x02 \x01(\tR\n" +
+	"toCurrency\x12\x1f\n" +
+	"\vrate_symbol\x18\x03 \x01(\tR\n" +
+	"rateSymbol\x12*\n" +
+	"\x11rate_symbol_price\x18\x04 \x01(\x01R\x0frateSymbolPrice\x12\x12\n" +
+	"\x04rate\x18\x05 \x01(\x01R\x04rate\x12%\n" +
+	"\x0eoriginal_price\x18\x06 \x01(\x01R\roriginalPrice\x12%\n" +
+	"\x0epivot_currency\x18\a \x01(\tR\rpivotCurrency\x12*\n" +
+	"\x11pivot_rate_symbol\x18\b \x01(\tR\x0fpivotRateSymbol\x125\n" +
+	"\x17pivot_rate_symbol_price\x18\t \x01(\x01R\x14pivotRateSymbolPrice\x12\x1b\n" +
+	"\ttick_size\x18\n" +
+	" \x01(\x01R\btickSize\x12'\n" +
+	"\x0fprice_precision\x18\v \x01(\x05R\x0epricePrecision\"5\n" +
+	"\aDecimal\x12\x14\n" +
+	"\x05units\x18\x01 \x01(\x03R\x05units\x12\x14\n" +
+	"\x05scale\x18\x02 \x01(\x05R\x05scale\"\x87\x05\n" +
 	"\x13StreamPricesRequest\x12\x18\n" +
 	"\asymbols\x18\x01 \x03(\tR\asymbols\x12,\n" +
-	"\x12update_interval_ms\x18\x02 \x01(\x05R\x10updateIntervalMs\"\xe3\x01\n" +
+	"\x12update_interval_ms\x18\x02 \x01(\x05R\x10updateIntervalMs\x12*\n" +
+	"\x11resume_session_id\x18\x03 \x01(\tR\x0fresumeSessionId\x12#\n" +
+	"\rlast_sequence\x18\x04 \x01(\x04R\flastSequence\x12C\n" +
+	"\x0fdelivery_policy\x18\x05 \x01(\x0e2\x1a.marketdata.DeliveryPolicyR\x0edeliveryPolicy\x12\x1e\n" +
+	"\vmax_rate_ms\x18\x06 \x01(\x05R\tmaxRateMs\x128\n" +
+	"\x18change_threshold_percent\x18\a \x01(\x01R\x16changeThresholdPercent\x12C\n" +
+	"\x0foverflow_policy\x18\b \x01(\x0e2\x1a.marketdata.OverflowPolicyR\x0eoverflowPolicy\x12,\n" +
+	"\x12update_interval_us\x18\t \x01(\x03R\x10updateIntervalUs\x12\x16\n" +
+	"\x06venues\x18\n" +
+	" \x03(\tR\x06venues\x12\"\n" +
+	"\fconsolidated\x18\v \x01(\bR\fconsolidated\x12)\n" +
+	"\x10reference_prices\x18\f \x01(\bR\x0freferencePrices\x127\n" +
+	"\vapi_version\x18\r \x01(\x0e2\x16.marketdata.ApiVersionR\n" +
+	"apiVersion\x12%\n" +
+	"\x0equote_currency\x18\x0e \x01(\tR\rquoteCurrency\"\xa7\n" +
+	"\n" +
 	"\vPriceUpdate\x12\x16\n" +
 	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x14\n" +
 	"\x05price\x18\x02 \x01(\x01R\x05price\x12\x16\n" +
@@ -1178,14 +5268,210 @@ const file_internal_proto_marketdata_proto_rawDesc = "" +
 	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x16\n" +
 	"\x06source\x18\x05 \x01(\tR\x06source\x12<\n" +
 	"\vchange_info\x18\x06 \x01(\v2\x1b.marketdata.PriceChangeInfoR\n" +
-	"changeInfo\"\xc2\x01\n" +
+	"changeInfo\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\a \x01(\tR\tsessionId\x12\x1a\n" +
+	"\bsequence\x18\b \x01(\x04R\bsequence\x12'\n" +
+	"\x0fsymbol_sequence\x18\t \x01(\x04R\x0esymbolSequence\x12\x1a\n" +
+	"\bsnapshot\x18\n" +
+	" \x01(\bR\bsnapshot\x12'\n" +
+	"\x0fconflated_count\x18\v \x01(\rR\x0econflatedCount\x12'\n" +
+	"\x05quote\x18\f \x01(\v2\x11.marketdata.QuoteR\x05quote\x124\n" +
+	"\n" +
+	"order_book\x18\r \x01(\v2\x15.marketdata.OrderBookR\torderBook\x12\x1f\n" +
+	"\vtrade_count\x18\x0e \x01(\rR\n" +
+	"tradeCount\x12@\n" +
+	"\x0etrading_status\x18\x0f \x01(\x0e2\x19.marketdata.TradingStatusR\rtradingStatus\x12-\n" +
+	"\x05event\x18\x10 \x01(\v2\x17.marketdata.MarketEventR\x05event\x12=\n" +
+	"\rtrading_phase\x18\x11 \x01(\x0e2\x18.marketdata.TradingPhaseR\ftradingPhase\x121\n" +
+	"\aauction\x18\x12 \x01(\v2\x17.marketdata.AuctionInfoR\aauction\x12\x14\n" +
+	"\x05venue\x18\x13 \x01(\tR\x05venue\x12E\n" +
+	"\x10reference_prices\x18\x14 \x03(\v2\x1a.marketdata.ReferencePriceR\x0freferencePrices\x127\n" +
+	"\tcomposite\x18\x15 \x01(\v2\x19.marketdata.CompositeInfoR\tcomposite\x128\n" +
+	"\rprice_decimal\x18\x16 \x01(\v2\x13.marketdata.DecimalR\fpriceDecimal\x12:\n" +
+	"\x0evolume_decimal\x18\x17 \x01(\v2\x13.marketdata.DecimalR\rvolumeDecimal\x127\n" +
+	"\tperpetual\x18\x18 \x01(\v2\x19.marketdata.PerpetualInfoR\tperpetual\x12.\n" +
+	"\x06future\x18\x19 \x01(\v2\x16.marketdata.FutureInfoR\x06future\x12+\n" +
+	"\x05cross\x18\x1a \x01(\v2\x15.marketdata.CrossInfoR\x05cross\x12(\n" +
+	"\x04bond\x18\x1b \x01(\v2\x14.marketdata.BondInfoR\x04bond\x12:\n" +
+	"\n" +
+	"conversion\x18\x1c \x01(\v2\x1a.marketdata.ConversionInfoR\n" +
+	"conversion\x12.\n" +
+	"\x06option\x18\x1d \x01(\v2\x16.marketdata.OptionInfoR\x06option\"\xe5\x03\n" +
+	"\n" +
+	"OptionInfo\x12\x16\n" +
+	"\x06series\x18\x01 \x01(\tR\x06series\x12+\n" +
+	"\x11underlying_symbol\x18\x02 \x01(\tR\x10underlyingSymbol\x12)\n" +
+	"\x10underlying_price\x18\x03 \x01(\x01R\x0funderlyingPrice\x122\n" +
+	"\x06expiry\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06expiry\x12*\n" +
+	"\x04type\x18\x05 \x01(\x0e2\x16.marketdata.OptionTypeR\x04type\x12\x16\n" +
+	"\x06strike\x18\x06 \x01(\x01R\x06strike\x12\x18\n" +
+	"\aforward\x18\a \x01(\x01R\aforward\x12%\n" +
+	"\x0eatm_volatility\x18\b \x01(\x01R\ratmVolatility\x12-\n" +
+	"\x12implied_volatility\x18\t \x01(\x01R\x11impliedVolatility\x120\n" +
+	"\x06greeks\x18\n" +
+	" \x01(\v2\x18.marketdata.OptionGreeksR\x06greeks\x12M\n" +
+	"\x18underlying_price_decimal\x18\v \x01(\v2\x13.marketdata.DecimalR\x16underlyingPriceDecimal\"\x86\x02\n" +
+	"\bBondInfo\x12%\n" +
+	"\x0ematurity_years\x18\x01 \x01(\x01R\rmaturityYears\x12%\n" +
+	"\x0ecoupon_percent\x18\x02 \x01(\x01R\rcouponPercent\x12#\n" +
+	"\ryield_percent\x18\x03 \x01(\x01R\fyieldPercent\x12*\n" +
+	"\x11zero_rate_percent\x18\x04 \x01(\x01R\x0fzeroRatePercent\x12+\n" +
+	"\x11modified_duration\x18\x05 \x01(\x01R\x10modifiedDuration\x12.\n" +
+	"\x05curve\x18\x06 \x01(\v2\x18.marketdata.CurveFactorsR\x05curve\"X\n" +
+	"\fCurveFactors\x12\x14\n" +
+	"\x05level\x18\x01 \x01(\x01R\x05level\x12\x14\n" +
+	"\x05slope\x18\x02 \x01(\x01R\x05slope\x12\x1c\n" +
+	"\tcurvature\x18\x03 \x01(\x01R\tcurvature\"\xb7\x04\n" +
+	"\tCrossInfo\x12%\n" +
+	"\x0epivot_currency\x18\x01 \x01(\tR\rpivotCurrency\x12,\n" +
+	"\x12base_factor_symbol\x18\x02 \x01(\tR\x10baseFactorSymbol\x12*\n" +
+	"\x11base_factor_price\x18\x03 \x01(\x01R\x0fbaseFactorPrice\x12.\n" +
+	"\x13quote_factor_symbol\x18\x04 \x01(\tR\x11quoteFactorSymbol\x12,\n" +
+	"\x12quote_factor_price\x18\x05 \x01(\x01R\x10quoteFactorPrice\x12-\n" +
+	"\x12triangulated_price\x18\x06 \x01(\x01R\x11triangulatedPrice\x12'\n" +
+	"\x0fdislocation_bps\x18\a \x01(\x01R\x0edislocationBps\x12Q\n" +
+	"\x1atriangulated_price_decimal\x18\b \x01(\v2\x13.marketdata.DecimalR\x18triangulatedPriceDecimal\x12N\n" +
+	"\x19base_factor_price_decimal\x18\t \x01(\v2\x13.marketdata.DecimalR\x16baseFactorPriceDecimal\x12P\n" +
+	"\x1aquote_factor_price_decimal\x18\n" +
+	" \x01(\v2\x13.marketdata.DecimalR\x17quoteFactorPriceDecimal\"\xf8\x03\n" +
+	"\n" +
+	"FutureInfo\x12+\n" +
+	"\x11underlying_symbol\x18\x01 \x01(\tR\x10underlyingSymbol\x12)\n" +
+	"\x10underlying_price\x18\x02 \x01(\x01R\x0funderlyingPrice\x122\n" +
+	"\x06expiry\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06expiry\x12$\n" +
+	"\x0edays_to_expiry\x18\x04 \x01(\x01R\fdaysToExpiry\x12\x1b\n" +
+	"\tbasis_bps\x18\x05 \x01(\x01R\bbasisBps\x128\n" +
+	"\x18annualized_basis_percent\x18\x06 \x01(\x01R\x16annualizedBasisPercent\x12\x18\n" +
+	"\asettled\x18\a \x01(\bR\asettled\x12)\n" +
+	"\x10settlement_price\x18\b \x01(\x01R\x0fsettlementPrice\x12M\n" +
+	"\x18underlying_price_decimal\x18\t \x01(\v2\x13.marketdata.DecimalR\x16underlyingPriceDecimal\x12M\n" +
+	"\x18settlement_price_decimal\x18\n" +
+	" \x01(\v2\x13.marketdata.DecimalR\x16settlementPriceDecimal\"\xb0\x04\n" +
+	"\rPerpetualInfo\x12!\n" +
+	"\findex_symbol\x18\x01 \x01(\tR\vindexSymbol\x12\x1f\n" +
+	"\vindex_price\x18\x02 \x01(\x01R\n" +
+	"indexPrice\x12\x1d\n" +
+	"\n" +
+	"mark_price\x18\x03 \x01(\x01R\tmarkPrice\x12\x1b\n" +
+	"\tbasis_bps\x18\x04 \x01(\x01R\bbasisBps\x12!\n" +
+	"\ffunding_rate\x18\x05 \x01(\x01R\vfundingRate\x12F\n" +
+	"\x11next_funding_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x0fnextFundingTime\x128\n" +
+	"\x18funding_interval_seconds\x18\a \x01(\x03R\x16fundingIntervalSeconds\x12*\n" +
+	"\x11last_funding_rate\x18\b \x01(\x01R\x0flastFundingRate\x12F\n" +
+	"\x11last_funding_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x0flastFundingTime\x12A\n" +
+	"\x12mark_price_decimal\x18\n" +
+	" \x01(\v2\x13.marketdata.DecimalR\x10markPriceDecimal\x12C\n" +
+	"\x13index_price_decimal\x18\v \x01(\v2\x13.marketdata.DecimalR\x11indexPriceDecimal\"Q\n" +
+	"\rCompositeInfo\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12,\n" +
+	"\x04legs\x18\x02 \x03(\v2\x18.marketdata.CompositeLegR\x04legs\"\x8e\x01\n" +
+	"\fCompositeLeg\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x16\n" +
+	"\x06weight\x18\x02 \x01(\x01R\x06weight\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x01R\x05price\x128\n" +
+	"\rprice_decimal\x18\x04 \x01(\v2\x13.marketdata.DecimalR\fpriceDecimal\"\xf6\x01\n" +
+	"\x0eReferencePrice\x122\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1e.marketdata.ReferencePriceTypeR\x04type\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\x01R\x05price\x12 \n" +
+	"\vmethodology\x18\x03 \x01(\tR\vmethodology\x12\"\n" +
+	"\fconstituents\x18\x04 \x03(\tR\fconstituents\x12\x1a\n" +
+	"\bexcluded\x18\x05 \x03(\tR\bexcluded\x128\n" +
+	"\rprice_decimal\x18\x06 \x01(\v2\x13.marketdata.DecimalR\fpriceDecimal\"l\n" +
+	"\x19GetReferencePricesRequest\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x127\n" +
+	"\vapi_version\x18\x02 \x01(\x0e2\x16.marketdata.ApiVersionR\n" +
+	"apiVersion\"\xc6\x01\n" +
+	"\x17ReferencePricesResponse\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x122\n" +
+	"\x06prices\x18\x02 \x03(\v2\x1a.marketdata.ReferencePriceR\x06prices\x12%\n" +
+	"\x04nbbo\x18\x03 \x01(\v2\x11.marketdata.QuoteR\x04nbbo\x128\n" +
+	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\xde\x02\n" +
+	"\vAuctionInfo\x12)\n" +
+	"\x10indicative_price\x18\x01 \x01(\x01R\x0findicativePrice\x12+\n" +
+	"\x11indicative_volume\x18\x02 \x01(\x01R\x10indicativeVolume\x12\x1c\n" +
+	"\timbalance\x18\x03 \x01(\x01R\timbalance\x129\n" +
+	"\n" +
+	"uncross_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tuncrossAt\x12M\n" +
+	"\x18indicative_price_decimal\x18\x05 \x01(\v2\x13.marketdata.DecimalR\x16indicativePriceDecimal\x12O\n" +
+	"\x19indicative_volume_decimal\x18\x06 \x01(\v2\x13.marketdata.DecimalR\x17indicativeVolumeDecimal\"\xed\x03\n" +
+	"\vMarketEvent\x12/\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1b.marketdata.MarketEventTypeR\x04type\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12'\n" +
+	"\x0freference_price\x18\x03 \x01(\x01R\x0ereferencePrice\x12\x19\n" +
+	"\bband_low\x18\x04 \x01(\x01R\abandLow\x12\x1b\n" +
+	"\tband_high\x18\x05 \x01(\x01R\bbandHigh\x127\n" +
+	"\tresume_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bresumeAt\x12.\n" +
+	"\x05phase\x18\a \x01(\x0e2\x18.marketdata.TradingPhaseR\x05phase\x12K\n" +
+	"\x17reference_price_decimal\x18\b \x01(\v2\x13.marketdata.DecimalR\x15referencePriceDecimal\x12=\n" +
+	"\x10band_low_decimal\x18\t \x01(\v2\x13.marketdata.DecimalR\x0ebandLowDecimal\x12?\n" +
+	"\x11band_high_decimal\x18\n" +
+	" \x01(\v2\x13.marketdata.DecimalR\x0fbandHighDecimal\"\xa4\x03\n" +
+	"\x05Quote\x12\x10\n" +
+	"\x03bid\x18\x01 \x01(\x01R\x03bid\x12\x10\n" +
+	"\x03ask\x18\x02 \x01(\x01R\x03ask\x12\x19\n" +
+	"\bbid_size\x18\x03 \x01(\x01R\abidSize\x12\x19\n" +
+	"\bask_size\x18\x04 \x01(\x01R\aaskSize\x12\x1d\n" +
+	"\n" +
+	"spread_bps\x18\x05 \x01(\x01R\tspreadBps\x12\x1b\n" +
+	"\tbid_venue\x18\x06 \x01(\tR\bbidVenue\x12\x1b\n" +
+	"\task_venue\x18\a \x01(\tR\baskVenue\x124\n" +
+	"\vbid_decimal\x18\b \x01(\v2\x13.marketdata.DecimalR\n" +
+	"bidDecimal\x124\n" +
+	"\vask_decimal\x18\t \x01(\v2\x13.marketdata.DecimalR\n" +
+	"askDecimal\x12=\n" +
+	"\x10bid_size_decimal\x18\n" +
+	" \x01(\v2\x13.marketdata.DecimalR\x0ebidSizeDecimal\x12=\n" +
+	"\x10ask_size_decimal\x18\v \x01(\v2\x13.marketdata.DecimalR\x0easkSizeDecimal\"k\n" +
+	"\tOrderBook\x12.\n" +
+	"\x04bids\x18\x01 \x03(\v2\x1a.marketdata.OrderBookLevelR\x04bids\x12.\n" +
+	"\x04asks\x18\x02 \x03(\v2\x1a.marketdata.OrderBookLevelR\x04asks\"\xac\x01\n" +
+	"\x0eOrderBookLevel\x12\x14\n" +
+	"\x05price\x18\x01 \x01(\x01R\x05price\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x01R\x04size\x128\n" +
+	"\rprice_decimal\x18\x03 \x01(\v2\x13.marketdata.DecimalR\fpriceDecimal\x126\n" +
+	"\fsize_decimal\x18\x04 \x01(\v2\x13.marketdata.DecimalR\vsizeDecimal\"\xb0\x01\n" +
+	"\x10PriceUpdateBatch\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x121\n" +
+	"\aupdates\x18\x02 \x03(\v2\x17.marketdata.PriceUpdateR\aupdates\x12%\n" +
+	"\x0efirst_sequence\x18\x03 \x01(\x04R\rfirstSequence\x12#\n" +
+	"\rlast_sequence\x18\x04 \x01(\x04R\flastSequence\"\xc2\x03\n" +
+	"\x13SubscriptionRequest\x126\n" +
+	"\x06action\x18\x01 \x01(\x0e2\x1e.marketdata.SubscriptionActionR\x06action\x12\x18\n" +
+	"\asymbols\x18\x02 \x03(\tR\asymbols\x12,\n" +
+	"\x12update_interval_ms\x18\x03 \x01(\x05R\x10updateIntervalMs\x12C\n" +
+	"\x0fdelivery_policy\x18\x04 \x01(\x0e2\x1a.marketdata.DeliveryPolicyR\x0edeliveryPolicy\x12\x1e\n" +
+	"\vmax_rate_ms\x18\x05 \x01(\x05R\tmaxRateMs\x128\n" +
+	"\x18change_threshold_percent\x18\x06 \x01(\x01R\x16changeThresholdPercent\x12,\n" +
+	"\x12update_interval_us\x18\a \x01(\x03R\x10updateIntervalUs\x127\n" +
+	"\vapi_version\x18\b \x01(\x0e2\x16.marketdata.ApiVersionR\n" +
+	"apiVersion\x12%\n" +
+	"\x0equote_currency\x18\t \x01(\tR\rquoteCurrency\"\x81\x01\n" +
+	"\x1aRecoverPriceUpdatesRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12#\n" +
+	"\rfrom_sequence\x18\x02 \x01(\x04R\ffromSequence\x12\x1f\n" +
+	"\vto_sequence\x18\x03 \x01(\x04R\n" +
+	"toSequence\"\xea\x01\n" +
+	"\x1bRecoverPriceUpdatesResponse\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x121\n" +
+	"\aupdates\x18\x02 \x03(\v2\x17.marketdata.PriceUpdateR\aupdates\x128\n" +
+	"\x18first_available_sequence\x18\x03 \x01(\x04R\x16firstAvailableSequence\x12#\n" +
+	"\rlast_sequence\x18\x04 \x01(\x04R\flastSequence\x12\x1a\n" +
+	"\bcomplete\x18\x05 \x01(\bR\bcomplete\"\xd6\x03\n" +
 	"\x0fPriceChangeInfo\x12#\n" +
 	"\rchange_amount\x18\x01 \x01(\x01R\fchangeAmount\x12+\n" +
 	"\x11change_percentage\x18\x02 \x01(\x01R\x10changePercentage\x12\x1d\n" +
 	"\n" +
 	"daily_high\x18\x03 \x01(\x01R\tdailyHigh\x12\x1b\n" +
 	"\tdaily_low\x18\x04 \x01(\x01R\bdailyLow\x12!\n" +
-	"\fdaily_volume\x18\x05 \x01(\x01R\vdailyVolume\"\xa4\x02\n" +
+	"\fdaily_volume\x18\x05 \x01(\x01R\vdailyVolume\x12G\n" +
+	"\x15change_amount_decimal\x18\x06 \x01(\v2\x13.marketdata.DecimalR\x13changeAmountDecimal\x12A\n" +
+	"\x12daily_high_decimal\x18\a \x01(\v2\x13.marketdata.DecimalR\x10dailyHighDecimal\x12?\n" +
+	"\x11daily_low_decimal\x18\b \x01(\v2\x13.marketdata.DecimalR\x0fdailyLowDecimal\x12E\n" +
+	"\x14daily_volume_decimal\x18\t \x01(\v2\x13.marketdata.DecimalR\x12dailyVolumeDecimal\"\xdd\x02\n" +
 	"\x11SimulationRequest\x12\x16\n" +
 	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x129\n" +
 	"\n" +
@@ -1194,7 +5480,9 @@ const file_internal_proto_marketdata_proto_rawDesc = "" +
 	"\x0fsimulation_type\x18\x04 \x01(\x0e2\x1a.marketdata.SimulationTypeR\x0esimulationType\x12@\n" +
 	"\n" +
 	"parameters\x18\x05 \x01(\v2 .marketdata.SimulationParametersR\n" +
-	"parameters\"\xa0\x02\n" +
+	"parameters\x127\n" +
+	"\vapi_version\x18\x06 \x01(\x0e2\x16.marketdata.ApiVersionR\n" +
+	"apiVersion\"\xa0\x02\n" +
 	"\x12SimulationResponse\x12\x16\n" +
 	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12?\n" +
 	"\x0fhistorical_data\x18\x02 \x03(\v2\x16.marketdata.PricePointR\x0ehistoricalData\x12=\n" +
@@ -1209,7 +5497,7 @@ const file_internal_proto_marketdata_proto_rawDesc = "" +
 	"parameters\x129\n" +
 	"\n" +
 	"start_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x12)\n" +
-	"\x10duration_minutes\x18\x05 \x01(\x05R\x0fdurationMinutes\"\xae\x01\n" +
+	"\x10duration_minutes\x18\x05 \x01(\x05R\x0fdurationMinutes\"\xca\x03\n" +
 	"\n" +
 	"PricePoint\x128\n" +
 	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x12\n" +
@@ -1217,7 +5505,14 @@ const file_internal_proto_marketdata_proto_rawDesc = "" +
 	"\x04high\x18\x03 \x01(\x01R\x04high\x12\x10\n" +
 	"\x03low\x18\x04 \x01(\x01R\x03low\x12\x14\n" +
 	"\x05close\x18\x05 \x01(\x01R\x05close\x12\x16\n" +
-	"\x06volume\x18\x06 \x01(\x01R\x06volume\"\x9e\x02\n" +
+	"\x06volume\x18\x06 \x01(\x01R\x06volume\x126\n" +
+	"\fopen_decimal\x18\a \x01(\v2\x13.marketdata.DecimalR\vopenDecimal\x126\n" +
+	"\fhigh_decimal\x18\b \x01(\v2\x13.marketdata.DecimalR\vhighDecimal\x124\n" +
+	"\vlow_decimal\x18\t \x01(\v2\x13.marketdata.DecimalR\n" +
+	"lowDecimal\x128\n" +
+	"\rclose_decimal\x18\n" +
+	" \x01(\v2\x13.marketdata.DecimalR\fcloseDecimal\x12:\n" +
+	"\x0evolume_decimal\x18\v \x01(\v2\x13.marketdata.DecimalR\rvolumeDecimal\"\x9e\x02\n" +
 	"\x12StatisticalMetrics\x127\n" +
 	"\x17correlation_coefficient\x18\x01 \x01(\x01R\x16correlationCoefficient\x123\n" +
 	"\x15volatility_similarity\x18\x02 \x01(\x01R\x14volatilitySimilarity\x12D\n" +
@@ -1231,12 +5526,124 @@ const file_internal_proto_marketdata_proto_rawDesc = "" +
 	"dataPoints\x12#\n" +
 	"\rinclude_noise\x18\x04 \x01(\bR\fincludeNoise\x12\x1f\n" +
 	"\vnoise_level\x18\x05 \x01(\x01R\n" +
-	"noiseLevel\"\xb3\x01\n" +
+	"noiseLevel\"\xef\x02\n" +
 	"\x12ScenarioParameters\x12\x1c\n" +
 	"\tintensity\x18\x01 \x01(\x01R\tintensity\x12'\n" +
 	"\x0fduration_factor\x18\x02 \x01(\x01R\x0edurationFactor\x12'\n" +
 	"\x0frecovery_factor\x18\x03 \x01(\x01R\x0erecoveryFactor\x12-\n" +
-	"\x12gradual_transition\x18\x04 \x01(\bR\x11gradualTransition\".\n" +
+	"\x12gradual_transition\x18\x04 \x01(\bR\x11gradualTransition\x12.\n" +
+	"\x13depeg_depth_percent\x18\x05 \x01(\x01R\x11depegDepthPercent\x124\n" +
+	"\x16depeg_duration_minutes\x18\x06 \x01(\x05R\x14depegDurationMinutes\x129\n" +
+	"\x16depeg_recovery_percent\x18\a \x01(\x01H\x00R\x14depegRecoveryPercent\x88\x01\x01B\x19\n" +
+	"\x17_depeg_recovery_percent\"\xab\x02\n" +
+	"\vTradeReport\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12)\n" +
+	"\x04side\x18\x02 \x01(\x0e2\x15.marketdata.TradeSideR\x04side\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x01R\bquantity\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\x01R\x05price\x12\x19\n" +
+	"\btrade_id\x18\x05 \x01(\tR\atradeId\x12\x16\n" +
+	"\x06source\x18\x06 \x01(\tR\x06source\x12;\n" +
+	"\vexecuted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"executedAt\x127\n" +
+	"\vapi_version\x18\b \x01(\x0e2\x16.marketdata.ApiVersionR\n" +
+	"apiVersion\"\xe1\x02\n" +
+	"\x13TradeReportResponse\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12!\n" +
+	"\fprice_before\x18\x02 \x01(\x01R\vpriceBefore\x12\x1f\n" +
+	"\vprice_after\x18\x03 \x01(\x01R\n" +
+	"priceAfter\x120\n" +
+	"\x14permanent_impact_bps\x18\x04 \x01(\x01R\x12permanentImpactBps\x120\n" +
+	"\x14temporary_impact_bps\x18\x05 \x01(\x01R\x12temporaryImpactBps\x12E\n" +
+	"\x14price_before_decimal\x18\x06 \x01(\v2\x13.marketdata.DecimalR\x12priceBeforeDecimal\x12C\n" +
+	"\x13price_after_decimal\x18\a \x01(\v2\x13.marketdata.DecimalR\x11priceAfterDecimal\"\xb1\x02\n" +
+	"\n" +
+	"Instrument\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x1f\n" +
+	"\vasset_class\x18\x02 \x01(\tR\n" +
+	"assetClass\x12#\n" +
+	"\rbase_currency\x18\x03 \x01(\tR\fbaseCurrency\x12%\n" +
+	"\x0equote_currency\x18\x04 \x01(\tR\rquoteCurrency\x12\x1b\n" +
+	"\ttick_size\x18\x05 \x01(\x01R\btickSize\x12\x19\n" +
+	"\blot_size\x18\x06 \x01(\x01R\alotSize\x12'\n" +
+	"\x0fprice_precision\x18\a \x01(\x05R\x0epricePrecision\x12=\n" +
+	"\rtrading_hours\x18\b \x01(\v2\x18.marketdata.TradingHoursR\ftradingHours\"\xef\x01\n" +
+	"\fTradingHours\x12\x1f\n" +
+	"\valways_open\x18\x01 \x01(\bR\n" +
+	"alwaysOpen\x12\x1b\n" +
+	"\ttime_zone\x18\x02 \x01(\tR\btimeZone\x12\x19\n" +
+	"\bpre_open\x18\x03 \x01(\tR\apreOpen\x12'\n" +
+	"\x0fopening_auction\x18\x04 \x01(\tR\x0eopeningAuction\x12\x1e\n" +
+	"\n" +
+	"continuous\x18\x05 \x01(\tR\n" +
+	"continuous\x12'\n" +
+	"\x0fclosing_auction\x18\x06 \x01(\tR\x0eclosingAuction\x12\x14\n" +
+	"\x05close\x18\a \x01(\tR\x05close\"`\n" +
+	"\x16ListInstrumentsRequest\x12\x1f\n" +
+	"\vasset_class\x18\x01 \x01(\tR\n" +
+	"assetClass\x12%\n" +
+	"\x0equote_currency\x18\x02 \x01(\tR\rquoteCurrency\"S\n" +
+	"\x17ListInstrumentsResponse\x128\n" +
+	"\vinstruments\x18\x01 \x03(\v2\x16.marketdata.InstrumentR\vinstruments\".\n" +
+	"\x14GetInstrumentRequest\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\"\x9c\x01\n" +
+	"\x15GetOptionChainRequest\x12\x16\n" +
+	"\x06series\x18\x01 \x01(\tR\x06series\x122\n" +
+	"\x06expiry\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x06expiry\x127\n" +
+	"\vapi_version\x18\x03 \x01(\x0e2\x16.marketdata.ApiVersionR\n" +
+	"apiVersion\"\x99\x01\n" +
+	"\x18StreamOptionChainRequest\x12\x16\n" +
+	"\x06series\x18\x01 \x01(\tR\x06series\x12,\n" +
+	"\x12update_interval_ms\x18\x02 \x01(\x05R\x10updateIntervalMs\x127\n" +
+	"\vapi_version\x18\x03 \x01(\x0e2\x16.marketdata.ApiVersionR\n" +
+	"apiVersion\"\xd8\x02\n" +
+	"\vOptionChain\x12\x16\n" +
+	"\x06series\x18\x01 \x01(\tR\x06series\x12+\n" +
+	"\x11underlying_symbol\x18\x02 \x01(\tR\x10underlyingSymbol\x12)\n" +
+	"\x10underlying_price\x18\x03 \x01(\x01R\x0funderlyingPrice\x128\n" +
+	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x124\n" +
+	"\bexpiries\x18\x05 \x03(\v2\x18.marketdata.OptionExpiryR\bexpiries\x12\x1a\n" +
+	"\bsequence\x18\x06 \x01(\x04R\bsequence\x12M\n" +
+	"\x18underlying_price_decimal\x18\a \x01(\v2\x13.marketdata.DecimalR\x16underlyingPriceDecimal\"\xb6\x01\n" +
+	"\fOptionExpiry\x122\n" +
+	"\x06expiry\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x06expiry\x12\x18\n" +
+	"\aforward\x18\x02 \x01(\x01R\aforward\x12%\n" +
+	"\x0eatm_volatility\x18\x03 \x01(\x01R\ratmVolatility\x121\n" +
+	"\aoptions\x18\x04 \x03(\v2\x17.marketdata.OptionQuoteR\aoptions\"\xa6\x03\n" +
+	"\vOptionQuote\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12*\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x16.marketdata.OptionTypeR\x04type\x12\x16\n" +
+	"\x06strike\x18\x03 \x01(\x01R\x06strike\x12\x10\n" +
+	"\x03bid\x18\x04 \x01(\x01R\x03bid\x12\x10\n" +
+	"\x03ask\x18\x05 \x01(\x01R\x03ask\x12\x12\n" +
+	"\x04mark\x18\x06 \x01(\x01R\x04mark\x12-\n" +
+	"\x12implied_volatility\x18\a \x01(\x01R\x11impliedVolatility\x120\n" +
+	"\x06greeks\x18\b \x01(\v2\x18.marketdata.OptionGreeksR\x06greeks\x124\n" +
+	"\vbid_decimal\x18\t \x01(\v2\x13.marketdata.DecimalR\n" +
+	"bidDecimal\x124\n" +
+	"\vask_decimal\x18\n" +
+	" \x01(\v2\x13.marketdata.DecimalR\n" +
+	"askDecimal\x126\n" +
+	"\fmark_decimal\x18\v \x01(\v2\x13.marketdata.DecimalR\vmarkDecimal\"v\n" +
+	"\fOptionGreeks\x12\x14\n" +
+	"\x05delta\x18\x01 \x01(\x01R\x05delta\x12\x14\n" +
+	"\x05gamma\x18\x02 \x01(\x01R\x05gamma\x12\x12\n" +
+	"\x04vega\x18\x03 \x01(\x01R\x04vega\x12\x14\n" +
+	"\x05theta\x18\x04 \x01(\x01R\x05theta\x12\x10\n" +
+	"\x03rho\x18\x05 \x01(\x01R\x03rho\"9\n" +
+	"\x14GetYieldCurveRequest\x12!\n" +
+	"\ftenors_years\x18\x01 \x03(\x01R\vtenorsYears\"\xee\x01\n" +
+	"\n" +
+	"YieldCurve\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x122\n" +
+	"\afactors\x18\x02 \x01(\v2\x18.marketdata.CurveFactorsR\afactors\x12!\n" +
+	"\flambda_years\x18\x03 \x01(\x01R\vlambdaYears\x123\n" +
+	"\x06points\x18\x04 \x03(\v2\x1b.marketdata.YieldCurvePointR\x06points\x128\n" +
+	"\ttimestamp\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\x87\x01\n" +
+	"\x0fYieldCurvePoint\x12\x1f\n" +
+	"\vtenor_years\x18\x01 \x01(\x01R\n" +
+	"tenorYears\x12*\n" +
+	"\x11zero_rate_percent\x18\x02 \x01(\x01R\x0fzeroRatePercent\x12'\n" +
+	"\x0fdiscount_factor\x18\x03 \x01(\x01R\x0ediscountFactor\".\n" +
 	"\x12HealthCheckRequest\x12\x18\n" +
 	"\aservice\x18\x01 \x01(\tR\aservice\"\x9f\x02\n" +
 	"\x13HealthCheckResponse\x120\n" +
@@ -1252,7 +5659,7 @@ const file_internal_proto_marketdata_proto_rawDesc = "" +
 	"\vMONTE_CARLO\x10\x01\x12\x13\n" +
 	"\x0fBROWNIAN_MOTION\x10\x02\x12\x12\n" +
 	"\x0eMEAN_REVERSION\x10\x03\x12\x13\n" +
-	"\x0fTREND_FOLLOWING\x10\x04*q\n" +
+	"\x0fTREND_FOLLOWING\x10\x04*\x9e\x01\n" +
 	"\fScenarioType\x12\t\n" +
 	"\x05RALLY\x10\x00\x12\t\n" +
 	"\x05CRASH\x10\x01\x12\x0e\n" +
@@ -1260,17 +5667,95 @@ const file_internal_proto_marketdata_proto_rawDesc = "" +
 	"DIVERGENCE\x10\x02\x12\x12\n" +
 	"\x0eMEAN_REVERTING\x10\x03\x12\x14\n" +
 	"\x10VOLATILITY_SPIKE\x10\x04\x12\x11\n" +
-	"\rCONSOLIDATION\x10\x05*N\n" +
+	"\rCONSOLIDATION\x10\x05\x12\x15\n" +
+	"\x11LIQUIDITY_DROUGHT\x10\x06\x12\x14\n" +
+	"\x10STABLECOIN_DEPEG\x10\a*r\n" +
+	"\x12SubscriptionAction\x12\x0f\n" +
+	"\vADD_SYMBOLS\x10\x00\x12\x12\n" +
+	"\x0eREMOVE_SYMBOLS\x10\x01\x12\x10\n" +
+	"\fSET_INTERVAL\x10\x02\x12\f\n" +
+	"\bSNAPSHOT\x10\x03\x12\x17\n" +
+	"\x13SET_DELIVERY_POLICY\x10\x04*>\n" +
+	"\x0eDeliveryPolicy\x12\x0e\n" +
+	"\n" +
+	"EVERY_TICK\x10\x00\x12\r\n" +
+	"\tCONFLATED\x10\x01\x12\r\n" +
+	"\tON_CHANGE\x10\x02*U\n" +
+	"\x0eOverflowPolicy\x12\x14\n" +
+	"\x10OVERFLOW_DEFAULT\x10\x00\x12\x0f\n" +
+	"\vDROP_OLDEST\x10\x01\x12\f\n" +
+	"\bCONFLATE\x10\x02\x12\x0e\n" +
+	"\n" +
+	"DISCONNECT\x10\x03*F\n" +
+	"\rTradingStatus\x12\v\n" +
+	"\aTRADING\x10\x00\x12\n" +
+	"\n" +
+	"\x06HALTED\x10\x01\x12\f\n" +
+	"\bLIMIT_UP\x10\x02\x12\x0e\n" +
+	"\n" +
+	"LIMIT_DOWN\x10\x03*\xab\x01\n" +
+	"\x0fMarketEventType\x12\x1c\n" +
+	"\x18MARKET_EVENT_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04HALT\x10\x01\x12\n" +
+	"\n" +
+	"\x06RESUME\x10\x02\x12\x11\n" +
+	"\rLIMIT_REACHED\x10\x03\x12\x12\n" +
+	"\x0eLIMIT_RELEASED\x10\x04\x12\x10\n" +
+	"\fPHASE_CHANGE\x10\x05\x12\v\n" +
+	"\aFUNDING\x10\x06\x12\x0e\n" +
+	"\n" +
+	"SETTLEMENT\x10\a\x12\x0e\n" +
+	"\n" +
+	"RATE_SHOCK\x10\b*b\n" +
+	"\fTradingPhase\x12\x0e\n" +
+	"\n" +
+	"CONTINUOUS\x10\x00\x12\f\n" +
+	"\bPRE_OPEN\x10\x01\x12\x13\n" +
+	"\x0fOPENING_AUCTION\x10\x02\x12\x13\n" +
+	"\x0fCLOSING_AUCTION\x10\x03\x12\n" +
+	"\n" +
+	"\x06CLOSED\x10\x04*T\n" +
+	"\x12ReferencePriceType\x12\x1f\n" +
+	"\x1bREFERENCE_PRICE_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04NBBO\x10\x01\x12\t\n" +
+	"\x05INDEX\x10\x02\x12\b\n" +
+	"\x04MARK\x10\x03*A\n" +
+	"\n" +
+	"ApiVersion\x12\x1b\n" +
+	"\x17API_VERSION_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06API_V1\x10\x01\x12\n" +
+	"\n" +
+	"\x06API_V2\x10\x02*<\n" +
+	"\n" +
+	"OptionType\x12\x1b\n" +
+	"\x17OPTION_TYPE_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04CALL\x10\x01\x12\a\n" +
+	"\x03PUT\x10\x02*:\n" +
+	"\tTradeSide\x12\x1a\n" +
+	"\x16TRADE_SIDE_UNSPECIFIED\x10\x00\x12\a\n" +
+	"\x03BUY\x10\x01\x12\b\n" +
+	"\x04SELL\x10\x02*N\n" +
 	"\fHealthStatus\x12\v\n" +
 	"\aUNKNOWN\x10\x00\x12\v\n" +
 	"\aSERVING\x10\x01\x12\x0f\n" +
 	"\vNOT_SERVING\x10\x02\x12\x13\n" +
-	"\x0fSERVICE_UNKNOWN\x10\x032\x95\x03\n" +
+	"\x0fSERVICE_UNKNOWN\x10\x032\xe0\t\n" +
 	"\x11MarketDataService\x12E\n" +
 	"\bGetPrice\x12\x1b.marketdata.GetPriceRequest\x1a\x1c.marketdata.GetPriceResponse\x12J\n" +
-	"\fStreamPrices\x12\x1f.marketdata.StreamPricesRequest\x1a\x17.marketdata.PriceUpdate0\x01\x12S\n" +
+	"\fStreamPrices\x12\x1f.marketdata.StreamPricesRequest\x1a\x17.marketdata.PriceUpdate0\x01\x12U\n" +
+	"\x12StreamPriceBatches\x12\x1f.marketdata.StreamPricesRequest\x1a\x1c.marketdata.PriceUpdateBatch0\x01\x12I\n" +
+	"\tSubscribe\x12\x1f.marketdata.SubscriptionRequest\x1a\x17.marketdata.PriceUpdate(\x010\x01\x12S\n" +
 	"\x12GenerateSimulation\x12\x1d.marketdata.SimulationRequest\x1a\x1e.marketdata.SimulationResponse\x12H\n" +
-	"\x0eStreamScenario\x12\x1b.marketdata.ScenarioRequest\x1a\x17.marketdata.PriceUpdate0\x01\x12N\n" +
+	"\x0eStreamScenario\x12\x1b.marketdata.ScenarioRequest\x1a\x17.marketdata.PriceUpdate0\x01\x12f\n" +
+	"\x13RecoverPriceUpdates\x12&.marketdata.RecoverPriceUpdatesRequest\x1a'.marketdata.RecoverPriceUpdatesResponse\x12G\n" +
+	"\vReportTrade\x12\x17.marketdata.TradeReport\x1a\x1f.marketdata.TradeReportResponse\x12`\n" +
+	"\x12GetReferencePrices\x12%.marketdata.GetReferencePricesRequest\x1a#.marketdata.ReferencePricesResponse\x12Z\n" +
+	"\x0fListInstruments\x12\".marketdata.ListInstrumentsRequest\x1a#.marketdata.ListInstrumentsResponse\x12I\n" +
+	"\rGetInstrument\x12 .marketdata.GetInstrumentRequest\x1a\x16.marketdata.Instrument\x12L\n" +
+	"\x0eGetOptionChain\x12!.marketdata.GetOptionChainRequest\x1a\x17.marketdata.OptionChain\x12T\n" +
+	"\x11StreamOptionChain\x12$.marketdata.StreamOptionChainRequest\x1a\x17.marketdata.OptionChain0\x01\x12I\n" +
+	"\rGetYieldCurve\x12 .marketdata.GetYieldCurveRequest\x1a\x16.marketdata.YieldCurve\x12N\n" +
 	"\vHealthCheck\x12\x1e.marketdata.HealthCheckRequest\x1a\x1f.marketdata.HealthCheckResponseBUZSgithub.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/protob\x06proto3"
 
 var (
@@ -1285,62 +5770,230 @@ func file_internal_proto_marketdata_proto_rawDescGZIP() []byte {
 	return file_internal_proto_marketdata_proto_rawDescData
 }
 
-var file_internal_proto_marketdata_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_internal_proto_marketdata_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_internal_proto_marketdata_proto_enumTypes = make([]protoimpl.EnumInfo, 13)
+var file_internal_proto_marketdata_proto_msgTypes = make([]protoimpl.MessageInfo, 53)
 var file_internal_proto_marketdata_proto_goTypes = []any{
-	(SimulationType)(0),          // 0: marketdata.SimulationType
-	(ScenarioType)(0),            // 1: marketdata.ScenarioType
-	(HealthStatus)(0),            // 2: marketdata.HealthStatus
-	(*GetPriceRequest)(nil),      // 3: marketdata.GetPriceRequest
-	(*GetPriceResponse)(nil),     // 4: marketdata.GetPriceResponse
-	(*StreamPricesRequest)(nil),  // 5: marketdata.StreamPricesRequest
-	(*PriceUpdate)(nil),          // 6: marketdata.PriceUpdate
-	(*PriceChangeInfo)(nil),      // 7: marketdata.PriceChangeInfo
-	(*SimulationRequest)(nil),    // 8: marketdata.SimulationRequest
-	(*SimulationResponse)(nil),   // 9: marketdata.SimulationResponse
-	(*ScenarioRequest)(nil),      // 10: marketdata.ScenarioRequest
-	(*PricePoint)(nil),           // 11: marketdata.PricePoint
-	(*StatisticalMetrics)(nil),   // 12: marketdata.StatisticalMetrics
-	(*SimulationParameters)(nil), // 13: marketdata.SimulationParameters
-	(*ScenarioParameters)(nil),   // 14: marketdata.ScenarioParameters
-	(*HealthCheckRequest)(nil),   // 15: marketdata.HealthCheckRequest
-	(*HealthCheckResponse)(nil),  // 16: marketdata.HealthCheckResponse
-	nil,                          // 17: marketdata.HealthCheckResponse.DetailsEntry
-	(*timestamp.Timestamp)(nil),  // 18: google.protobuf.Timestamp
+	(SimulationType)(0),                 // 0: marketdata.SimulationType
+	(ScenarioType)(0),                   // 1: marketdata.ScenarioType
+	(SubscriptionAction)(0),             // 2: marketdata.SubscriptionAction
+	(DeliveryPolicy)(0),                 // 3: marketdata.DeliveryPolicy
+	(OverflowPolicy)(0),                 // 4: marketdata.OverflowPolicy
+	(TradingStatus)(0),                  // 5: marketdata.TradingStatus
+	(MarketEventType)(0),                // 6: marketdata.MarketEventType
+	(TradingPhase)(0),                   // 7: marketdata.TradingPhase
+	(ReferencePriceType)(0),             // 8: marketdata.ReferencePriceType
+	(ApiVersion)(0),                     // 9: marketdata.ApiVersion
+	(OptionType)(0),                     // 10: marketdata.OptionType
+	(TradeSide)(0),                      // 11: marketdata.TradeSide
+	(HealthStatus)(0),                   // 12: marketdata.HealthStatus
+	(*GetPriceRequest)(nil),             // 13: marketdata.GetPriceRequest
+	(*GetPriceResponse)(nil),            // 14: marketdata.GetPriceResponse
+	(*ConversionInfo)(nil),              // 15: marketdata.ConversionInfo
+	(*Decimal)(nil),                     // 16: marketdata.Decimal
+	(*StreamPricesRequest)(nil),         // 17: marketdata.StreamPricesRequest
+	(*PriceUpdate)(nil),                 // 18: marketdata.PriceUpdate
+	(*OptionInfo)(nil),                  // 19: marketdata.OptionInfo
+	(*BondInfo)(nil),                    // 20: marketdata.BondInfo
+	(*CurveFactors)(nil),                // 21: marketdata.CurveFactors
+	(*CrossInfo)(nil),                   // 22: marketdata.CrossInfo
+	(*FutureInfo)(nil),                  // 23: marketdata.FutureInfo
+	(*PerpetualInfo)(nil),               // 24: marketdata.PerpetualInfo
+	(*CompositeInfo)(nil),               // 25: marketdata.CompositeInfo
+	(*CompositeLeg)(nil),                // 26: marketdata.CompositeLeg
+	(*ReferencePrice)(nil),              // 27: marketdata.ReferencePrice
+	(*GetReferencePricesRequest)(nil),   // 28: marketdata.GetReferencePricesRequest
+	(*ReferencePricesResponse)(nil),     // 29: marketdata.ReferencePricesResponse
+	(*AuctionInfo)(nil),                 // 30: marketdata.AuctionInfo
+	(*MarketEvent)(nil),                 // 31: marketdata.MarketEvent
+	(*Quote)(nil),                       // 32: marketdata.Quote
+	(*OrderBook)(nil),                   // 33: marketdata.OrderBook
+	(*OrderBookLevel)(nil),              // 34: marketdata.OrderBookLevel
+	(*PriceUpdateBatch)(nil),            // 35: marketdata.PriceUpdateBatch
+	(*SubscriptionRequest)(nil),         // 36: marketdata.SubscriptionRequest
+	(*RecoverPriceUpdatesRequest)(nil),  // 37: marketdata.RecoverPriceUpdatesRequest
+	(*RecoverPriceUpdatesResponse)(nil), // 38: marketdata.RecoverPriceUpdatesResponse
+	(*PriceChangeInfo)(nil),             // 39: marketdata.PriceChangeInfo
+	(*SimulationRequest)(nil),           // 40: marketdata.SimulationRequest
+	(*SimulationResponse)(nil),          // 41: marketdata.SimulationResponse
+	(*ScenarioRequest)(nil),             // 42: marketdata.ScenarioRequest
+	(*PricePoint)(nil),                  // 43: marketdata.PricePoint
+	(*StatisticalMetrics)(nil),          // 44: marketdata.StatisticalMetrics
+	(*SimulationParameters)(nil),        // 45: marketdata.SimulationParameters
+	(*ScenarioParameters)(nil),          // 46: marketdata.ScenarioParameters
+	(*TradeReport)(nil),                 // 47: marketdata.TradeReport
+	(*TradeReportResponse)(nil),         // 48: marketdata.TradeReportResponse
+	(*Instrument)(nil),                  // 49: marketdata.Instrument
+	(*TradingHours)(nil),                // 50: marketdata.TradingHours
+	(*ListInstrumentsRequest)(nil),      // 51: marketdata.ListInstrumentsRequest
+	(*ListInstrumentsResponse)(nil),     // 52: marketdata.ListInstrumentsResponse
+	(*GetInstrumentRequest)(nil),        // 53: marketdata.GetInstrumentRequest
+	(*GetOptionChainRequest)(nil),       // 54: marketdata.GetOptionChainRequest
+	(*StreamOptionChainRequest)(nil),    // 55: marketdata.StreamOptionChainRequest
+	(*OptionChain)(nil),                 // 56: marketdata.OptionChain
+	(*OptionExpiry)(nil),                // 57: marketdata.OptionExpiry
+	(*OptionQuote)(nil),                 // 58: marketdata.OptionQuote
+	(*OptionGreeks)(nil),                // 59: marketdata.OptionGreeks
+	(*GetYieldCurveRequest)(nil),        // 60: marketdata.GetYieldCurveRequest
+	(*YieldCurve)(nil),                  // 61: marketdata.YieldCurve
+	(*YieldCurvePoint)(nil),             // 62: marketdata.YieldCurvePoint
+	(*HealthCheckRequest)(nil),          // 63: marketdata.HealthCheckRequest
+	(*HealthCheckResponse)(nil),         // 64: marketdata.HealthCheckResponse
+	nil,                                 // 65: marketdata.HealthCheckResponse.DetailsEntry
+	(*timestamp.Timestamp)(nil),         // 66: google.protobuf.Timestamp
 }
 var file_internal_proto_marketdata_proto_depIdxs = []int32{
-	18, // 0: marketdata.GetPriceResponse.timestamp:type_name -> google.protobuf.Timestamp
-	18, // 1: marketdata.PriceUpdate.timestamp:type_name -> google.protobuf.Timestamp
-	7,  // 2: marketdata.PriceUpdate.change_info:type_name -> marketdata.PriceChangeInfo
-	18, // 3: marketdata.SimulationRequest.start_time:type_name -> google.protobuf.Timestamp
-	18, // 4: marketdata.SimulationRequest.end_time:type_name -> google.protobuf.Timestamp
-	0,  // 5: marketdata.SimulationRequest.simulation_type:type_name -> marketdata.SimulationType
-	13, // 6: marketdata.SimulationRequest.parameters:type_name -> marketdata.SimulationParameters
-	11, // 7: marketdata.SimulationResponse.historical_data:type_name -> marketdata.PricePoint
-	11, // 8: marketdata.SimulationResponse.simulated_data:type_name -> marketdata.PricePoint
-	12, // 9: marketdata.SimulationResponse.similarity_metrics:type_name -> marketdata.StatisticalMetrics
-	1,  // 10: marketdata.ScenarioRequest.scenario_type:type_name -> marketdata.ScenarioType
-	14, // 11: marketdata.ScenarioRequest.parameters:type_name -> marketdata.ScenarioParameters
-	18, // 12: marketdata.ScenarioRequest.start_time:type_name -> google.protobuf.Timestamp
-	18, // 13: marketdata.PricePoint.timestamp:type_name -> google.protobuf.Timestamp
-	2,  // 14: marketdata.HealthCheckResponse.status:type_name -> marketdata.HealthStatus
-	18, // 15: marketdata.HealthCheckResponse.timestamp:type_name -> google.protobuf.Timestamp
-	17, // 16: marketdata.HealthCheckResponse.details:type_name -> marketdata.HealthCheckResponse.DetailsEntry
-	3,  // 17: marketdata.MarketDataService.GetPrice:input_type -> marketdata.GetPriceRequest
-	5,  // 18: marketdata.MarketDataService.StreamPrices:input_type -> marketdata.StreamPricesRequest
-	8,  // 19: marketdata.MarketDataService.GenerateSimulation:input_type -> marketdata.SimulationRequest
-	10, // 20: marketdata.MarketDataService.StreamScenario:input_type -> marketdata.ScenarioRequest
-	15, // 21: marketdata.MarketDataService.HealthCheck:input_type -> marketdata.HealthCheckRequest
-	4,  // 22: marketdata.MarketDataService.GetPrice:output_type -> marketdata.GetPriceResponse
-	6,  // 23: marketdata.MarketDataService.StreamPrices:output_type -> marketdata.PriceUpdate
-	9,  // 24: marketdata.MarketDataService.GenerateSimulation:output_type -> marketdata.SimulationResponse
-	6,  // 25: marketdata.MarketDataService.StreamScenario:output_type -> marketdata.PriceUpdate
-	16, // 26: marketdata.MarketDataService.HealthCheck:output_type -> marketdata.HealthCheckResponse
-	22, // [22:27] is the sub-list for method output_type
-	17, // [17:22] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	9,   // 0: marketdata.GetPriceRequest.api_version:type_name -> marketdata.ApiVersion
+	66,  // 1: marketdata.GetPriceResponse.timestamp:type_name -> google.protobuf.Timestamp
+	16,  // 2: marketdata.GetPriceResponse.price_decimal:type_name -> marketdata.Decimal
+	15,  // 3: marketdata.GetPriceResponse.conversion:type_name -> marketdata.ConversionInfo
+	3,   // 4: marketdata.StreamPricesRequest.delivery_policy:type_name -> marketdata.DeliveryPolicy
+	4,   // 5: marketdata.StreamPricesRequest.overflow_policy:type_name -> marketdata.OverflowPolicy
+	9,   // 6: marketdata.StreamPricesRequest.api_version:type_name -> marketdata.ApiVersion
+	66,  // 7: marketdata.PriceUpdate.timestamp:type_name -> google.protobuf.Timestamp
+	39,  // 8: marketdata.PriceUpdate.change_info:type_name -> marketdata.PriceChangeInfo
+	32,  // 9: marketdata.PriceUpdate.quote:type_name -> marketdata.Quote
+	33,  // 10: marketdata.PriceUpdate.order_book:type_name -> marketdata.OrderBook
+	5,   // 11: marketdata.PriceUpdate.trading_status:type_name -> marketdata.TradingStatus
+	31,  // 12: marketdata.PriceUpdate.event:type_name -> marketdata.MarketEvent
+	7,   // 13: marketdata.PriceUpdate.trading_phase:type_name -> marketdata.TradingPhase
+	30,  // 14: marketdata.PriceUpdate.auction:type_name -> marketdata.AuctionInfo
+	27,  // 15: marketdata.PriceUpdate.reference_prices:type_name -> marketdata.ReferencePrice
+	25,  // 16: marketdata.PriceUpdate.composite:type_name -> marketdata.CompositeInfo
+	16,  // 17: marketdata.PriceUpdate.price_decimal:type_name -> marketdata.Decimal
+	16,  // 18: marketdata.PriceUpdate.volume_decimal:type_name -> marketdata.Decimal
+	24,  // 19: marketdata.PriceUpdate.perpetual:type_name -> marketdata.PerpetualInfo
+	23,  // 20: marketdata.PriceUpdate.future:type_name -> marketdata.FutureInfo
+	22,  // 21: marketdata.PriceUpdate.cross:type_name -> marketdata.CrossInfo
+	20,  // 22: marketdata.PriceUpdate.bond:type_name -> marketdata.BondInfo
+	15,  // 23: marketdata.PriceUpdate.conversion:type_name -> marketdata.ConversionInfo
+	19,  // 24: marketdata.PriceUpdate.option:type_name -> marketdata.OptionInfo
+	66,  // 25: marketdata.OptionInfo.expiry:type_name -> google.protobuf.Timestamp
+	10,  // 26: marketdata.OptionInfo.type:type_name -> marketdata.OptionType
+	59,  // 27: marketdata.OptionInfo.greeks:type_name -> marketdata.OptionGreeks
+	16,  // 28: marketdata.OptionInfo.underlying_price_decimal:type_name -> marketdata.Decimal
+	21,  // 29: marketdata.BondInfo.curve:type_name -> marketdata.CurveFactors
+	16,  // 30: marketdata.CrossInfo.triangulated_price_decimal:type_name -> marketdata.Decimal
+	16,  // 31: marketdata.CrossInfo.base_factor_price_decimal:type_name -> marketdata.Decimal
+	16,  // 32: marketdata.CrossInfo.quote_factor_price_decimal:type_name -> marketdata.Decimal
+	66,  // 33: marketdata.FutureInfo.expiry:type_name -> google.protobuf.Timestamp
+	16,  // 34: marketdata.FutureInfo.underlying_price_decimal:type_name -> marketdata.Decimal
+	16,  // 35: marketdata.FutureInfo.settlement_price_decimal:type_name -> marketdata.Decimal
+	66,  // 36: marketdata.PerpetualInfo.next_funding_time:type_name -> google.protobuf.Timestamp
+	66,  // 37: marketdata.PerpetualInfo.last_funding_time:type_name -> google.protobuf.Timestamp
+	16,  // 38: marketdata.PerpetualInfo.mark_price_decimal:type_name -> marketdata.Decimal
+	16,  // 39: marketdata.PerpetualInfo.index_price_decimal:type_name -> marketdata.Decimal
+	26,  // 40: marketdata.CompositeInfo.legs:type_name -> marketdata.CompositeLeg
+	16,  // 41: marketdata.CompositeLeg.price_decimal:type_name -> marketdata.Decimal
+	8,   // 42: marketdata.ReferencePrice.type:type_name -> marketdata.ReferencePriceType
+	16,  // 43: marketdata.ReferencePrice.price_decimal:type_name -> marketdata.Decimal
+	9,   // 44: marketdata.GetReferencePricesRequest.api_version:type_name -> marketdata.ApiVersion
+	27,  // 45: marketdata.ReferencePricesResponse.prices:type_name -> marketdata.ReferencePrice
+	32,  // 46: marketdata.ReferencePricesResponse.nbbo:type_name -> marketdata.Quote
+	66,  // 47: marketdata.ReferencePricesResponse.timestamp:type_name -> google.protobuf.Timestamp
+	66,  // 48: marketdata.AuctionInfo.uncross_at:type_name -> google.protobuf.Timestamp
+	16,  // 49: marketdata.AuctionInfo.indicative_price_decimal:type_name -> marketdata.Decimal
+	16,  // 50: marketdata.AuctionInfo.indicative_volume_decimal:type_name -> marketdata.Decimal
+	6,   // 51: marketdata.MarketEvent.type:type_name -> marketdata.MarketEventType
+	66,  // 52: marketdata.MarketEvent.resume_at:type_name -> google.protobuf.Timestamp
+	7,   // 53: marketdata.MarketEvent.phase:type_name -> marketdata.TradingPhase
+	16,  // 54: marketdata.MarketEvent.reference_price_decimal:type_name -> marketdata.Decimal
+	16,  // 55: marketdata.MarketEvent.band_low_decimal:type_name -> marketdata.Decimal
+	16,  // 56: marketdata.MarketEvent.band_high_decimal:type_name -> marketdata.Decimal
+	16,  // 57: marketdata.Quote.bid_decimal:type_name -> marketdata.Decimal
+	16,  // 58: marketdata.Quote.ask_decimal:type_name -> marketdata.Decimal
+	16,  // 59: marketdata.Quote.bid_size_decimal:type_name -> marketdata.Decimal
+	16,  // 60: marketdata.Quote.ask_size_decimal:type_name -> marketdata.Decimal
+	34,  // 61: marketdata.OrderBook.bids:type_name -> marketdata.OrderBookLevel
+	34,  // 62: marketdata.OrderBook.asks:type_name -> marketdata.OrderBookLevel
+	16,  // 63: marketdata.OrderBookLevel.price_decimal:type_name -> marketdata.Decimal
+	16,  // 64: marketdata.OrderBookLevel.size_decimal:type_name -> marketdata.Decimal
+	18,  // 65: marketdata.PriceUpdateBatch.updates:type_name -> marketdata.PriceUpdate
+	2,   // 66: marketdata.SubscriptionRequest.action:type_name -> marketdata.SubscriptionAction
+	3,   // 67: marketdata.SubscriptionRequest.delivery_policy:type_name -> marketdata.DeliveryPolicy
+	9,   // 68: marketdata.SubscriptionRequest.api_version:type_name -> marketdata.ApiVersion
+	18,  // 69: marketdata.RecoverPriceUpdatesResponse.updates:type_name -> marketdata.PriceUpdate
+	16,  // 70: marketdata.PriceChangeInfo.change_amount_decimal:type_name -> marketdata.Decimal
+	16,  // 71: marketdata.PriceChangeInfo.daily_high_decimal:type_name -> marketdata.Decimal
+	16,  // 72: marketdata.PriceChangeInfo.daily_low_decimal:type_name -> marketdata.Decimal
+	16,  // 73: marketdata.PriceChangeInfo.daily_volume_decimal:type_name -> marketdata.Decimal
+	66,  // 74: marketdata.SimulationRequest.start_time:type_name -> google.protobuf.Timestamp
+	66,  // 75: marketdata.SimulationRequest.end_time:type_name -> google.protobuf.Timestamp
+	0,   // 76: marketdata.SimulationRequest.simulation_type:type_name -> marketdata.SimulationType
+	45,  // 77: marketdata.SimulationRequest.parameters:type_name -> marketdata.SimulationParameters
+	9,   // 78: marketdata.SimulationRequest.api_version:type_name -> marketdata.ApiVersion
+	43,  // 79: marketdata.SimulationResponse.historical_data:type_name -> marketdata.PricePoint
+	43,  // 80: marketdata.SimulationResponse.simulated_data:type_name -> marketdata.PricePoint
+	44,  // 81: marketdata.SimulationResponse.similarity_metrics:type_name -> marketdata.StatisticalMetrics
+	1,   // 82: marketdata.ScenarioRequest.scenario_type:type_name -> marketdata.ScenarioType
+	46,  // 83: marketdata.ScenarioRequest.parameters:type_name -> marketdata.ScenarioParameters
+	66,  // 84: marketdata.ScenarioRequest.start_time:type_name -> google.protobuf.Timestamp
+	66,  // 85: marketdata.PricePoint.timestamp:type_name -> google.protobuf.Timestamp
+	16,  // 86: marketdata.PricePoint.open_decimal:type_name -> marketdata.Decimal
+	16,  // 87: marketdata.PricePoint.high_decimal:type_name -> marketdata.Decimal
+	16,  // 88: marketdata.PricePoint.low_decimal:type_name -> marketdata.Decimal
+	16,  // 89: marketdata.PricePoint.close_decimal:type_name -> marketdata.Decimal
+	16,  // 90: marketdata.PricePoint.volume_decimal:type_name -> marketdata.Decimal
+	11,  // 91: marketdata.TradeReport.side:type_name -> marketdata.TradeSide
+	66,  // 92: marketdata.TradeReport.executed_at:type_name -> google.protobuf.Timestamp
+	9,   // 93: marketdata.TradeReport.api_version:type_name -> marketdata.ApiVersion
+	16,  // 94: marketdata.TradeReportResponse.price_before_decimal:type_name -> marketdata.Decimal
+	16,  // 95: marketdata.TradeReportResponse.price_after_decimal:type_name -> marketdata.Decimal
+	50,  // 96: marketdata.Instrument.trading_hours:type_name -> marketdata.TradingHours
+	49,  // 97: marketdata.ListInstrumentsResponse.instruments:type_name -> marketdata.Instrument
+	66,  // 98: marketdata.GetOptionChainRequest.expiry:type_name -> google.protobuf.Timestamp
+	9,   // 99: marketdata.GetOptionChainRequest.api_version:type_name -> marketdata.ApiVersion
+	9,   // 100: marketdata.StreamOptionChainRequest.api_version:type_name -> marketdata.ApiVersion
+	66,  // 101: marketdata.OptionChain.timestamp:type_name -> google.protobuf.Timestamp
+	57,  // 102: marketdata.OptionChain.expiries:type_name -> marketdata.OptionExpiry
+	16,  // 103: marketdata.OptionChain.underlying_price_decimal:type_name -> marketdata.Decimal
+	66,  // 104: marketdata.OptionExpiry.expiry:type_name -> google.protobuf.Timestamp
+	58,  // 105: marketdata.OptionExpiry.options:type_name -> marketdata.OptionQuote
+	10,  // 106: marketdata.OptionQuote.type:type_name -> marketdata.OptionType
+	59,  // 107: marketdata.OptionQuote.greeks:type_name -> marketdata.OptionGreeks
+	16,  // 108: marketdata.OptionQuote.bid_decimal:type_name -> marketdata.Decimal
+	16,  // 109: marketdata.OptionQuote.ask_decimal:type_name -> marketdata.Decimal
+	16,  // 110: marketdata.OptionQuote.mark_decimal:type_name -> marketdata.Decimal
+	21,  // 111: marketdata.YieldCurve.factors:type_name -> marketdata.CurveFactors
+	62,  // 112: marketdata.YieldCurve.points:type_name -> marketdata.YieldCurvePoint
+	66,  // 113: marketdata.YieldCurve.timestamp:type_name -> google.protobuf.Timestamp
+	12,  // 114: marketdata.HealthCheckResponse.status:type_name -> marketdata.HealthStatus
+	66,  // 115: marketdata.HealthCheckResponse.timestamp:type_name -> google.protobuf.Timestamp
+	65,  // 116: marketdata.HealthCheckResponse.details:type_name -> marketdata.HealthCheckResponse.DetailsEntry
+	13,  // 117: marketdata.MarketDataService.GetPrice:input_type -> marketdata.GetPriceRequest
+	17,  // 118: marketdata.MarketDataService.StreamPrices:input_type -> marketdata.StreamPricesRequest
+	17,  // 119: marketdata.MarketDataService.StreamPriceBatches:input_type -> marketdata.StreamPricesRequest
+	36,  // 120: marketdata.MarketDataService.Subscribe:input_type -> marketdata.SubscriptionRequest
+	40,  // 121: marketdata.MarketDataService.GenerateSimulation:input_type -> marketdata.SimulationRequest
+	42,  // 122: marketdata.MarketDataService.StreamScenario:input_type -> marketdata.ScenarioRequest
+	37,  // 123: marketdata.MarketDataService.RecoverPriceUpdates:input_type -> marketdata.RecoverPriceUpdatesRequest
+	47,  // 124: marketdata.MarketDataService.ReportTrade:input_type -> marketdata.TradeReport
+	28,  // 125: marketdata.MarketDataService.GetReferencePrices:input_type -> marketdata.GetReferencePricesRequest
+	51,  // 126: marketdata.MarketDataService.ListInstruments:input_type -> marketdata.ListInstrumentsRequest
+	53,  // 127: marketdata.MarketDataService.GetInstrument:input_type -> marketdata.GetInstrumentRequest
+	54,  // 128: marketdata.MarketDataService.GetOptionChain:input_type -> marketdata.GetOptionChainRequest
+	55,  // 129: marketdata.MarketDataService.StreamOptionChain:input_type -> marketdata.StreamOptionChainRequest
+	60,  // 130: marketdata.MarketDataService.GetYieldCurve:input_type -> marketdata.GetYieldCurveRequest
+	63,  // 131: marketdata.MarketDataService.HealthCheck:input_type -> marketdata.HealthCheckRequest
+	14,  // 132: marketdata.MarketDataService.GetPrice:output_type -> marketdata.GetPriceResponse
+	18,  // 133: marketdata.MarketDataService.StreamPrices:output_type -> marketdata.PriceUpdate
+	35,  // 134: marketdata.MarketDataService.StreamPriceBatches:output_type -> marketdata.PriceUpdateBatch
+	18,  // 135: marketdata.MarketDataService.Subscribe:output_type -> marketdata.PriceUpdate
+	41,  // 136: marketdata.MarketDataService.GenerateSimulation:output_type -> marketdata.SimulationResponse
+	18,  // 137: marketdata.MarketDataService.StreamScenario:output_type -> marketdata.PriceUpdate
+	38,  // 138: marketdata.MarketDataService.RecoverPriceUpdates:output_type -> marketdata.RecoverPriceUpdatesResponse
+	48,  // 139: marketdata.MarketDataService.ReportTrade:output_type -> marketdata.TradeReportResponse
+	29,  // 140: marketdata.MarketDataService.GetReferencePrices:output_type -> marketdata.ReferencePricesResponse
+	52,  // 141: marketdata.MarketDataService.ListInstruments:output_type -> marketdata.ListInstrumentsResponse
+	49,  // 142: marketdata.MarketDataService.GetInstrument:output_type -> marketdata.Instrument
+	56,  // 143: marketdata.MarketDataService.GetOptionChain:output_type -> marketdata.OptionChain
+	56,  // 144: marketdata.MarketDataService.StreamOptionChain:output_type -> marketdata.OptionChain
+	61,  // 145: marketdata.MarketDataService.GetYieldCurve:output_type -> marketdata.YieldCurve
+	64,  // 146: marketdata.MarketDataService.HealthCheck:output_type -> marketdata.HealthCheckResponse
+	132, // [132:147] is the sub-list for method output_type
+	117, // [117:132] is the sub-list for method input_type
+	117, // [117:117] is the sub-list for extension type_name
+	117, // [117:117] is the sub-list for extension extendee
+	0,   // [0:117] is the sub-list for field type_name
 }
 
 func init() { file_internal_proto_marketdata_proto_init() }
@@ -1348,13 +6001,14 @@ func file_internal_proto_marketdata_proto_init() {
 	if File_internal_proto_marketdata_proto != nil {
 		return
 	}
+	file_internal_proto_marketdata_proto_msgTypes[33].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_proto_marketdata_proto_rawDesc), len(file_internal_proto_marketdata_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   15,
+			NumEnums:      13,
+			NumMessages:   53,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,11 +19,21 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MarketDataService_GetPrice_FullMethodName           = "/marketdata.MarketDataService/GetPrice"
-	MarketDataService_StreamPrices_FullMethodName       = "/marketdata.MarketDataService/StreamPrices"
-	MarketDataService_GenerateSimulation_FullMethodName = "/marketdata.MarketDataService/GenerateSimulation"
-	MarketDataService_StreamScenario_FullMethodName     = "/marketdata.MarketDataService/StreamScenario"
-	MarketDataService_HealthCheck_FullMethodName        = "/marketdata.MarketDataService/HealthCheck"
+	MarketDataService_GetPrice_FullMethodName            = "/marketdata.MarketDataService/GetPrice"
+	MarketDataService_StreamPrices_FullMethodName        = "/marketdata.MarketDataService/StreamPrices"
+	MarketDataService_StreamPriceBatches_FullMethodName  = "/marketdata.MarketDataService/StreamPriceBatches"
+	MarketDataService_Subscribe_FullMethodName           = "/marketdata.MarketDataService/Subscribe"
+	MarketDataService_GenerateSimulation_FullMethodName  = "/marketdata.MarketDataService/GenerateSimulation"
+	MarketDataService_StreamScenario_FullMethodName      = "/marketdata.MarketDataService/StreamScenario"
+	MarketDataService_RecoverPriceUpdates_FullMethodName = "/marketdata.MarketDataService/RecoverPriceUpdates"
+	MarketDataService_ReportTrade_FullMethodName         = "/marketdata.MarketDataService/ReportTrade"
+	MarketDataService_GetReferencePrices_FullMethodName  = "/marketdata.MarketDataService/GetReferencePrices"
+	MarketDataService_ListInstruments_FullMethodName     = "/marketdata.MarketDataService/ListInstruments"
+	MarketDataService_GetInstrument_FullMethodName       = "/marketdata.MarketDataService/GetInstrument"
+	MarketDataService_GetOptionChain_FullMethodName      = "/marketdata.MarketDataService/GetOptionChain"
+	MarketDataService_StreamOptionChain_FullMethodName   = "/marketdata.MarketDataService/StreamOptionChain"
+	MarketDataService_GetYieldCurve_FullMethodName       = "/marketdata.MarketDataService/GetYieldCurve"
+	MarketDataService_HealthCheck_FullMethodName         = "/marketdata.MarketDataService/HealthCheck"
 )
 
 // MarketDataServiceClient is the client API for MarketDataService service.
@@ -36,10 +46,29 @@ type MarketDataServiceClient interface {
 	GetPrice(ctx context.Context, in *GetPriceRequest, opts ...grpc.CallOption) (*GetPriceResponse, error)
 	// Subscribe to real-time price stream
 	StreamPrices(ctx context.Context, in *StreamPricesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PriceUpdate], error)
+	// Same as StreamPrices, with the updates of a tick delivered in one message
+	StreamPriceBatches(ctx context.Context, in *StreamPricesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PriceUpdateBatch], error)
+	// Manage a price subscription mid-stream (add/remove symbols, change interval, snapshots)
+	Subscribe(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[SubscriptionRequest, PriceUpdate], error)
 	// Generate simulated market data based on real data
 	GenerateSimulation(ctx context.Context, in *SimulationRequest, opts ...grpc.CallOption) (*SimulationResponse, error)
 	// Stream simulated scenarios (rally, crash, divergence, etc.)
 	StreamScenario(ctx context.Context, in *ScenarioRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PriceUpdate], error)
+	// Recover a range of stream updates from the session's replay buffer
+	RecoverPriceUpdates(ctx context.Context, in *RecoverPriceUpdatesRequest, opts ...grpc.CallOption) (*RecoverPriceUpdatesResponse, error)
+	// Report an executed trade so its market impact moves the simulated price
+	ReportTrade(ctx context.Context, in *TradeReport, opts ...grpc.CallOption) (*TradeReportResponse, error)
+	// Reference prices (NBBO, volume-weighted index, mark) computed across venues
+	GetReferencePrices(ctx context.Context, in *GetReferencePricesRequest, opts ...grpc.CallOption) (*ReferencePricesResponse, error)
+	// Instrument reference data; unknown symbols are NOT_FOUND here and on every price RPC
+	ListInstruments(ctx context.Context, in *ListInstrumentsRequest, opts ...grpc.CallOption) (*ListInstrumentsResponse, error)
+	GetInstrument(ctx context.Context, in *GetInstrumentRequest, opts ...grpc.CallOption) (*Instrument, error)
+	// Listed options chains priced from the implied volatility surface, as a
+	// snapshot or re-priced as the underlying moves
+	GetOptionChain(ctx context.Context, in *GetOptionChainRequest, opts ...grpc.CallOption) (*OptionChain, error)
+	StreamOptionChain(ctx context.Context, in *StreamOptionChainRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[OptionChain], error)
+	// Snapshot of the shared yield curve, shocks included; bonds priced off it are instruments
+	GetYieldCurve(ctx context.Context, in *GetYieldCurveRequest, opts ...grpc.CallOption) (*YieldCurve, error)
 	// Health check
 	HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error)
 }
@@ -81,6 +110,38 @@ func (c *marketDataServiceClient) StreamPrices(ctx context.Context, in *StreamPr
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type MarketDataService_StreamPricesClient = grpc.ServerStreamingClient[PriceUpdate]
 
+func (c *marketDataServiceClient) StreamPriceBatches(ctx context.Context, in *StreamPricesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PriceUpdateBatch], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MarketDataService_ServiceDesc.Streams[1], MarketDataService_StreamPriceBatches_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamPricesRequest, PriceUpdateBatch]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MarketDataService_StreamPriceBatchesClient = grpc.ServerStreamingClient[PriceUpdateBatch]
+
+func (c *marketDataServiceClient) Subscribe(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[SubscriptionRequest, PriceUpdate], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MarketDataService_ServiceDesc.Streams[2], MarketDataService_Subscribe_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscriptionRequest, PriceUpdate]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MarketDataService_SubscribeClient = grpc.BidiStreamingClient[SubscriptionRequest, PriceUpdate]
+
 func (c *marketDataServiceClient) GenerateSimulation(ctx context.Context, in *SimulationRequest, opts ...grpc.CallOption) (*SimulationResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SimulationResponse)
@@ -93,7 +154,7 @@ func (c *marketDataServiceClient) GenerateSimulation(ctx context.Context, in *Si
 
 func (c *marketDataServiceClient) StreamScenario(ctx context.Context, in *ScenarioRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PriceUpdate], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &MarketDataService_ServiceDesc.Streams[1], MarketDataService_StreamScenario_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &MarketDataService_ServiceDesc.Streams[3], MarketDataService_StreamScenario_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -110,6 +171,95 @@ func (c *marketDataServiceClient) StreamScenario(ctx context.Context, in *Scenar
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type MarketDataService_StreamScenarioClient = grpc.ServerStreamingClient[PriceUpdate]
 
+func (c *marketDataServiceClient) RecoverPriceUpdates(ctx context.Context, in *RecoverPriceUpdatesRequest, opts ...grpc.CallOption) (*RecoverPriceUpdatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecoverPriceUpdatesResponse)
+	err := c.cc.Invoke(ctx, MarketDataService_RecoverPriceUpdates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketDataServiceClient) ReportTrade(ctx context.Context, in *TradeReport, opts ...grpc.CallOption) (*TradeReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TradeReportResponse)
+	err := c.cc.Invoke(ctx, MarketDataService_ReportTrade_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketDataServiceClient) GetReferencePrices(ctx context.Context, in *GetReferencePricesRequest, opts ...grpc.CallOption) (*ReferencePricesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReferencePricesResponse)
+	err := c.cc.Invoke(ctx, MarketDataService_GetReferencePrices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketDataServiceClient) ListInstruments(ctx context.Context, in *ListInstrumentsRequest, opts ...grpc.CallOption) (*ListInstrumentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInstrumentsResponse)
+	err := c.cc.Invoke(ctx, MarketDataService_ListInstruments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketDataServiceClient) GetInstrument(ctx context.Context, in *GetInstrumentRequest, opts ...grpc.CallOption) (*Instrument, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Instrument)
+	err := c.cc.Invoke(ctx, MarketDataService_GetInstrument_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketDataServiceClient) GetOptionChain(ctx context.Context, in *GetOptionChainRequest, opts ...grpc.CallOption) (*OptionChain, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OptionChain)
+	err := c.cc.Invoke(ctx, MarketDataService_GetOptionChain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketDataServiceClient) StreamOptionChain(ctx context.Context, in *StreamOptionChainRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[OptionChain], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MarketDataService_ServiceDesc.Streams[4], MarketDataService_StreamOptionChain_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamOptionChainRequest, OptionChain]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MarketDataService_StreamOptionChainClient = grpc.ServerStreamingClient[OptionChain]
+
+func (c *marketDataServiceClient) GetYieldCurve(ctx context.Context, in *GetYieldCurveRequest, opts ...grpc.CallOption) (*YieldCurve, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(YieldCurve)
+	err := c.cc.Invoke(ctx, MarketDataService_GetYieldCurve_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *marketDataServiceClient) HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(HealthCheckResponse)
@@ -130,10 +280,29 @@ type MarketDataServiceServer interface {
 	GetPrice(context.Context, *GetPriceRequest) (*GetPriceResponse, error)
 	// Subscribe to real-time price stream
 	StreamPrices(*StreamPricesRequest, grpc.ServerStreamingServer[PriceUpdate]) error
+	// Same as StreamPrices, with the updates of a tick delivered in one message
+	StreamPriceBatches(*StreamPricesRequest, grpc.ServerStreamingServer[PriceUpdateBatch]) error
+	// Manage a price subscription mid-stream (add/remove symbols, change interval, snapshots)
+	Subscribe(grpc.BidiStreamingServer[SubscriptionRequest, PriceUpdate]) error
 	// Generate simulated market data based on real data
 	GenerateSimulation(context.Context, *SimulationRequest) (*SimulationResponse, error)
 	// Stream simulated scenarios (rally, crash, divergence, etc.)
 	StreamScenario(*ScenarioRequest, grpc.ServerStreamingServer[PriceUpdate]) error
+	// Recover a range of stream updates from the session's replay buffer
+	RecoverPriceUpdates(context.Context, *RecoverPriceUpdatesRequest) (*RecoverPriceUpdatesResponse, error)
+	// Report an executed trade so its market impact moves the simulated price
+	ReportTrade(context.Context, *TradeReport) (*TradeReportResponse, error)
+	// Reference prices (NBBO, volume-weighted index, mark) computed across venues
+	GetReferencePrices(context.Context, *GetReferencePricesRequest) (*ReferencePricesResponse, error)
+	// Instrument reference data; unknown symbols are NOT_FOUND here and on every price RPC
+	ListInstruments(context.Context, *ListInstrumentsRequest) (*ListInstrumentsResponse, error)
+	GetInstrument(context.Context, *GetInstrumentRequest) (*Instrument, error)
+	// Listed options chains priced from the implied volatility surface, as a
+	// snapshot or re-priced as the underlying moves
+	GetOptionChain(context.Context, *GetOptionChainRequest) (*OptionChain, error)
+	StreamOptionChain(*StreamOptionChainRequest, grpc.ServerStreamingServer[OptionChain]) error
+	// Snapshot of the shared yield curve, shocks included; bonds priced off it are instruments
+	GetYieldCurve(context.Context, *GetYieldCurveRequest) (*YieldCurve, error)
 	// Health check
 	HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error)
 	mustEmbedUnimplementedMarketDataServiceServer()
@@ -152,12 +321,42 @@ func (UnimplementedMarketDataServiceServer) GetPrice(context.Context, *GetPriceR
 func (UnimplementedMarketDataServiceServer) StreamPrices(*StreamPricesRequest, grpc.ServerStreamingServer[PriceUpdate]) error {
 	return status.Errorf(codes.Unimplemented, "method StreamPrices not implemented")
 }
+func (UnimplementedMarketDataServiceServer) StreamPriceBatches(*StreamPricesRequest, grpc.ServerStreamingServer[PriceUpdateBatch]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamPriceBatches not implemented")
+}
+func (UnimplementedMarketDataServiceServer) Subscribe(grpc.BidiStreamingServer[SubscriptionRequest, PriceUpdate]) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
 func (UnimplementedMarketDataServiceServer) GenerateSimulation(context.Context, *SimulationRequest) (*SimulationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateSimulation not implemented")
 }
 func (UnimplementedMarketDataServiceServer) StreamScenario(*ScenarioRequest, grpc.ServerStreamingServer[PriceUpdate]) error {
 	return status.Errorf(codes.Unimplemented, "method StreamScenario not implemented")
 }
+func (UnimplementedMarketDataServiceServer) RecoverPriceUpdates(context.Context, *RecoverPriceUpdatesRequest) (*RecoverPriceUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverPriceUpdates not implemented")
+}
+func (UnimplementedMarketDataServiceServer) ReportTrade(context.Context, *TradeReport) (*TradeReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportTrade not implemented")
+}
+func (UnimplementedMarketDataServiceServer) GetReferencePrices(context.Context, *GetReferencePricesRequest) (*ReferencePricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReferencePrices not implemented")
+}
+func (UnimplementedMarketDataServiceServer) ListInstruments(context.Context, *ListInstrumentsRequest) (*ListInstrumentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInstruments not implemented")
+}
+func (UnimplementedMarketDataServiceServer) GetInstrument(context.Context, *GetInstrumentRequest) (*Instrument, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstrument not implemented")
+}
+func (UnimplementedMarketDataServiceServer) GetOptionChain(context.Context, *GetOptionChainRequest) (*OptionChain, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOptionChain not implemented")
+}
+func (UnimplementedMarketDataServiceServer) StreamOptionChain(*StreamOptionChainRequest, grpc.ServerStreamingServer[OptionChain]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamOptionChain not implemented")
+}
+func (UnimplementedMarketDataServiceServer) GetYieldCurve(context.Context, *GetYieldCurveRequest) (*YieldCurve, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetYieldCurve not implemented")
+}
 func (UnimplementedMarketDataServiceServer) HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HealthCheck not implemented")
 }
@@ -211,6 +410,24 @@ func _MarketDataService_StreamPrices_Handler(srv interface{}, stream grpc.Server
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type MarketDataService_StreamPricesServer = grpc.ServerStreamingServer[PriceUpdate]
 
+func _MarketDataService_StreamPriceBatches_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamPricesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MarketDataServiceServer).StreamPriceBatches(m, &grpc.GenericServerStream[StreamPricesRequest, PriceUpdateBatch]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MarketDataService_StreamPriceBatchesServer = grpc.ServerStreamingServer[PriceUpdateBatch]
+
+func _MarketDataService_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MarketDataServiceServer).Subscribe(&grpc.GenericServerStream[SubscriptionRequest, PriceUpdate]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MarketDataService_SubscribeServer = grpc.BidiStreamingServer[SubscriptionRequest, PriceUpdate]
+
 func _MarketDataService_GenerateSimulation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SimulationRequest)
 	if err := dec(in); err != nil {
@@ -240,6 +457,143 @@ func _MarketDataService_StreamScenario_Handler(srv interface{}, stream grpc.Serv
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type MarketDataService_StreamScenarioServer = grpc.ServerStreamingServer[PriceUpdate]
 
+func _MarketDataService_RecoverPriceUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecoverPriceUpdatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketDataServiceServer).RecoverPriceUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarketDataService_RecoverPriceUpdates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketDataServiceServer).RecoverPriceUpdates(ctx, req.(*RecoverPriceUpdatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarketDataService_ReportTrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TradeReport)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketDataServiceServer).ReportTrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarketDataService_ReportTrade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketDataServiceServer).ReportTrade(ctx, req.(*TradeReport))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarketDataService_GetReferencePrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReferencePricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketDataServiceServer).GetReferencePrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarketDataService_GetReferencePrices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketDataServiceServer).GetReferencePrices(ctx, req.(*GetReferencePricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarketDataService_ListInstruments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInstrumentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketDataServiceServer).ListInstruments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarketDataService_ListInstruments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketDataServiceServer).ListInstruments(ctx, req.(*ListInstrumentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarketDataService_GetInstrument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstrumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketDataServiceServer).GetInstrument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarketDataService_GetInstrument_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketDataServiceServer).GetInstrument(ctx, req.(*GetInstrumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarketDataService_GetOptionChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOptionChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketDataServiceServer).GetOptionChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarketDataService_GetOptionChain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketDataServiceServer).GetOptionChain(ctx, req.(*GetOptionChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarketDataService_StreamOptionChain_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamOptionChainRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MarketDataServiceServer).StreamOptionChain(m, &grpc.GenericServerStream[StreamOptionChainRequest, OptionChain]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MarketDataService_StreamOptionChainServer = grpc.ServerStreamingServer[OptionChain]
+
+func _MarketDataService_GetYieldCurve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetYieldCurveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketDataServiceServer).GetYieldCurve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarketDataService_GetYieldCurve_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketDataServiceServer).GetYieldCurve(ctx, req.(*GetYieldCurveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MarketDataService_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(HealthCheckRequest)
 	if err := dec(in); err != nil {
@@ -273,6 +627,34 @@ var MarketDataService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GenerateSimulation",
 			Handler:    _MarketDataService_GenerateSimulation_Handler,
 		},
+		{
+			MethodName: "RecoverPriceUpdates",
+			Handler:    _MarketDataService_RecoverPriceUpdates_Handler,
+		},
+		{
+			MethodName: "ReportTrade",
+			Handler:    _MarketDataService_ReportTrade_Handler,
+		},
+		{
+			MethodName: "GetReferencePrices",
+			Handler:    _MarketDataService_GetReferencePrices_Handler,
+		},
+		{
+			MethodName: "ListInstruments",
+			Handler:    _MarketDataService_ListInstruments_Handler,
+		},
+		{
+			MethodName: "GetInstrument",
+			Handler:    _MarketDataService_GetInstrument_Handler,
+		},
+		{
+			MethodName: "GetOptionChain",
+			Handler:    _MarketDataService_GetOptionChain_Handler,
+		},
+		{
+			MethodName: "GetYieldCurve",
+			Handler:    _MarketDataService_GetYieldCurve_Handler,
+		},
 		{
 			MethodName: "HealthCheck",
 			Handler:    _MarketDataService_HealthCheck_Handler,
@@ -284,11 +666,27 @@ var MarketDataService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _MarketDataService_StreamPrices_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "StreamPriceBatches",
+			Handler:       _MarketDataService_StreamPriceBatches_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Subscribe",
+			Handler:       _MarketDataService_Subscribe_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "StreamScenario",
 			Handler:       _MarketDataService_StreamScenario_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "StreamOptionChain",
+			Handler:       _MarketDataService_StreamOptionChain_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "internal/proto/marketdata.proto",
 }
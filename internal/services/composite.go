@@ -0,0 +1,31 @@
+package services
+
+import (
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+)
+
+// CompositePrice evaluates a composite symbol from its legs' prices
+func CompositePrice(composite config.CompositeSymbol, legPrice func(symbol string) float64) float64 {
+	legs := make([]float64, len(composite.Legs))
+	for i, leg := range composite.Legs {
+		legs[i] = leg.Weight * legPrice(leg.Symbol)
+	}
+
+	switch composite.Kind {
+	case config.CompositeSpread:
+		return legs[0] - legs[1]
+	case config.CompositeRatio:
+		if legs[1] == 0 {
+			return 0
+		}
+		return legs[0] / legs[1]
+	case config.CompositeFX:
+		return legs[0] * legs[1]
+	default:
+		var sum float64
+		for _, price := range legs {
+			sum += price
+		}
+		return sum
+	}
+}
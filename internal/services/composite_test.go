@@ -0,0 +1,63 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+)
+
+func TestCompositePrice(t *testing.T) {
+	prices := map[string]float64{"BTC-USD": 50000, "ETH-USD": 2500, "USD-GBP": 0.8}
+	legPrice := func(symbol string) float64 { return prices[symbol] }
+	legs := func(a, b float64) []config.CompositeLeg {
+		return []config.CompositeLeg{{Symbol: "BTC-USD", Weight: a}, {Symbol: "ETH-USD", Weight: b}}
+	}
+
+	tests := []struct {
+		kind     string
+		legs     []config.CompositeLeg
+		expected float64
+	}{
+		{config.CompositeBasket, legs(0.01, 0.2), 1000},
+		{config.CompositeSpread, legs(1, 15), 12500},
+		{config.CompositeRatio, legs(1, 1), 20},
+		{config.CompositeFX, []config.CompositeLeg{{Symbol: "BTC-USD", Weight: 1}, {Symbol: "USD-GBP", Weight: 1}}, 40000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.kind, func(t *testing.T) {
+			price := CompositePrice(config.CompositeSymbol{Symbol: "X", Kind: tt.kind, Legs: tt.legs}, legPrice)
+			assert.InDelta(t, tt.expected, price, 1e-9)
+		})
+	}
+
+	// A zero denominator prices the ratio at zero rather than infinity
+	assert.Equal(t, 0.0, CompositePrice(config.CompositeSymbol{Kind: config.CompositeRatio, Legs: legs(1, 0)}, legPrice))
+}
+
+func TestMarketDataService_Composite(t *testing.T) {
+	cfg := &config.Config{
+		Symbols: []string{"BTC-USD"},
+		CompositeSymbols: []config.CompositeSymbol{
+			{Symbol: "PAIR", Kind: config.CompositeSpread, Legs: []config.CompositeLeg{{Symbol: "BTC-USD", Weight: 2}, {Symbol: "ETH-USD", Weight: 1}}},
+		},
+	}
+	service := NewMarketDataService(cfg, logrus.New())
+
+	composite, exists := service.Composite("PAIR")
+	require.True(t, exists)
+	assert.Equal(t, config.CompositeSpread, composite.Kind)
+	_, exists = service.Composite("BTC-USD")
+	assert.False(t, exists)
+
+	price, err := service.GetPrice("PAIR")
+	require.NoError(t, err)
+	assert.InDelta(t, 100, price, 1e-9)
+
+	assert.True(t, service.Universe().Contains("PAIR"))
+	assert.True(t, service.Universe().Contains("ETH-USD"), "legs join the universe")
+}
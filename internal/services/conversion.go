@@ -0,0 +1,126 @@
+package services
+
+import (
+	"math"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+)
+
+// Conversion converts prices quoted in one currency into another at the
+// prices of pairs the simulator quotes: one pair converting directly, or two
+// through the FX pivot when none does
+type Conversion struct {
+	From      string
+	To        string
+	Pivot     string // Set when converting through the pivot currency
+	Legs      []ConversionLeg
+	Magnitude int // Power of ten of the rate when found; scales ticks into To
+}
+
+// ConversionLeg is one pair a conversion reads its rate from
+type ConversionLeg struct {
+	RateSymbol string
+	Inverted   bool // RateSymbol quotes the leg's source in its target, e.g. EUR-USD converting USD to EUR
+}
+
+// Rate is the multiplier from the leg's source to its target currency given
+// the rate symbol's price
+func (l ConversionLeg) Rate(price float64) float64 {
+	if !l.Inverted {
+		return price
+	}
+	if price == 0 {
+		return 0
+	}
+	return 1 / price
+}
+
+// Rate is the multiplier from From to To given the rate symbols' prices
+func (c Conversion) Rate(price func(symbol string) float64) float64 {
+	rate := 1.0
+	for _, leg := range c.Legs {
+		rate *= leg.Rate(price(leg.RateSymbol))
+	}
+	return rate
+}
+
+// RateSymbols lists the symbols the conversion reads its rate from
+func (c Conversion) RateSymbols() []string {
+	symbols := make([]string, 0, len(c.Legs))
+	for _, leg := range c.Legs {
+		symbols = append(symbols, leg.RateSymbol)
+	}
+	return symbols
+}
+
+// ConversionFor finds the spot pairs that convert prices quoted in from into
+// to: a direct pair, preferring one quoted in the target currency, or else a
+// pair into the FX pivot and one out of it. Perpetuals, futures and options
+// share their underlying's currencies but are not rates.
+func (s *MarketDataService) ConversionFor(from, to string) (Conversion, bool) {
+	var spot []config.Instrument
+	for _, instrument := range s.registry.List() {
+		switch instrument.AssetClass {
+		case "perpetual", "future", "option":
+		default:
+			spot = append(spot, instrument)
+		}
+	}
+
+	if leg, exists := conversionLeg(spot, from, to); exists {
+		return s.measure(Conversion{From: from, To: to, Legs: []ConversionLeg{leg}}), true
+	}
+
+	pivot := s.config.FXPivot
+	if pivot == "" || pivot == from || pivot == to {
+		return Conversion{}, false
+	}
+	into, exists := conversionLeg(spot, from, pivot)
+	if !exists {
+		return Conversion{}, false
+	}
+	out, exists := conversionLeg(spot, pivot, to)
+	if !exists {
+		return Conversion{}, false
+	}
+	return s.measure(Conversion{From: from, To: to, Pivot: pivot, Legs: []ConversionLeg{into, out}}), true
+}
+
+// measure fixes the conversion's magnitude at the current rate. It is not
+// re-read per tick, so converted prices keep one grid while the rate drifts.
+func (s *MarketDataService) measure(conversion Conversion) Conversion {
+	if rate := conversion.Rate(s.engine.Price); rate > 0 {
+		conversion.Magnitude = int(math.Round(math.Log10(rate)))
+	}
+	return conversion
+}
+
+// ConvertedInstrument restates an instrument's price grid in the
+// conversion's target currency: the tick scaled by the rate's magnitude, so
+// converted prices keep the precision they had relative to the price
+func ConvertedInstrument(instrument config.Instrument, conversion Conversion) config.Instrument {
+	converted := instrument
+	converted.QuoteCurrency = conversion.To
+	converted.PricePrecision = max(PriceScale(instrument)-conversion.Magnitude, 0)
+	if instrument.TickSize > 0 {
+		scale := math.Pow10(converted.PricePrecision)
+		converted.TickSize = max(math.Round(instrument.TickSize*math.Pow10(conversion.Magnitude)*scale), 1) / scale
+	}
+	return converted
+}
+
+// conversionLeg finds the pair converting from into to, preferring one quoted
+// in the target currency
+func conversionLeg(spot []config.Instrument, from, to string) (ConversionLeg, bool) {
+	for _, instrument := range spot {
+		if instrument.BaseCurrency == from && instrument.QuoteCurrency == to {
+			return ConversionLeg{RateSymbol: instrument.Symbol}, true
+		}
+	}
+	for _, instrument := range spot {
+		if instrument.BaseCurrency == to && instrument.QuoteCurrency == from {
+			return ConversionLeg{RateSymbol: instrument.Symbol, Inverted: true}, true
+		}
+	}
+	return ConversionLeg{}, false
+}
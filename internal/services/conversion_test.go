@@ -0,0 +1,88 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+)
+
+func TestConversion_Rate(t *testing.T) {
+	prices := map[string]float64{"BTC-EUR": 60000, "EUR-USD": 1.25, "USD-JPY": 150}
+	price := func(symbol string) float64 { return prices[symbol] }
+
+	direct := Conversion{From: "BTC", To: "EUR", Legs: []ConversionLeg{{RateSymbol: "BTC-EUR"}}}
+	assert.Equal(t, 60000.0, direct.Rate(price))
+
+	inverted := Conversion{From: "USD", To: "EUR", Legs: []ConversionLeg{{RateSymbol: "EUR-USD", Inverted: true}}}
+	assert.InDelta(t, 0.8, inverted.Rate(price), 1e-15)
+	assert.Zero(t, ConversionLeg{RateSymbol: "EUR-USD", Inverted: true}.Rate(0), "an unpriced rate converts to nothing rather than infinity")
+
+	// Through the pivot the legs' rates multiply
+	pivoted := Conversion{From: "EUR", To: "JPY", Pivot: "USD", Legs: []ConversionLeg{{RateSymbol: "EUR-USD"}, {RateSymbol: "USD-JPY"}}}
+	assert.InDelta(t, 187.5, pivoted.Rate(price), 1e-12)
+	assert.Equal(t, []string{"EUR-USD", "USD-JPY"}, pivoted.RateSymbols())
+}
+
+func TestMarketDataService_ConversionFor(t *testing.T) {
+	cfg := &config.Config{
+		Symbols:   []string{"BTC-USD", "USD-EUR"},
+		FXPivot:   "USD",
+		FXFactors: []string{"EUR-USD", "USD-JPY"},
+		FXCrosses: []string{"EUR-JPY"},
+		Perpetuals: []config.Perpetual{
+			{Symbol: "AUD-PERP", Index: "AUD-USD", FundingInterval: 8 * time.Hour},
+		},
+	}
+	service := NewMarketDataService(cfg, logrus.New())
+
+	// A pair quoted in the target currency is preferred
+	conversion, exists := service.ConversionFor("USD", "EUR")
+	require.True(t, exists)
+	assert.Equal(t, Conversion{From: "USD", To: "EUR", Legs: []ConversionLeg{{RateSymbol: "USD-EUR"}}}, conversion)
+
+	// Otherwise the inverse pair is used
+	conversion, exists = service.ConversionFor("JPY", "USD")
+	require.True(t, exists)
+	assert.Equal(t, Conversion{From: "JPY", To: "USD", Legs: []ConversionLeg{{RateSymbol: "USD-JPY", Inverted: true}}, Magnitude: -2}, conversion)
+
+	// Without a direct pair, prices convert through the FX pivot
+	conversion, exists = service.ConversionFor("BTC", "JPY")
+	require.True(t, exists)
+	assert.Equal(t, Conversion{
+		From:      "BTC",
+		To:        "JPY",
+		Pivot:     "USD",
+		Legs:      []ConversionLeg{{RateSymbol: "BTC-USD"}, {RateSymbol: "USD-JPY"}},
+		Magnitude: 4,
+	}, conversion)
+
+	// Perpetuals are not rates, so nothing reaches AUD
+	_, exists = service.ConversionFor("AUD", "USD")
+	assert.False(t, exists)
+	_, exists = service.ConversionFor("AUD", "JPY")
+	assert.False(t, exists)
+}
+
+func TestConvertedInstrument(t *testing.T) {
+	instrument := InferInstrument("SOL-USD")
+
+	// A high-value quote currency gets a finer grid, a low-value one a coarser
+	converted := ConvertedInstrument(instrument, Conversion{To: "BTC", Magnitude: -5})
+	assert.Equal(t, "BTC", converted.QuoteCurrency)
+	assert.Equal(t, 1e-7, converted.TickSize)
+	assert.Equal(t, 7, converted.PricePrecision)
+	assert.Equal(t, 0.0000025, RoundPrice(converted, 0.00000246))
+
+	converted = ConvertedInstrument(instrument, Conversion{To: "JPY", Magnitude: 2})
+	assert.Equal(t, 1.0, converted.TickSize)
+	assert.Equal(t, 0, converted.PricePrecision)
+
+	converted = ConvertedInstrument(instrument, Conversion{To: "EUR"})
+	assert.Equal(t, instrument.TickSize, converted.TickSize)
+	assert.Equal(t, instrument.PricePrecision, converted.PricePrecision)
+}
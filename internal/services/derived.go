@@ -0,0 +1,61 @@
+package services
+
+import (
+	"slices"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+)
+
+// derivedKind is a kind of configured symbol priced off other symbols
+type derivedKind int
+
+const (
+	derivedComposite derivedKind = iota
+	derivedPerpetual
+	derivedFuture
+	derivedCross
+)
+
+// isDerived reports whether a symbol is configured as one of the given
+// kinds. Futures are matched by contract name, listed or not.
+func isDerived(cfg *config.Config, kinds ...derivedKind) func(symbol string) bool {
+	symbols := make(map[string]bool)
+	futures := false
+	for _, kind := range kinds {
+		switch kind {
+		case derivedComposite:
+			for _, composite := range cfg.CompositeSymbols {
+				symbols[composite.Symbol] = true
+			}
+		case derivedPerpetual:
+			for _, perpetual := range cfg.Perpetuals {
+				symbols[perpetual.Symbol] = true
+			}
+		case derivedFuture:
+			futures = true
+		case derivedCross:
+			for _, cross := range cfg.FXCrosses {
+				symbols[cross] = true
+			}
+		}
+	}
+	return func(symbol string) bool {
+		if symbols[symbol] {
+			return true
+		}
+		_, future := ParseContract(cfg.Futures, symbol)
+		return futures && future
+	}
+}
+
+// builtOnRegular keeps the definitions none of whose symbols, as listed by
+// symbols, are derived
+func builtOnRegular[T any](definitions []T, derived func(symbol string) bool, symbols func(T) []string) []T {
+	var kept []T
+	for _, definition := range definitions {
+		if !slices.ContainsFunc(symbols(definition), derived) {
+			kept = append(kept, definition)
+		}
+	}
+	return kept
+}
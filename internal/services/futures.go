@@ -0,0 +1,209 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+)
+
+const (
+	// contractDateLayout is the expiry suffix of a contract symbol
+	contractDateLayout = "20060102"
+
+	// expiryHour is the time of day, UTC, contracts expire at
+	expiryHour = 8
+
+	// settledRetention is how long an expired contract stays in the universe
+	// and the engine, so every stream publishes its settlement first
+	settledRetention = time.Hour
+
+	year = 365 * 24 * time.Hour
+)
+
+// FuturesOf returns the configured future series whose underlying is a
+// regular symbol
+func FuturesOf(cfg *config.Config) []config.FutureSeries {
+	return builtOnRegular(cfg.Futures, isDerived(cfg, derivedComposite, derivedPerpetual), func(series config.FutureSeries) []string {
+		return []string{series.Underlying}
+	})
+}
+
+// FutureContract is one expiry of a future series
+type FutureContract struct {
+	Symbol string
+	Series config.FutureSeries
+	Expiry time.Time
+}
+
+// Expiries returns the next n expiries of the cycle strictly after at
+func Expiries(cycle string, at time.Time, n int) []time.Time {
+	at = at.UTC()
+	var expiries []time.Time
+	for month := time.Date(at.Year(), at.Month(), 1, 0, 0, 0, 0, time.UTC); len(expiries) < n; month = month.AddDate(0, 1, 0) {
+		if cycle == config.FutureCycleQuarterly && month.Month()%3 != 0 {
+			continue
+		}
+		if expiry := lastFriday(month); expiry.After(at) {
+			expiries = append(expiries, expiry)
+		}
+	}
+	return expiries
+}
+
+// lastFriday is the expiry in the month starting at month
+func lastFriday(month time.Time) time.Time {
+	last := month.AddDate(0, 1, -1)
+	back := (int(last.Weekday()) - int(time.Friday) + 7) % 7
+	return time.Date(last.Year(), last.Month(), last.Day()-back, expiryHour, 0, 0, 0, time.UTC)
+}
+
+// ContractSymbol names a series' contract for an expiry
+func ContractSymbol(series config.FutureSeries, expiry time.Time) string {
+	return series.Root + "-" + expiry.UTC().Format(contractDateLayout)
+}
+
+// ListedContracts returns the contracts of a series trading at the given time
+func ListedContracts(series config.FutureSeries, at time.Time) []FutureContract {
+	var contracts []FutureContract
+	for _, expiry := range Expiries(series.Cycle, at, series.Listed) {
+		contracts = append(contracts, FutureContract{Symbol: ContractSymbol(series, expiry), Series: series, Expiry: expiry})
+	}
+	return contracts
+}
+
+// ParseContract finds the contract a symbol names, listed or not. The
+// date must be an expiry of the series' cycle.
+func ParseContract(series []config.FutureSeries, symbol string) (FutureContract, bool) {
+	for _, candidate := range series {
+		date, found := strings.CutPrefix(symbol, candidate.Root+"-")
+		if !found {
+			continue
+		}
+		day, err := time.Parse(contractDateLayout, date)
+		if err != nil {
+			continue
+		}
+		expiries := Expiries(candidate.Cycle, day.Add(-time.Nanosecond), 1)
+		if expiries[0].Truncate(24 * time.Hour).Equal(day) {
+			return FutureContract{Symbol: symbol, Series: candidate, Expiry: expiries[0]}, true
+		}
+	}
+	return FutureContract{}, false
+}
+
+// CurveBasis is the annualized basis, as a fraction, of a future with the
+// given time to expiry: linear between curve points and flat beyond them
+func CurveBasis(curve []config.CurvePoint, tenor time.Duration) float64 {
+	if len(curve) == 0 {
+		return 0
+	}
+	if tenor <= curve[0].Tenor {
+		return curve[0].BasisPercent / 100
+	}
+	for i := 1; i < len(curve); i++ {
+		if tenor <= curve[i].Tenor {
+			low, high := curve[i-1], curve[i]
+			weight := float64(tenor-low.Tenor) / float64(high.Tenor-low.Tenor)
+			return (low.BasisPercent + weight*(high.BasisPercent-low.BasisPercent)) / 100
+		}
+	}
+	return curve[len(curve)-1].BasisPercent / 100
+}
+
+// FuturePrice carries the underlying to expiry at the curve's basis, so the
+// future converges to the underlying as expiry approaches
+func FuturePrice(underlying float64, curve []config.CurvePoint, untilExpiry time.Duration) float64 {
+	if untilExpiry <= 0 {
+		return underlying
+	}
+	return underlying * math.Exp(CurveBasis(curve, untilExpiry)*untilExpiry.Hours()/year.Hours())
+}
+
+const (
+	// futureNoiseHorizon is the time to expiry from which a contract's noise
+	// around the curve starts fading, so it converges on the underlying
+	futureNoiseHorizon = 90 * 24 * time.Hour
+
+	// futureNoiseHalfLife is how fast that noise reverts to the curve
+	futureNoiseHalfLife = time.Minute
+)
+
+// futureState is a contract's deviation from the curve
+type futureState struct {
+	noiseBps        float64
+	updatedAt       time.Time // Zero until the engine first steps the contract
+	settled         bool
+	settlementPrice float64
+}
+
+// FutureTick is a contract's state at a tick
+type FutureTick struct {
+	UnderlyingPrice        float64
+	BasisBps               float64
+	AnnualizedBasisPercent float64 // Zero once settled
+	Settled                bool
+	SettlementPrice        float64
+}
+
+// stepFuture moves a contract's noise around the curve, or settles it at the
+// underlying on the first step at or after expiry
+func (e *PriceEngine) stepFuture(symbol string, contract FutureContract, state *futureState, at time.Time) {
+	if state.settled {
+		return
+	}
+	if !at.Before(contract.Expiry) {
+		state.settled = true
+		state.settlementPrice = e.priceOf(contract.Series.Underlying)
+		e.record(symbol, MarketEvent{
+			Type:   EventSettlement,
+			Price:  state.settlementPrice,
+			Reason: fmt.Sprintf("expired and settled at %.6g; %s is now the front contract", state.settlementPrice, ListedContracts(contract.Series, at)[0].Symbol),
+		})
+		return
+	}
+
+	noiseBps := e.service.config.FutureBasisNoiseBps
+	if state.updatedAt.IsZero() {
+		state.updatedAt = at
+	}
+	if elapsed := at.Sub(state.updatedAt); elapsed > 0 {
+		decay := math.Exp(-math.Ln2 * elapsed.Seconds() / futureNoiseHalfLife.Seconds())
+		state.noiseBps = state.noiseBps*decay + rand.NormFloat64()*noiseBps*math.Sqrt(1-decay*decay)
+		state.updatedAt = at
+	}
+}
+
+// futurePrice prices a contract off its underlying along the series' curve,
+// with its noise fading towards expiry. Settled contracts keep their
+// settlement price.
+func (e *PriceEngine) futurePrice(contract FutureContract, state *futureState) float64 {
+	if state.settled {
+		return state.settlementPrice
+	}
+	underlying := e.priceOf(contract.Series.Underlying)
+	untilExpiry := contract.Expiry.Sub(e.clock())
+	if untilExpiry <= 0 {
+		return underlying
+	}
+	fade := math.Sqrt(min(1, untilExpiry.Hours()/futureNoiseHorizon.Hours()))
+	return FuturePrice(underlying, contract.Series.Curve, untilExpiry) * (1 + state.noiseBps*fade/10000)
+}
+
+// futureTick reports a contract at its current price
+func (e *PriceEngine) futureTick(contract FutureContract, state *futureState, price float64) *FutureTick {
+	underlying := e.priceOf(contract.Series.Underlying)
+	tick := &FutureTick{
+		UnderlyingPrice: underlying,
+		BasisBps:        (price/underlying - 1) * 10000,
+		Settled:         state.settled,
+		SettlementPrice: state.settlementPrice,
+	}
+	if !state.settled {
+		tick.AnnualizedBasisPercent = CurveBasis(contract.Series.Curve, contract.Expiry.Sub(e.clock())) * 100
+	}
+	return tick
+}
@@ -0,0 +1,149 @@
+package services
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+)
+
+func testFutureSeries() config.FutureSeries {
+	return config.FutureSeries{
+		Root:       "BTC-FUT",
+		Underlying: "BTC-USD",
+		Cycle:      config.FutureCycleQuarterly,
+		Listed:     3,
+		Curve: []config.CurvePoint{
+			{Tenor: 30 * 24 * time.Hour, BasisPercent: 5},
+			{Tenor: 90 * 24 * time.Hour, BasisPercent: 8},
+		},
+	}
+}
+
+func TestExpiries(t *testing.T) {
+	at := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, []time.Time{
+		time.Date(2024, 3, 29, 8, 0, 0, 0, time.UTC),
+		time.Date(2024, 6, 28, 8, 0, 0, 0, time.UTC),
+		time.Date(2024, 9, 27, 8, 0, 0, 0, time.UTC),
+	}, Expiries(config.FutureCycleQuarterly, at, 3))
+
+	// An expired contract is replaced by the next expiry of the cycle
+	expired := time.Date(2024, 3, 29, 8, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2024, 4, 26, 8, 0, 0, 0, time.UTC), Expiries(config.FutureCycleMonthly, expired, 1)[0])
+	assert.Equal(t, time.Date(2024, 12, 27, 8, 0, 0, 0, time.UTC), Expiries(config.FutureCycleQuarterly, expired, 3)[2])
+
+	// Months ending on a Friday expire on their last day
+	assert.Equal(t, time.Date(2024, 5, 31, 8, 0, 0, 0, time.UTC), Expiries(config.FutureCycleMonthly, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), 1)[0])
+}
+
+func TestParseContract(t *testing.T) {
+	series := []config.FutureSeries{testFutureSeries()}
+
+	contract, exists := ParseContract(series, "BTC-FUT-20240628")
+	require.True(t, exists)
+	assert.Equal(t, time.Date(2024, 6, 28, 8, 0, 0, 0, time.UTC), contract.Expiry)
+	assert.Equal(t, "BTC-USD", contract.Series.Underlying)
+	assert.Equal(t, "BTC-FUT-20240628", ContractSymbol(contract.Series, contract.Expiry))
+
+	for _, symbol := range []string{"BTC-FUT-20240426", "BTC-FUT-20240627", "BTC-FUT-2024", "ETH-FUT-20240628", "BTC-USD"} {
+		_, exists := ParseContract(series, symbol)
+		assert.False(t, exists, symbol)
+	}
+}
+
+func TestFuturePrice(t *testing.T) {
+	curve := testFutureSeries().Curve
+
+	// Flat before the first point and beyond the last, linear in between
+	assert.InDelta(t, 0.05, CurveBasis(curve, 7*24*time.Hour), 1e-12)
+	assert.InDelta(t, 0.065, CurveBasis(curve, 60*24*time.Hour), 1e-12)
+	assert.InDelta(t, 0.08, CurveBasis(curve, 365*24*time.Hour), 1e-12)
+	assert.Equal(t, 0.0, CurveBasis(nil, time.Hour))
+
+	// Contango prices above the underlying and converges at expiry
+	assert.InDelta(t, 100*math.Exp(0.08*292.0/365), FuturePrice(100, curve, 292*24*time.Hour), 1e-9)
+	assert.Equal(t, 100.0, FuturePrice(100, curve, 0))
+	assert.Less(t, FuturePrice(100, curve, time.Hour), 100.001)
+
+	backwardation := []config.CurvePoint{{Tenor: 30 * 24 * time.Hour, BasisPercent: -4}}
+	assert.Less(t, FuturePrice(100, backwardation, 30*24*time.Hour), 100.0)
+}
+
+func TestMarketDataService_Futures(t *testing.T) {
+	series := testFutureSeries()
+	cfg := &config.Config{Symbols: []string{"BTC-USD"}, Futures: []config.FutureSeries{series}}
+	service := NewMarketDataService(cfg, logrus.New())
+
+	listed := ListedContracts(series, time.Now())
+	require.Len(t, listed, 3)
+	front := listed[0]
+
+	instrument, exists := service.Instrument(front.Symbol)
+	require.True(t, exists)
+	assert.Equal(t, "future", instrument.AssetClass)
+	assert.Equal(t, 0.01, instrument.TickSize)
+	assert.True(t, service.Universe().Contains(front.Symbol))
+
+	price, err := service.GetPrice(listed[2].Symbol)
+	require.NoError(t, err)
+	assert.Greater(t, price, 100.0, "contango")
+
+	// Expired contracts are no longer instruments, but still name a contract
+	expired := ContractSymbol(series, time.Date(2024, 3, 29, 0, 0, 0, 0, time.UTC))
+	_, exists = service.Instrument(expired)
+	assert.False(t, exists)
+	_, exists = service.Future(expired)
+	assert.True(t, exists)
+
+	// Advancing the shared market past the front expiry delists it and lists
+	// the next contract
+	next := ListedContracts(series, front.Expiry)[2]
+	assert.False(t, service.Universe().Contains(next.Symbol))
+	service.Advance(front.Expiry)
+	assert.True(t, service.Universe().Contains(next.Symbol))
+	_, exists = service.Instrument(front.Symbol)
+	assert.False(t, exists)
+	_, exists = service.Instrument(next.Symbol)
+	assert.True(t, exists)
+}
+
+func TestMarketDataService_RetiresSettledFutures(t *testing.T) {
+	series := testFutureSeries()
+	cfg := &config.Config{Symbols: []string{"BTC-USD"}, Futures: []config.FutureSeries{series}}
+	service := NewMarketDataService(cfg, logrus.New())
+
+	listed := ListedContracts(series, time.Now())
+	symbols := make([]string, 0, len(listed))
+	for _, contract := range listed {
+		symbols = append(symbols, contract.Symbol)
+	}
+	service.Snapshot(symbols, 0)
+	tracked := len(service.engine.order)
+	front := listed[0]
+
+	// Settled contracts stay simulated while streams publish the settlement
+	service.Advance(front.Expiry)
+	service.Advance(front.Expiry.Add(settledRetention / 2))
+	assert.True(t, service.Universe().Contains(front.Symbol))
+	assert.Len(t, service.engine.order, tracked)
+
+	// then leave the universe and the engine, however many roll by
+	service.Advance(front.Expiry.Add(settledRetention))
+	assert.False(t, service.Universe().Contains(front.Symbol))
+	assert.Len(t, service.engine.order, tracked-1)
+	assert.NotContains(t, service.engine.symbols, front.Symbol)
+	assert.Contains(t, service.engine.symbols, "BTC-USD", "the underlying is still tracked")
+
+	last := listed[len(listed)-1]
+	for _, at := range []time.Time{listed[1].Expiry, last.Expiry} {
+		service.Advance(at.Add(settledRetention))
+	}
+	assert.Len(t, service.engine.order, 1, "only the underlying is left")
+	assert.Len(t, service.contracts, len(listed))
+}
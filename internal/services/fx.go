@@ -0,0 +1,156 @@
+package services
+
+import (
+	"math"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+)
+
+// FXCross is a currency pair priced from the factors of its two currencies,
+// so any triangle of crosses and factors closes
+type FXCross struct {
+	Symbol string
+	Pivot  string
+	Base   FXLeg
+	Quote  FXLeg
+}
+
+// FXLeg values one currency of a cross in the pivot currency
+type FXLeg struct {
+	Currency string
+	Factor   string // Pair against the pivot; empty for the pivot itself
+	Inverted bool   // The factor quotes the pivot in this currency, e.g. USD-JPY
+}
+
+// Value is the leg's currency in pivot units, given the factor's price
+func (l FXLeg) Value(price func(symbol string) float64) float64 {
+	switch {
+	case l.Factor == "":
+		return 1
+	case l.Inverted:
+		return 1 / price(l.Factor)
+	default:
+		return price(l.Factor)
+	}
+}
+
+// Factors lists the factor symbols the cross is priced from
+func (c FXCross) Factors() []string {
+	var factors []string
+	for _, leg := range []FXLeg{c.Base, c.Quote} {
+		if leg.Factor != "" {
+			factors = append(factors, leg.Factor)
+		}
+	}
+	return factors
+}
+
+// CrossRate triangulates a cross from its factors' prices
+func CrossRate(cross FXCross, price func(symbol string) float64) float64 {
+	quote := cross.Quote.Value(price)
+	if quote == 0 {
+		return 0
+	}
+	return cross.Base.Value(price) / quote
+}
+
+// CrossesOf resolves the configured crosses against the factors. Factors
+// must quote a currency against the pivot and be regular symbols; crosses
+// whose currencies have no factor, that are factors themselves or that
+// clash with a composite or perpetual are skipped.
+func CrossesOf(cfg *config.Config) []FXCross {
+	derived := isDerived(cfg, derivedComposite, derivedPerpetual)
+
+	legs := map[string]FXLeg{cfg.FXPivot: {Currency: cfg.FXPivot}}
+	factors := make(map[string]bool)
+	for _, factor := range cfg.FXFactors {
+		base, quote, ok := splitPair(factor)
+		if !ok || derived(factor) {
+			continue
+		}
+		leg := FXLeg{Currency: base, Factor: factor}
+		switch {
+		case quote == cfg.FXPivot && base != cfg.FXPivot:
+		case base == cfg.FXPivot && quote != cfg.FXPivot:
+			leg = FXLeg{Currency: quote, Factor: factor, Inverted: true}
+		default:
+			continue
+		}
+		if _, exists := legs[leg.Currency]; !exists {
+			legs[leg.Currency] = leg
+			factors[factor] = true
+		}
+	}
+
+	var crosses []FXCross
+	seen := make(map[string]bool)
+	for _, symbol := range cfg.FXCrosses {
+		base, quote, ok := splitPair(symbol)
+		if !ok || base == quote || factors[symbol] || derived(symbol) || seen[symbol] {
+			continue
+		}
+		baseLeg, baseKnown := legs[base]
+		quoteLeg, quoteKnown := legs[quote]
+		if !baseKnown || !quoteKnown {
+			continue
+		}
+		seen[symbol] = true
+		crosses = append(crosses, FXCross{Symbol: symbol, Pivot: cfg.FXPivot, Base: baseLeg, Quote: quoteLeg})
+	}
+	return crosses
+}
+
+// seedPrice is where a regular symbol's walk starts: FX pairs at the ratio
+// of their currencies' reference rates, stablecoins as their peg, anything
+// else at 100
+func seedPrice(symbol string) float64 {
+	if base, quote, ok := splitPair(symbol); ok {
+		if peg, pegged := stablecoins[base]; pegged {
+			base = peg
+		}
+		if baseRate, quoteRate := fiatRates[base], fiatRates[quote]; baseRate > 0 && quoteRate > 0 {
+			return baseRate / quoteRate
+		}
+	}
+	return 100.0
+}
+
+// splitPair splits "BASE-QUOTE" or "BASE/QUOTE" into its currencies
+func splitPair(symbol string) (string, string, bool) {
+	base, quote, found := strings.Cut(strings.ReplaceAll(symbol, "/", "-"), "-")
+	return base, quote, found && base != "" && quote != "" && !strings.Contains(quote, "-")
+}
+
+// crossState is a cross's dislocation from its triangulated rate
+type crossState struct {
+	dislocationBps float64
+	updatedAt      time.Time // Zero until the engine first steps the cross
+}
+
+// CrossTick is an FX cross's state at a tick
+type CrossTick struct {
+	TriangulatedPrice float64
+	DislocationBps    float64
+}
+
+// stepCross moves a cross's dislocation. Without configured dislocations the
+// cross sits exactly on the triangulated rate; with them it wanders around
+// it and reverts, leaving small arbitrages open.
+func (e *PriceEngine) stepCross(state *crossState, at time.Time) {
+	cfg := e.service.config
+	if cfg.FXDislocationBps <= 0 {
+		state.dislocationBps = 0
+		return
+	}
+	if state.updatedAt.IsZero() {
+		state.updatedAt = at
+	}
+	if elapsed := at.Sub(state.updatedAt); elapsed > 0 {
+		decay := math.Exp(-math.Ln2 * elapsed.Seconds() / cfg.FXDislocationHalfLife.Seconds())
+		state.dislocationBps = state.dislocationBps*decay + rand.NormFloat64()*cfg.FXDislocationBps*math.Sqrt(1-decay*decay)
+		state.updatedAt = at
+	}
+}
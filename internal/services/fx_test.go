@@ -0,0 +1,60 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+)
+
+func testFXConfig() *config.Config {
+	return &config.Config{
+		FXPivot:   "USD",
+		FXFactors: []string{"EUR-USD", "USD-JPY", "GBP-USD", "EUR-GBP", "USD-JPY"},
+		FXCrosses: []string{"EUR-JPY", "EUR/GBP", "JPY-GBP", "USD-EUR", "USD-JPY", "EUR-CHF", "EUR-JPY", "EUR-EUR"},
+	}
+}
+
+func TestCrossesOf(t *testing.T) {
+	crosses := CrossesOf(testFXConfig())
+
+	// Factors, unknown currencies, duplicates and degenerate pairs are skipped
+	require.Len(t, crosses, 4)
+	assert.Equal(t, FXCross{
+		Symbol: "EUR-JPY",
+		Pivot:  "USD",
+		Base:   FXLeg{Currency: "EUR", Factor: "EUR-USD"},
+		Quote:  FXLeg{Currency: "JPY", Factor: "USD-JPY", Inverted: true},
+	}, crosses[0])
+	assert.Equal(t, "EUR/GBP", crosses[1].Symbol)
+	assert.Equal(t, []string{"USD-JPY", "GBP-USD"}, crosses[2].Factors())
+	assert.Equal(t, []string{"EUR-USD"}, crosses[3].Factors(), "the pivot needs no factor")
+}
+
+func TestCrossRate(t *testing.T) {
+	prices := map[string]float64{"EUR-USD": 1.08, "USD-JPY": 150, "GBP-USD": 1.25}
+	price := func(symbol string) float64 { return prices[symbol] }
+	rates := make(map[string]float64)
+	for _, cross := range CrossesOf(testFXConfig()) {
+		rates[cross.Symbol] = CrossRate(cross, price)
+	}
+
+	assert.InDelta(t, 162, rates["EUR-JPY"], 1e-9)
+	assert.InDelta(t, 0.864, rates["EUR/GBP"], 1e-12)
+	assert.InDelta(t, 1/187.5, rates["JPY-GBP"], 1e-15)
+	assert.InDelta(t, 1/1.08, rates["USD-EUR"], 1e-15)
+
+	// Every triangle closes: EUR-JPY = EUR-GBP × GBP-JPY
+	assert.InDelta(t, rates["EUR-JPY"], rates["EUR/GBP"]/rates["JPY-GBP"], 1e-9)
+}
+
+func TestSeedPrice(t *testing.T) {
+	// FX pairs start near real levels, so triangulated crosses do too
+	assert.InDelta(t, 1.08, seedPrice("EUR-USD"), 1e-12)
+	assert.InDelta(t, 1/0.0067, seedPrice("USD/JPY"), 1e-9)
+	assert.InDelta(t, 1.08/1.27, seedPrice("EUR-GBP"), 1e-12)
+	assert.Equal(t, 100.0, seedPrice("BTC-USD"))
+	assert.Equal(t, 100.0, seedPrice("IDX"))
+}
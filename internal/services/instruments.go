@@ -0,0 +1,206 @@
+package services
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+)
+
+// ErrUnknownInstrument is returned for symbols missing from the registry
+var ErrUnknownInstrument = errors.New("unknown instrument")
+
+// fiatRates are the fiat currencies with a rough value in USD. They tell FX
+// pairs apart when inferring reference data and seed FX prices.
+var fiatRates = map[string]float64{
+	"USD": 1, "EUR": 1.08, "GBP": 1.27, "JPY": 0.0067, "CHF": 1.12,
+	"CAD": 0.74, "AUD": 0.66, "NZD": 0.61, "SEK": 0.095, "NOK": 0.094,
+}
+
+// stablecoins are the crypto currencies pegged to a fiat one. They trade
+// within basis points of the peg, so they quote to four decimals.
+var stablecoins = map[string]string{"USDC": "USD", "USDT": "USD", "DAI": "USD"}
+
+// InstrumentRegistry holds the reference data of every tradable symbol. It
+// is built once at startup and read-only afterwards; dated futures and
+// options are derived on lookup, as the listed contracts change with time.
+type InstrumentRegistry struct {
+	instruments map[string]config.Instrument
+	futures     []config.FutureSeries
+	options     *optionListing
+	clock       func() time.Time // Decides which contracts are listed
+}
+
+// NewInstrumentRegistry registers the configured instruments, then infers
+// reference data for configured symbols, composites, perpetuals, FX crosses
+// and their factors, and bonds without an entry
+func NewInstrumentRegistry(cfg *config.Config) *InstrumentRegistry {
+	r := &InstrumentRegistry{instruments: make(map[string]config.Instrument), futures: FuturesOf(cfg), options: newOptionListing(OptionsOf(cfg)), clock: time.Now}
+	for _, instrument := range cfg.Instruments {
+		r.instruments[instrument.Symbol] = instrument
+	}
+	for _, symbol := range cfg.Symbols {
+		if _, exists := r.instruments[symbol]; !exists {
+			r.instruments[symbol] = InferInstrument(symbol)
+		}
+	}
+	for _, composite := range cfg.CompositeSymbols {
+		if _, exists := r.instruments[composite.Symbol]; !exists {
+			r.instruments[composite.Symbol] = r.compositeInstrument(composite)
+		}
+	}
+	for _, perpetual := range PerpetualsOf(cfg) {
+		if _, exists := r.instruments[perpetual.Symbol]; !exists {
+			r.instruments[perpetual.Symbol] = r.perpetualInstrument(perpetual)
+		}
+	}
+	for _, cross := range CrossesOf(cfg) {
+		for _, symbol := range append(cross.Factors(), cross.Symbol) {
+			if _, exists := r.instruments[symbol]; !exists {
+				r.instruments[symbol] = InferInstrument(symbol)
+			}
+		}
+	}
+	for _, bond := range cfg.Bonds {
+		if _, exists := r.instruments[bond.Symbol]; !exists {
+			r.instruments[bond.Symbol] = bondInstrument(bond, cfg.YieldCurve)
+		}
+	}
+	return r
+}
+
+// InferInstrument derives reference data from a "BASE-QUOTE" or "BASE/QUOTE"
+// symbol: pairs of fiat currencies are FX, anything else is crypto, with a
+// finer tick for stablecoins
+func InferInstrument(symbol string) config.Instrument {
+	base, quote, _ := strings.Cut(strings.ReplaceAll(symbol, "/", "-"), "-")
+	if fiatRates[base] > 0 && fiatRates[quote] > 0 {
+		return config.Instrument{
+			Symbol: symbol, AssetClass: "fx", BaseCurrency: base, QuoteCurrency: quote,
+			TickSize: 0.00001, LotSize: 1000, PricePrecision: 5,
+		}
+	}
+	if _, pegged := stablecoins[base]; pegged {
+		return config.Instrument{
+			Symbol: symbol, AssetClass: "crypto", BaseCurrency: base, QuoteCurrency: quote,
+			TickSize: 0.0001, LotSize: 1, PricePrecision: 4,
+		}
+	}
+	return config.Instrument{
+		Symbol: symbol, AssetClass: "crypto", BaseCurrency: base, QuoteCurrency: quote,
+		TickSize: 0.01, LotSize: 0.0001, PricePrecision: 2,
+	}
+}
+
+// compositeInstrument quotes a composite in its legs' currency. Ratios are
+// unitless and FX conversions take the rate leg's quote currency.
+func (r *InstrumentRegistry) compositeInstrument(composite config.CompositeSymbol) config.Instrument {
+	currencyLeg := composite.Legs[0].Symbol
+	switch composite.Kind {
+	case config.CompositeFX:
+		currencyLeg = composite.Legs[1].Symbol
+	case config.CompositeRatio:
+		currencyLeg = ""
+	}
+
+	instrument := config.Instrument{
+		Symbol:         composite.Symbol,
+		AssetClass:     "composite",
+		TickSize:       0.0001,
+		LotSize:        1,
+		PricePrecision: 4,
+	}
+	if leg, exists := r.instruments[currencyLeg]; exists {
+		instrument.QuoteCurrency = leg.QuoteCurrency
+	}
+	return instrument
+}
+
+// bondInstrument quotes a bond per 100 face value in the curve's currency
+func bondInstrument(bond config.Bond, curve config.YieldCurve) config.Instrument {
+	return config.Instrument{
+		Symbol:         bond.Symbol,
+		AssetClass:     "bond",
+		QuoteCurrency:  curve.Currency,
+		TickSize:       0.001,
+		LotSize:        1,
+		PricePrecision: 3,
+	}
+}
+
+// perpetualInstrument trades a perpetual in its index's currency and
+// increments, inferring the index's reference data if it has none
+func (r *InstrumentRegistry) perpetualInstrument(perpetual config.Perpetual) config.Instrument {
+	instrument, exists := r.instruments[perpetual.Index]
+	if !exists {
+		instrument = InferInstrument(perpetual.Index)
+	}
+	instrument.Symbol = perpetual.Symbol
+	instrument.AssetClass = "perpetual"
+	return instrument
+}
+
+// futureInstrument trades a contract in its underlying's currency and
+// increments
+func (r *InstrumentRegistry) futureInstrument(contract FutureContract) config.Instrument {
+	instrument, exists := r.instruments[contract.Series.Underlying]
+	if !exists {
+		instrument = InferInstrument(contract.Series.Underlying)
+	}
+	instrument.Symbol = contract.Symbol
+	instrument.AssetClass = "future"
+	return instrument
+}
+
+// optionInstrument quotes an option in its underlying's currency and
+// increments
+func (r *InstrumentRegistry) optionInstrument(contract OptionContract) config.Instrument {
+	instrument, exists := r.instruments[contract.Series.Underlying]
+	if !exists {
+		instrument = InferInstrument(contract.Series.Underlying)
+	}
+	instrument.Symbol = contract.Symbol
+	instrument.AssetClass = "option"
+	return instrument
+}
+
+// Get looks up a symbol's reference data. Future contracts are only known
+// while listed at the registry's clock, options while on the listing.
+func (r *InstrumentRegistry) Get(symbol string) (config.Instrument, bool) {
+	if instrument, exists := r.instruments[symbol]; exists {
+		return instrument, true
+	}
+	if contract, exists := ParseContract(r.futures, symbol); exists {
+		for _, listed := range ListedContracts(contract.Series, r.clock()) {
+			if listed.Symbol == symbol {
+				return r.futureInstrument(contract), true
+			}
+		}
+	}
+	if contract, exists := ParseOption(r.options.series, symbol); exists && r.options.listed(contract) {
+		return r.optionInstrument(contract), true
+	}
+	return config.Instrument{}, false
+}
+
+// List returns all instruments sorted by symbol
+func (r *InstrumentRegistry) List() []config.Instrument {
+	instruments := make([]config.Instrument, 0, len(r.instruments))
+	for _, instrument := range r.instruments {
+		instruments = append(instruments, instrument)
+	}
+	for _, series := range r.futures {
+		for _, contract := range ListedContracts(series, r.clock()) {
+			instruments = append(instruments, r.futureInstrument(contract))
+		}
+	}
+	for _, series := range r.options.series {
+		for _, contract := range r.options.contracts(series) {
+			instruments = append(instruments, r.optionInstrument(contract))
+		}
+	}
+	sort.Slice(instruments, func(i, j int) bool { return instruments[i].Symbol < instruments[j].Symbol })
+	return instruments
+}
@@ -0,0 +1,68 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+)
+
+func TestInferInstrument(t *testing.T) {
+	crypto := InferInstrument("BTC-USD")
+	assert.Equal(t, "crypto", crypto.AssetClass)
+	assert.Equal(t, "BTC", crypto.BaseCurrency)
+	assert.Equal(t, "USD", crypto.QuoteCurrency)
+
+	fx := InferInstrument("EUR/USD")
+	assert.Equal(t, "fx", fx.AssetClass)
+	assert.Equal(t, "EUR", fx.BaseCurrency)
+	assert.Equal(t, 5, fx.PricePrecision)
+
+	stablecoin := InferInstrument("USDC-USD")
+	assert.Equal(t, "crypto", stablecoin.AssetClass)
+	assert.Equal(t, 0.0001, stablecoin.TickSize)
+	assert.Equal(t, 4, stablecoin.PricePrecision)
+	assert.Equal(t, 1.0, seedPrice("USDC-USD"))
+	assert.InDelta(t, 1/1.08, seedPrice("USDT-EUR"), 1e-9)
+}
+
+func TestInstrumentRegistry(t *testing.T) {
+	cfg := &config.Config{
+		Instruments: []config.Instrument{
+			{Symbol: "BTC-USD", AssetClass: "crypto", BaseCurrency: "BTC", QuoteCurrency: "USD", TickSize: 0.5, LotSize: 0.001, PricePrecision: 1},
+		},
+		Symbols: []string{"BTC-USD", "ETH-USD"},
+		CompositeSymbols: []config.CompositeSymbol{
+			{Symbol: "BTC-ETH", Kind: config.CompositeSpread, Legs: []config.CompositeLeg{{Symbol: "BTC-USD", Weight: 1}, {Symbol: "ETH-USD", Weight: 1}}},
+			{Symbol: "BTC/ETH", Kind: config.CompositeRatio, Legs: []config.CompositeLeg{{Symbol: "BTC-USD", Weight: 1}, {Symbol: "ETH-USD", Weight: 1}}},
+		},
+	}
+	registry := NewInstrumentRegistry(cfg)
+
+	// Configured reference data wins over inference
+	btc, exists := registry.Get("BTC-USD")
+	require.True(t, exists)
+	assert.Equal(t, 0.5, btc.TickSize)
+
+	eth, exists := registry.Get("ETH-USD")
+	require.True(t, exists)
+	assert.Equal(t, "crypto", eth.AssetClass)
+
+	spread, exists := registry.Get("BTC-ETH")
+	require.True(t, exists)
+	assert.Equal(t, "composite", spread.AssetClass)
+	assert.Equal(t, "USD", spread.QuoteCurrency)
+	ratio, _ := registry.Get("BTC/ETH")
+	assert.Empty(t, ratio.QuoteCurrency)
+
+	_, exists = registry.Get("BTC-USDT")
+	assert.False(t, exists)
+
+	var symbols []string
+	for _, instrument := range registry.List() {
+		symbols = append(symbols, instrument.Symbol)
+	}
+	assert.Equal(t, []string{"BTC-ETH", "BTC-USD", "BTC/ETH", "ETH-USD"}, symbols)
+}
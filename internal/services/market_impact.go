@@ -0,0 +1,133 @@
+package services
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+)
+
+// ImpactModel is a square-root market impact model. A trade of quantity Q
+// shifts the log price by coefficient × volatility × sqrt(Q / dailyVolume) in
+// the aggressor's direction. The permanent part stays; the temporary part
+// decays with DecayHalfLife.
+type ImpactModel struct {
+	Volatility           float64
+	DailyVolume          float64
+	TemporaryCoefficient float64
+	PermanentCoefficient float64
+	DecayHalfLife        time.Duration
+}
+
+func ImpactModelFromConfig(cfg *config.Config) ImpactModel {
+	return ImpactModel{
+		Volatility:           cfg.ImpactVolatility,
+		DailyVolume:          cfg.ImpactDailyVolume,
+		TemporaryCoefficient: cfg.ImpactTemporaryCoefficient,
+		PermanentCoefficient: cfg.ImpactPermanentCoefficient,
+		DecayHalfLife:        cfg.ImpactDecayHalfLife,
+	}
+}
+
+// Trade is an executed trade reported by the exchange
+type Trade struct {
+	Symbol   string
+	Quantity float64
+	Buy      bool // Aggressor side
+}
+
+// ImpactResult describes the impact of one trade, in log-price units
+type ImpactResult struct {
+	Permanent   float64
+	Temporary   float64 // At trade time
+	LevelBefore float64
+	LevelAfter  float64
+}
+
+// MarketImpact accumulates trade impact per symbol. Its level is a log-price
+// offset that price generators apply on top of their own random walk.
+type MarketImpact struct {
+	model   ImpactModel
+	mu      sync.Mutex
+	symbols map[string]*symbolImpact
+}
+
+type symbolImpact struct {
+	permanent float64
+	temporary float64 // As of updatedAt
+	updatedAt time.Time
+}
+
+func NewMarketImpact(model ImpactModel) *MarketImpact {
+	return &MarketImpact{
+		model:   model,
+		symbols: make(map[string]*symbolImpact),
+	}
+}
+
+// Apply records a trade at the given time and returns its impact
+func (m *MarketImpact) Apply(trade Trade, now time.Time) ImpactResult {
+	size := m.model.size(trade.Quantity)
+	if !trade.Buy {
+		size = -size
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	state, exists := m.symbols[trade.Symbol]
+	if !exists {
+		state = &symbolImpact{updatedAt: now}
+		m.symbols[trade.Symbol] = state
+	}
+
+	result := ImpactResult{
+		Permanent:   m.model.PermanentCoefficient * size,
+		Temporary:   m.model.TemporaryCoefficient * size,
+		LevelBefore: state.level(m.model, now),
+	}
+
+	state.temporary = state.temporary*m.model.decay(now.Sub(state.updatedAt)) + result.Temporary
+	state.permanent += result.Permanent
+	state.updatedAt = now
+
+	result.LevelAfter = state.level(m.model, now)
+	return result
+}
+
+// Level returns the symbol's current log-price impact
+func (m *MarketImpact) Level(symbol string, now time.Time) float64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	state, exists := m.symbols[symbol]
+	if !exists {
+		return 0
+	}
+	return state.level(m.model, now)
+}
+
+func (s *symbolImpact) level(model ImpactModel, now time.Time) float64 {
+	return s.permanent + s.temporary*model.decay(now.Sub(s.updatedAt))
+}
+
+// size is volatility × sqrt(Q / dailyVolume), the unsigned impact before
+// coefficients
+func (m ImpactModel) size(quantity float64) float64 {
+	if m.DailyVolume <= 0 || quantity <= 0 {
+		return 0
+	}
+	return m.Volatility * math.Sqrt(quantity/m.DailyVolume)
+}
+
+// decay is the fraction of temporary impact left after elapsed
+func (m ImpactModel) decay(elapsed time.Duration) float64 {
+	if m.DecayHalfLife <= 0 {
+		return 0
+	}
+	if elapsed <= 0 {
+		return 1
+	}
+	return math.Pow(0.5, float64(elapsed)/float64(m.DecayHalfLife))
+}
@@ -0,0 +1,80 @@
+package services
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testImpactModel() ImpactModel {
+	return ImpactModel{
+		Volatility:           0.02,
+		DailyVolume:          1000000,
+		TemporaryCoefficient: 0.5,
+		PermanentCoefficient: 0.1,
+		DecayHalfLife:        10 * time.Second,
+	}
+}
+
+func TestMarketImpact_SquareRoot(t *testing.T) {
+	impact := NewMarketImpact(testImpactModel())
+	now := time.Now()
+
+	small := impact.Apply(Trade{Symbol: "BTC-USD", Quantity: 10000, Buy: true}, now)
+	large := impact.Apply(Trade{Symbol: "ETH-USD", Quantity: 40000, Buy: true}, now)
+
+	// 0.02 × sqrt(10000 / 1e6) = 0.002
+	assert.InDelta(t, 0.0002, small.Permanent, 1e-12)
+	assert.InDelta(t, 0.001, small.Temporary, 1e-12)
+	// Four times the size, twice the impact
+	assert.InDelta(t, 2*small.Permanent, large.Permanent, 1e-12)
+	assert.InDelta(t, small.LevelAfter*2, large.LevelAfter, 1e-12)
+}
+
+func TestMarketImpact_SellsPushDown(t *testing.T) {
+	impact := NewMarketImpact(testImpactModel())
+	now := time.Now()
+
+	result := impact.Apply(Trade{Symbol: "BTC-USD", Quantity: 10000, Buy: false}, now)
+
+	assert.Less(t, result.Permanent, 0.0)
+	assert.Less(t, result.Temporary, 0.0)
+	assert.Less(t, impact.Level("BTC-USD", now), 0.0)
+}
+
+func TestMarketImpact_TemporaryDecays(t *testing.T) {
+	impact := NewMarketImpact(testImpactModel())
+	now := time.Now()
+
+	result := impact.Apply(Trade{Symbol: "BTC-USD", Quantity: 10000, Buy: true}, now)
+	assert.InDelta(t, result.Permanent+result.Temporary, impact.Level("BTC-USD", now), 1e-12)
+
+	// One half-life later half the temporary impact remains
+	assert.InDelta(t, result.Permanent+result.Temporary/2, impact.Level("BTC-USD", now.Add(10*time.Second)), 1e-12)
+
+	// Long after, only the permanent impact is left
+	assert.InDelta(t, result.Permanent, impact.Level("BTC-USD", now.Add(time.Hour)), 1e-9)
+}
+
+func TestMarketImpact_Accumulates(t *testing.T) {
+	impact := NewMarketImpact(testImpactModel())
+	now := time.Now()
+
+	first := impact.Apply(Trade{Symbol: "BTC-USD", Quantity: 10000, Buy: true}, now)
+	second := impact.Apply(Trade{Symbol: "BTC-USD", Quantity: 10000, Buy: true}, now.Add(10*time.Second))
+
+	assert.InDelta(t, first.Permanent+first.Temporary/2, second.LevelBefore, 1e-12)
+	assert.InDelta(t, 2*first.Permanent+first.Temporary/2+second.Temporary, second.LevelAfter, 1e-12)
+	assert.Equal(t, 0.0, impact.Level("ETH-USD", now), "other symbols are unaffected")
+}
+
+func TestMarketImpact_Disabled(t *testing.T) {
+	impact := NewMarketImpact(ImpactModel{})
+
+	result := impact.Apply(Trade{Symbol: "BTC-USD", Quantity: 10000, Buy: true}, time.Now())
+
+	assert.Equal(t, 0.0, result.LevelAfter)
+	assert.False(t, math.IsNaN(result.LevelAfter))
+}
@@ -1,29 +1,315 @@
 package services
 
 import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
 	"github.com/sirupsen/logrus"
 
 	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
 )
 
 type MarketDataService struct {
-	config *config.Config
-	logger *logrus.Logger
+	config     *config.Config
+	logger     *logrus.Logger
+	universe   *SymbolUniverse
+	impact     *MarketImpact
+	composites map[string]config.CompositeSymbol
+	perpetuals map[string]config.Perpetual
+	futures    []config.FutureSeries
+	options    map[string]config.OptionSeries
+	crosses    map[string]FXCross
+	bonds      map[string]config.Bond
+	registry   *InstrumentRegistry
+	engine     *PriceEngine
+
+	contractsMu sync.Mutex
+	contracts   map[string]time.Time // Listed future contracts in the universe, by expiry
 }
 
 func NewMarketDataService(cfg *config.Config, logger *logrus.Logger) *MarketDataService {
-	return &MarketDataService{
-		config: cfg,
-		logger: logger,
+	// Composites, perpetuals, FX crosses, bonds and what they, futures and
+	// options are priced from are part of the universe from the start;
+	// contracts join as they list
+	symbols := append([]string(nil), cfg.Symbols...)
+	composites := make(map[string]config.CompositeSymbol, len(cfg.CompositeSymbols))
+	for _, composite := range cfg.CompositeSymbols {
+		composites[composite.Symbol] = composite
+		symbols = append(symbols, composite.Symbol)
+		for _, leg := range composite.Legs {
+			symbols = append(symbols, leg.Symbol)
+		}
+	}
+	perpetuals := make(map[string]config.Perpetual, len(cfg.Perpetuals))
+	for _, perpetual := range PerpetualsOf(cfg) {
+		perpetuals[perpetual.Symbol] = perpetual
+		symbols = append(symbols, perpetual.Symbol, perpetual.Index)
+	}
+	futures := FuturesOf(cfg)
+	for _, series := range futures {
+		symbols = append(symbols, series.Underlying)
+	}
+	options := make(map[string]config.OptionSeries, len(cfg.Options))
+	for _, series := range OptionsOf(cfg) {
+		options[series.Root] = series
+		symbols = append(symbols, series.Underlying)
+	}
+	crosses := make(map[string]FXCross, len(cfg.FXCrosses))
+	for _, cross := range CrossesOf(cfg) {
+		crosses[cross.Symbol] = cross
+		symbols = append(append(symbols, cross.Symbol), cross.Factors()...)
+	}
+	bonds := make(map[string]config.Bond, len(cfg.Bonds))
+	for _, bond := range cfg.Bonds {
+		bonds[bond.Symbol] = bond
+		symbols = append(symbols, bond.Symbol)
+	}
+
+	service := &MarketDataService{
+		config:     cfg,
+		logger:     logger,
+		universe:   NewSymbolUniverse(symbols, cfg.SymbolGroups),
+		impact:     NewMarketImpact(ImpactModelFromConfig(cfg)),
+		composites: composites,
+		perpetuals: perpetuals,
+		futures:    futures,
+		options:    options,
+		crosses:    crosses,
+		bonds:      bonds,
+		registry:   NewInstrumentRegistry(cfg),
+		contracts:  make(map[string]time.Time),
 	}
+	service.engine = newPriceEngine(service)
+	// Contracts stop being listed when the shared market passes their expiry
+	service.registry.clock = service.engine.Now
+	service.RollFutures(time.Now())
+	service.ListOptions(time.Now())
+	return service
 }
 
 func (s *MarketDataService) GetPrice(symbol string) (float64, error) {
 	s.logger.WithField("symbol", symbol).Info("Getting price for symbol")
-	return 100.0, nil
+	if _, exists := s.registry.Get(symbol); !exists {
+		return 0, fmt.Errorf("%w: %s", ErrUnknownInstrument, symbol)
+	}
+	return s.engine.Price(symbol), nil
+}
+
+// Run advances the shared market every engine step until the context ends,
+// so the market moves whether or not anyone is streaming
+func (s *MarketDataService) Run(ctx context.Context) {
+	ticker := time.NewTicker(engineStep)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			s.Advance(now)
+		}
+	}
+}
+
+// Advance moves the shared market to the given time and rolls futures at
+// it; streams call it with their tick times, and times already passed are
+// ignored
+func (s *MarketDataService) Advance(at time.Time) {
+	if s.engine.Advance(at) {
+		s.RollFutures(at)
+		s.ListOptions(at)
+	}
+}
+
+// Snapshot returns the given symbols, and what they are priced off, as the
+// shared market stands, with the market events after the given sequence
+func (s *MarketDataService) Snapshot(symbols []string, after uint64) MarketSnapshot {
+	return s.engine.Snapshot(symbols, after)
+}
+
+// WatchVenues keeps the venues quoting the symbols simulated until unwatched;
+// snapshots carry venue offsets only for watched symbols
+func (s *MarketDataService) WatchVenues(symbols []string) {
+	s.engine.WatchVenues(symbols)
+}
+
+// UnwatchVenues releases symbols watched with WatchVenues
+func (s *MarketDataService) UnwatchVenues(symbols []string) {
+	s.engine.UnwatchVenues(symbols)
+}
+
+// EventSequence is the sequence of the latest market event
+func (s *MarketDataService) EventSequence() uint64 {
+	return s.engine.Sequence()
+}
+
+// Composite returns the definition of a composite symbol
+func (s *MarketDataService) Composite(symbol string) (config.CompositeSymbol, bool) {
+	composite, exists := s.composites[symbol]
+	return composite, exists
+}
+
+// Perpetual returns the definition of a perpetual swap
+func (s *MarketDataService) Perpetual(symbol string) (config.Perpetual, bool) {
+	perpetual, exists := s.perpetuals[symbol]
+	return perpetual, exists
+}
+
+// Cross returns the definition of an FX cross
+func (s *MarketDataService) Cross(symbol string) (FXCross, bool) {
+	cross, exists := s.crosses[symbol]
+	return cross, exists
+}
+
+// Bond returns the definition of a bond
+func (s *MarketDataService) Bond(symbol string) (config.Bond, bool) {
+	bond, exists := s.bonds[symbol]
+	return bond, exists
+}
+
+// TradingSchedule returns a symbol's trading schedule, or nil for 24/7 symbols
+func (s *MarketDataService) TradingSchedule(symbol string) *config.TradingSchedule {
+	return tradingSchedule(s.config.TradingSchedules, symbol)
+}
+
+// YieldCurve returns the configured yield curve
+func (s *MarketDataService) YieldCurve() config.YieldCurve {
+	return s.config.YieldCurve
 }
 
+// Future returns the contract a symbol names, whether or not it still trades
+func (s *MarketDataService) Future(symbol string) (FutureContract, bool) {
+	return ParseContract(s.futures, symbol)
+}
+
+// FutureInstrument returns a contract's reference data, also after expiry
+func (s *MarketDataService) FutureInstrument(contract FutureContract) config.Instrument {
+	return s.registry.futureInstrument(contract)
+}
+
+// OptionSeries returns the definition of an option series by root
+func (s *MarketDataService) OptionSeries(root string) (config.OptionSeries, bool) {
+	series, exists := s.options[root]
+	return series, exists
+}
+
+// Option returns the option a symbol names, listed or not
+func (s *MarketDataService) Option(symbol string) (OptionContract, bool) {
+	return ParseOption(s.registry.options.series, symbol)
+}
+
+// ListedOptions returns a series' listed options, nearest expiry first, by
+// strike, call before put
+func (s *MarketDataService) ListedOptions(series config.OptionSeries) []OptionContract {
+	return s.registry.options.contracts(series)
+}
+
+// ListOptions lists each option series' expiries trading at the given time,
+// with strikes around the shared market's underlying, adding the new
+// contracts to the universe and retiring expired ones from it and the engine
+func (s *MarketDataService) ListOptions(at time.Time) {
+	added, removed := s.registry.options.roll(at, s.engine.Price)
+	for _, contract := range added {
+		if s.universe.Add(contract.Symbol) {
+			s.logger.WithFields(logrus.Fields{
+				"symbol": contract.Symbol,
+				"expiry": contract.Expiry,
+			}).Info("Listed option contract")
+		}
+	}
+
+	var retired []string
+	for _, contract := range removed {
+		s.universe.Remove(contract.Symbol)
+		retired = append(retired, contract.Symbol)
+		s.logger.WithFields(logrus.Fields{
+			"symbol": contract.Symbol,
+			"expiry": contract.Expiry,
+		}).Info("Delisted option contract")
+	}
+	if len(retired) > 0 {
+		s.engine.Untrack(retired)
+	}
+}
+
+// RollFutures lists the contracts trading at the given time, adding the
+// expiry that replaces each expired contract to the universe. Expired
+// contracts leave the universe and the engine once their settlement has
+// been retained long enough for every stream to publish it.
+func (s *MarketDataService) RollFutures(at time.Time) {
+	s.contractsMu.Lock()
+	defer s.contractsMu.Unlock()
+
+	for _, series := range s.futures {
+		for _, contract := range ListedContracts(series, at) {
+			if _, listed := s.contracts[contract.Symbol]; listed {
+				continue
+			}
+			s.contracts[contract.Symbol] = contract.Expiry
+			if s.universe.Add(contract.Symbol) {
+				s.logger.WithFields(logrus.Fields{
+					"symbol": contract.Symbol,
+					"expiry": contract.Expiry,
+				}).Info("Listed future contract")
+			}
+		}
+	}
+
+	var retired []string
+	for symbol, expiry := range s.contracts {
+		if at.Before(expiry.Add(settledRetention)) {
+			continue
+		}
+		delete(s.contracts, symbol)
+		s.universe.Remove(symbol)
+		retired = append(retired, symbol)
+		s.logger.WithField("symbol", symbol).Info("Retired settled future contract")
+	}
+	s.engine.Untrack(retired)
+}
+
+// ReportTrade applies the market impact of an executed trade
+func (s *MarketDataService) ReportTrade(trade Trade) ImpactResult {
+	result := s.impact.Apply(trade, time.Now())
+	s.logger.WithFields(logrus.Fields{
+		"symbol":    trade.Symbol,
+		"quantity":  trade.Quantity,
+		"buy":       trade.Buy,
+		"permanent": result.Permanent,
+		"temporary": result.Temporary,
+	}).Info("Applied trade impact")
+	return result
+}
+
+// ImpactLevel returns the log-price offset accrued from reported trades
+func (s *MarketDataService) ImpactLevel(symbol string) float64 {
+	return s.impact.Level(symbol, time.Now())
+}
+
+// Instrument returns a symbol's reference data
+func (s *MarketDataService) Instrument(symbol string) (config.Instrument, bool) {
+	return s.registry.Get(symbol)
+}
+
+// Instruments returns the reference data of every tradable symbol
+func (s *MarketDataService) Instruments() []config.Instrument {
+	return s.registry.List()
+}
+
+// Subscribe registers an explicitly requested symbol with the universe so
+// pattern subscribers pick it up
 func (s *MarketDataService) Subscribe(symbol string) error {
 	s.logger.WithField("symbol", symbol).Info("Subscribing to symbol")
+	if _, exists := s.registry.Get(symbol); !exists {
+		return fmt.Errorf("%w: %s", ErrUnknownInstrument, symbol)
+	}
+	if s.universe.Add(symbol) {
+		s.logger.WithField("symbol", symbol).Info("Added symbol to universe")
+	}
 	return nil
-}
\ No newline at end of file
+}
+
+func (s *MarketDataService) Universe() *SymbolUniverse {
+	return s.universe
+}
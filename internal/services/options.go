@@ -0,0 +1,303 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+)
+
+const (
+	// minVolatility keeps deep wings of a steep smile positive
+	minVolatility = 0.01
+
+	// surfaceReferenceTenor is where VolSurface.ATM applies
+	surfaceReferenceTenor = 30 * 24 * time.Hour
+)
+
+// OptionsOf returns the configured option series whose underlying is a
+// regular symbol
+func OptionsOf(cfg *config.Config) []config.OptionSeries {
+	return builtOnRegular(cfg.Options, isDerived(cfg, derivedComposite, derivedPerpetual, derivedFuture, derivedCross), func(series config.OptionSeries) []string {
+		return []string{series.Underlying}
+	})
+}
+
+// OptionContract is one option of a series
+type OptionContract struct {
+	Symbol string
+	Series config.OptionSeries
+	Expiry time.Time
+	Strike float64
+	Call   bool
+}
+
+// ParseOption finds the option a symbol names, listed or not. The date must
+// be an expiry of the series' cycle and the symbol in OptionSymbol's form.
+func ParseOption(series []config.OptionSeries, symbol string) (OptionContract, bool) {
+	for _, candidate := range series {
+		rest, found := strings.CutPrefix(symbol, candidate.Root+"-")
+		if !found {
+			continue
+		}
+		fields := strings.Split(rest, "-")
+		if len(fields) != 3 || (fields[2] != "C" && fields[2] != "P") {
+			continue
+		}
+		day, err := time.Parse(contractDateLayout, fields[0])
+		if err != nil {
+			continue
+		}
+		strike, err := strconv.ParseFloat(fields[1], 64)
+		if err != nil || strike <= 0 {
+			continue
+		}
+		expiry := Expiries(candidate.Cycle, day.Add(-time.Nanosecond), 1)[0]
+		call := fields[2] == "C"
+		if expiry.Truncate(24*time.Hour).Equal(day) && OptionSymbol(candidate, expiry, strike, call) == symbol {
+			return OptionContract{Symbol: symbol, Series: candidate, Expiry: expiry, Strike: strike, Call: call}, true
+		}
+	}
+	return OptionContract{}, false
+}
+
+// optionListing holds the strikes of each series' listed expiries. Strikes
+// are listed around the underlying when an expiry is first listed and stay
+// fixed until it expires, so every stream and snapshot quotes one chain.
+type optionListing struct {
+	mu      sync.Mutex
+	series  []config.OptionSeries
+	strikes map[string]map[time.Time][]float64 // By series root, then expiry
+}
+
+func newOptionListing(series []config.OptionSeries) *optionListing {
+	listing := &optionListing{series: series, strikes: make(map[string]map[time.Time][]float64)}
+	for _, option := range series {
+		listing.strikes[option.Root] = make(map[time.Time][]float64)
+	}
+	return listing
+}
+
+// roll lists the expiries trading at the given time around their
+// underlying's price and delists expired ones. It returns the contracts
+// newly listed and those delisted.
+func (l *optionListing) roll(at time.Time, spot func(underlying string) float64) ([]OptionContract, []OptionContract) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var added, removed []OptionContract
+	for _, series := range l.series {
+		listed := l.strikes[series.Root]
+		expiries := Expiries(series.Cycle, at, series.Expiries)
+		current := make(map[time.Time]bool, len(expiries))
+		for _, expiry := range expiries {
+			current[expiry] = true
+			if _, exists := listed[expiry]; exists {
+				continue
+			}
+			listed[expiry] = StrikeGrid(spot(series.Underlying), series.StrikeStepPercent, series.Strikes)
+			added = append(added, optionContracts(series, expiry, listed[expiry])...)
+		}
+		for expiry, strikes := range listed {
+			if !current[expiry] {
+				removed = append(removed, optionContracts(series, expiry, strikes)...)
+				delete(listed, expiry)
+			}
+		}
+	}
+	return added, removed
+}
+
+// listed reports whether a contract's expiry and strike are listed
+func (l *optionListing) listed(contract OptionContract) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, strike := range l.strikes[contract.Series.Root][contract.Expiry] {
+		if strike == contract.Strike {
+			return true
+		}
+	}
+	return false
+}
+
+// contracts returns a series' listed options, nearest expiry first, by
+// strike, call before put
+func (l *optionListing) contracts(series config.OptionSeries) []OptionContract {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	listed := l.strikes[series.Root]
+	expiries := make([]time.Time, 0, len(listed))
+	for expiry := range listed {
+		expiries = append(expiries, expiry)
+	}
+	sort.Slice(expiries, func(i, j int) bool { return expiries[i].Before(expiries[j]) })
+
+	var contracts []OptionContract
+	for _, expiry := range expiries {
+		contracts = append(contracts, optionContracts(series, expiry, listed[expiry])...)
+	}
+	return contracts
+}
+
+// optionContracts lists a call and a put at each strike of an expiry
+func optionContracts(series config.OptionSeries, expiry time.Time, strikes []float64) []OptionContract {
+	contracts := make([]OptionContract, 0, 2*len(strikes))
+	for _, strike := range strikes {
+		for _, call := range []bool{true, false} {
+			contracts = append(contracts, OptionContract{
+				Symbol: OptionSymbol(series, expiry, strike, call),
+				Series: series,
+				Expiry: expiry,
+				Strike: strike,
+				Call:   call,
+			})
+		}
+	}
+	return contracts
+}
+
+// OptionTick is an option priced off its underlying at a tick. The quote
+// is the mark less and plus half the volatility spread's worth of vega.
+type OptionTick struct {
+	UnderlyingPrice   float64
+	Forward           float64
+	ATMVolatility     float64 // At the forward
+	ImpliedVolatility float64 // At the strike
+	Mark              float64
+	Bid               float64
+	Ask               float64
+	Greeks            Greeks
+}
+
+// optionTick prices an option off its underlying at the engine's clock
+func (e *PriceEngine) optionTick(contract OptionContract) *OptionTick {
+	cfg := e.service.config
+	spot := e.priceOf(contract.Series.Underlying)
+	untilExpiry := contract.Expiry.Sub(e.clock())
+	forward := spot * math.Exp(cfg.OptionInterestRate*untilExpiry.Hours()/year.Hours())
+	volatility := ImpliedVolatility(cfg.VolSurface, forward, contract.Strike, untilExpiry)
+	mark, greeks := BlackScholes(contract.Call, spot, contract.Strike, untilExpiry, volatility, cfg.OptionInterestRate)
+
+	// Vega is per volatility point, the spread a fraction
+	halfSpread := greeks.Vega * cfg.OptionVolSpread * 100 / 2
+	return &OptionTick{
+		UnderlyingPrice:   spot,
+		Forward:           forward,
+		ATMVolatility:     ImpliedVolatility(cfg.VolSurface, forward, forward, untilExpiry),
+		ImpliedVolatility: volatility,
+		Mark:              mark,
+		Bid:               math.Max(0, mark-halfSpread),
+		Ask:               mark + halfSpread,
+		Greeks:            greeks,
+	}
+}
+
+// Greeks are an option's sensitivities: delta and gamma to the underlying,
+// vega per volatility point, theta per calendar day and rho per percentage
+// point of interest
+type Greeks struct {
+	Delta float64
+	Gamma float64
+	Vega  float64
+	Theta float64
+	Rho   float64
+}
+
+// ImpliedVolatility reads the surface at a strike for an expiry. The term
+// structure is floored at an hour to expiry so it stays finite.
+func ImpliedVolatility(surface config.VolSurface, forward, strike float64, untilExpiry time.Duration) float64 {
+	tenor := max(untilExpiry, time.Hour)
+	atm := surface.ATM + surface.TermSlope*math.Log2(tenor.Hours()/surfaceReferenceTenor.Hours())
+	moneyness := math.Log(strike/forward) / math.Sqrt(tenor.Hours()/year.Hours())
+	return max(minVolatility, atm+surface.Skew*moneyness+surface.Smile*moneyness*moneyness)
+}
+
+// BlackScholes prices a European option on a non-dividend-paying underlying
+// and returns its Greeks. At or past expiry the option is worth its
+// intrinsic value.
+func BlackScholes(call bool, spot, strike float64, untilExpiry time.Duration, volatility, rate float64) (float64, Greeks) {
+	years := untilExpiry.Hours() / year.Hours()
+	if years <= 0 || volatility <= 0 {
+		if call {
+			return math.Max(spot-strike, 0), Greeks{Delta: step(spot > strike)}
+		}
+		return math.Max(strike-spot, 0), Greeks{Delta: -step(strike > spot)}
+	}
+
+	sqrtYears := math.Sqrt(years)
+	d1 := (math.Log(spot/strike) + (rate+volatility*volatility/2)*years) / (volatility * sqrtYears)
+	d2 := d1 - volatility*sqrtYears
+	discount := math.Exp(-rate * years)
+	density := math.Exp(-d1*d1/2) / math.Sqrt(2*math.Pi)
+
+	greeks := Greeks{
+		Gamma: density / (spot * volatility * sqrtYears),
+		Vega:  spot * density * sqrtYears / 100,
+	}
+	decay := -spot * density * volatility / (2 * sqrtYears)
+	if call {
+		greeks.Delta = normalCDF(d1)
+		greeks.Theta = (decay - rate*strike*discount*normalCDF(d2)) / 365
+		greeks.Rho = strike * years * discount * normalCDF(d2) / 100
+		return spot*normalCDF(d1) - strike*discount*normalCDF(d2), greeks
+	}
+	greeks.Delta = normalCDF(d1) - 1
+	greeks.Theta = (decay + rate*strike*discount*normalCDF(-d2)) / 365
+	greeks.Rho = -strike * years * discount * normalCDF(-d2) / 100
+	return strike*discount*normalCDF(-d2) - spot*normalCDF(-d1), greeks
+}
+
+func normalCDF(x float64) float64 {
+	return 0.5 * math.Erfc(-x/math.Sqrt2)
+}
+
+func step(positive bool) float64 {
+	if positive {
+		return 1
+	}
+	return 0
+}
+
+// StrikeGrid lists strikes either side of the one nearest spot, spaced
+// stepPercent of spot apart after rounding the spacing to a round number
+func StrikeGrid(spot, stepPercent float64, perSide int) []float64 {
+	spacing := roundNumber(spot * stepPercent / 100)
+	decimals := decimalPlaces(spacing)
+	atm := math.Round(spot / spacing)
+
+	var strikes []float64
+	for i := -perSide; i <= perSide; i++ {
+		strike := roundTo((atm+float64(i))*spacing, spacing, decimals, math.Round)
+		if strike > 0 {
+			strikes = append(strikes, strike)
+		}
+	}
+	return strikes
+}
+
+// roundNumber is the 1, 2, 2.5 or 5 multiple of a power of ten nearest x
+func roundNumber(x float64) float64 {
+	magnitude := math.Pow10(int(math.Floor(math.Log10(x))))
+	best := magnitude
+	for _, multiple := range []float64{2, 2.5, 5, 10} {
+		if candidate := multiple * magnitude; math.Abs(candidate-x) < math.Abs(best-x) {
+			best = candidate
+		}
+	}
+	return best
+}
+
+// OptionSymbol names an option, e.g. "BTC-OPT-20240329-65000-C"
+func OptionSymbol(series config.OptionSeries, expiry time.Time, strike float64, call bool) string {
+	side := "P"
+	if call {
+		side = "C"
+	}
+	return fmt.Sprintf("%s-%s-%s-%s", series.Root, expiry.UTC().Format(contractDateLayout), strconv.FormatFloat(strike, 'f', -1, 64), side)
+}
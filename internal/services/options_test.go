@@ -0,0 +1,185 @@
+package services
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+)
+
+func TestImpliedVolatility(t *testing.T) {
+	surface := config.VolSurface{ATM: 0.6, TermSlope: -0.02, Skew: -0.1, Smile: 0.3}
+	month := 30 * 24 * time.Hour
+
+	assert.InDelta(t, 0.6, ImpliedVolatility(surface, 100, 100, month), 1e-12)
+	assert.InDelta(t, 0.58, ImpliedVolatility(surface, 100, 100, 2*month), 1e-12, "term slope per doubling")
+
+	// Puts' wing sits above the calls' wing at the same distance
+	low, high := ImpliedVolatility(surface, 100, 80, month), ImpliedVolatility(surface, 100, 125, month)
+	assert.Greater(t, low, 0.6)
+	assert.Greater(t, high, 0.6)
+	assert.Greater(t, low, high)
+
+	// The smile flattens with time
+	assert.Less(t, ImpliedVolatility(surface, 100, 80, 12*month)-ImpliedVolatility(surface, 100, 100, 12*month), low-0.6)
+
+	assert.Equal(t, minVolatility, ImpliedVolatility(config.VolSurface{ATM: 0.1, Skew: -2}, 100, 200, month))
+}
+
+func TestBlackScholes(t *testing.T) {
+	untilExpiry := year / 4
+	call, callGreeks := BlackScholes(true, 100, 105, untilExpiry, 0.5, 0.05)
+	put, putGreeks := BlackScholes(false, 100, 105, untilExpiry, 0.5, 0.05)
+
+	// Put-call parity
+	assert.InDelta(t, 100-105*math.Exp(-0.05*0.25), call-put, 1e-9)
+	assert.InDelta(t, 1, callGreeks.Delta-putGreeks.Delta, 1e-12)
+	assert.InDelta(t, callGreeks.Gamma, putGreeks.Gamma, 1e-12)
+	assert.InDelta(t, callGreeks.Vega, putGreeks.Vega, 1e-12)
+	assert.InDelta(t, 8.39, call, 0.01)
+
+	assert.Less(t, callGreeks.Theta, 0.0)
+	assert.Greater(t, callGreeks.Rho, 0.0)
+	assert.Less(t, putGreeks.Rho, 0.0)
+
+	// Vega is per volatility point
+	bumped, _ := BlackScholes(true, 100, 105, untilExpiry, 0.51, 0.05)
+	assert.InDelta(t, callGreeks.Vega, bumped-call, 0.001)
+
+	// At expiry only intrinsic value is left
+	price, greeks := BlackScholes(false, 100, 105, 0, 0.5, 0.05)
+	assert.Equal(t, 5.0, price)
+	assert.Equal(t, -1.0, greeks.Delta)
+}
+
+func TestStrikeGrid(t *testing.T) {
+	assert.Equal(t, []float64{60000, 62500, 65000, 67500, 70000}, StrikeGrid(65300, 4, 2))
+	assert.Equal(t, []float64{1.05, 1.1, 1.15}, StrikeGrid(1.0987, 4, 1))
+	assert.Equal(t, []float64{10, 20}, StrikeGrid(10, 80, 1), "non-positive strikes are not listed")
+}
+
+func TestOptionSymbol(t *testing.T) {
+	series := config.OptionSeries{Root: "BTC-OPT"}
+	expiry := time.Date(2024, 3, 29, 8, 0, 0, 0, time.UTC)
+	assert.Equal(t, "BTC-OPT-20240329-62500-C", OptionSymbol(series, expiry, 62500, true))
+	assert.Equal(t, "BTC-OPT-20240329-1.15-P", OptionSymbol(series, expiry, 1.15, false))
+}
+
+func TestOptionsOf(t *testing.T) {
+	cfg := &config.Config{
+		CompositeSymbols: []config.CompositeSymbol{{Symbol: "BASKET"}},
+		Perpetuals:       []config.Perpetual{{Symbol: "BTC-PERP", Index: "BTC-USD"}},
+		Futures:          []config.FutureSeries{{Root: "BTC-FUT", Underlying: "BTC-USD", Cycle: config.FutureCycleMonthly}},
+		Options: []config.OptionSeries{
+			{Root: "BTC-OPT", Underlying: "BTC-USD"},
+			{Root: "BASKET-OPT", Underlying: "BASKET"},
+			{Root: "PERP-OPT", Underlying: "BTC-PERP"},
+			{Root: "FUT-OPT", Underlying: "BTC-FUT-20240329"},
+		},
+	}
+	series := OptionsOf(cfg)
+	assert.Len(t, series, 1)
+	assert.Equal(t, "BTC-OPT", series[0].Root)
+}
+
+func TestParseOption(t *testing.T) {
+	series := []config.OptionSeries{{Root: "BTC-OPT", Underlying: "BTC-USD", Cycle: config.FutureCycleMonthly}}
+
+	contract, exists := ParseOption(series, "BTC-OPT-20240329-62500-C")
+	require.True(t, exists)
+	assert.Equal(t, time.Date(2024, 3, 29, 8, 0, 0, 0, time.UTC), contract.Expiry)
+	assert.Equal(t, 62500.0, contract.Strike)
+	assert.True(t, contract.Call)
+
+	contract, exists = ParseOption(series, "BTC-OPT-20240329-1.15-P")
+	require.True(t, exists)
+	assert.False(t, contract.Call)
+
+	for _, symbol := range []string{"BTC-OPT-20240328-62500-C", "BTC-OPT-20240329-62500.0-C", "BTC-OPT-20240329-0-C", "BTC-OPT-20240329-62500-X", "BTC-OPT-20240329", "BTC-USD"} {
+		_, exists := ParseOption(series, symbol)
+		assert.False(t, exists, symbol)
+	}
+}
+
+func TestMarketDataService_Options(t *testing.T) {
+	series := config.OptionSeries{Root: "BTC-OPT", Underlying: "BTC-USD", Cycle: config.FutureCycleMonthly, Expiries: 2, Strikes: 1, StrikeStepPercent: 10}
+	cfg := &config.Config{
+		Symbols:         []string{"BTC-USD"},
+		Options:         []config.OptionSeries{series},
+		VolSurface:      config.VolSurface{ATM: 0.5},
+		OptionVolSpread: 0.01,
+	}
+	service := NewMarketDataService(cfg, logrus.New())
+
+	// Listed around the shared underlying, a call and a put per strike
+	listed := service.ListedOptions(series)
+	require.Len(t, listed, 12)
+	assert.Equal(t, 90.0, listed[0].Strike)
+	assert.True(t, listed[0].Call)
+	assert.False(t, listed[1].Call)
+	assert.True(t, listed[0].Expiry.Before(listed[6].Expiry))
+
+	instrument, exists := service.Instrument(listed[0].Symbol)
+	require.True(t, exists)
+	assert.Equal(t, "option", instrument.AssetClass)
+	assert.True(t, service.Universe().Contains(listed[0].Symbol))
+	_, exists = service.Instrument(OptionSymbol(series, listed[0].Expiry, 95, true))
+	assert.False(t, exists, "strikes off the listing are not instruments")
+
+	// The engine prices options off the underlying it walks
+	service.Advance(time.Now())
+	market := service.Snapshot([]string{listed[0].Symbol}, 0)
+	tick := market.Ticks[listed[0].Symbol]
+	require.NotNil(t, tick.Option)
+	assert.Equal(t, market.Price("BTC-USD"), tick.Option.UnderlyingPrice)
+	assert.Equal(t, tick.Option.Mark, tick.Price)
+	assert.Less(t, tick.Option.Bid, tick.Option.Ask)
+
+	// Strikes stay fixed while the underlying moves; expiries roll with the market
+	front := listed[0].Expiry
+	service.Advance(front.Add(-time.Hour))
+	service.Advance(front)
+	rolled := service.ListedOptions(series)
+	require.Len(t, rolled, 12)
+	assert.Equal(t, listed[6].Symbol, rolled[0].Symbol)
+	_, exists = service.Instrument(listed[0].Symbol)
+	assert.False(t, exists, "expired options are delisted")
+}
+
+func TestMarketDataService_RetiresDelistedOptions(t *testing.T) {
+	series := config.OptionSeries{Root: "BTC-OPT", Underlying: "BTC-USD", Cycle: config.FutureCycleMonthly, Expiries: 2, Strikes: 1, StrikeStepPercent: 10}
+	cfg := &config.Config{
+		Symbols:         []string{"BTC-USD"},
+		Options:         []config.OptionSeries{series},
+		VolSurface:      config.VolSurface{ATM: 0.5},
+		OptionVolSpread: 0.01,
+	}
+	service := NewMarketDataService(cfg, logrus.New())
+
+	listed := service.ListedOptions(series)
+	symbols := make([]string, 0, len(listed))
+	for _, contract := range listed {
+		symbols = append(symbols, contract.Symbol)
+	}
+	service.Snapshot(symbols, 0)
+	tracked := len(service.engine.order)
+
+	// Delisted contracts leave the universe and the engine at expiry
+	front := listed[0].Expiry
+	service.Advance(front)
+	for _, contract := range listed[:6] {
+		assert.False(t, service.Universe().Contains(contract.Symbol))
+		assert.NotContains(t, service.engine.symbols, contract.Symbol)
+	}
+	assert.Len(t, service.engine.order, tracked-6)
+	assert.Contains(t, service.engine.symbols, "BTC-USD", "the underlying is still tracked")
+
+	// however many expiries roll by
+	service.Advance(listed[6].Expiry)
+	assert.Len(t, service.engine.order, 1, "only the underlying is left")
+}
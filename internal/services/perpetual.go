@@ -0,0 +1,139 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+)
+
+// fundingClamp bounds how far the interest component can pull the funding
+// rate away from the premium, as on the major perpetual venues
+const fundingClamp = 0.0005
+
+// PerpetualsOf returns the configured perpetuals whose index is a regular
+// symbol; a composite index is not simulated ahead of the swap
+func PerpetualsOf(cfg *config.Config) []config.Perpetual {
+	return builtOnRegular(cfg.Perpetuals, isDerived(cfg, derivedComposite), func(perpetual config.Perpetual) []string {
+		return []string{perpetual.Symbol, perpetual.Index}
+	})
+}
+
+// FundingRate is the rate longs pay shorts at a funding time, per funding
+// interval, from the average premium of the swap over its index. The
+// interest rate applies unless the premium outweighs it by more than the
+// clamp, and the result is capped either way.
+func FundingRate(premium, interest, limit float64) float64 {
+	rate := premium + math.Max(-fundingClamp, math.Min(fundingClamp, interest-premium))
+	return math.Max(-limit, math.Min(limit, rate))
+}
+
+// NextFunding is the first funding time after at. Funding runs on multiples
+// of the interval from midnight UTC, so an 8h interval funds at 00:00, 08:00
+// and 16:00. Intervals that do not divide a day restart at midnight.
+func NextFunding(at time.Time, interval time.Duration) time.Time {
+	at = at.UTC()
+	midnight := time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, time.UTC)
+	next := midnight.Add(at.Sub(midnight).Truncate(interval) + interval)
+	if tomorrow := midnight.AddDate(0, 0, 1); next.After(tomorrow) {
+		return tomorrow
+	}
+	return next
+}
+
+// MarkPrice marks a perpetual at its index plus the part of the predicted
+// funding that is still to accrue before the next funding time. Marking off
+// the index rather than the last trade keeps liquidations immune to a
+// squeeze on the swap alone.
+func MarkPrice(index, fundingRate float64, untilFunding, interval time.Duration) float64 {
+	return index * (1 + fundingRate*untilFunding.Seconds()/interval.Seconds())
+}
+
+// perpetualState is a perpetual's basis and funding accrual
+type perpetualState struct {
+	basisBps    float64       // Price over the index
+	updatedAt   time.Time     // Zero until the engine first steps the swap
+	premium     float64       // Premium × seconds accrued this funding interval
+	accrued     time.Duration // Time the premium was accrued over
+	nextFunding time.Time
+	lastRate    float64
+	lastFunding time.Time // Zero until the first funding
+}
+
+// PerpetualTick is a perpetual's state at a tick
+type PerpetualTick struct {
+	IndexPrice      float64
+	MarkPrice       float64
+	BasisBps        float64
+	FundingRate     float64
+	NextFunding     time.Time
+	LastFundingRate float64
+	LastFunding     time.Time // Zero until the first funding
+}
+
+// stepPerpetual moves a perpetual's basis and settles funding once a funding
+// time has passed. The basis reverts to zero with the configured half-life;
+// the noise term keeps its spread at PerpetualBasisBps whatever the step.
+func (e *PriceEngine) stepPerpetual(symbol string, perpetual config.Perpetual, state *perpetualState, at time.Time) {
+	cfg := e.service.config
+	if state.updatedAt.IsZero() {
+		state.updatedAt = at
+		state.nextFunding = NextFunding(at, perpetual.FundingInterval)
+	}
+	if elapsed := at.Sub(state.updatedAt); elapsed > 0 {
+		decay := math.Exp(-math.Ln2 * elapsed.Seconds() / cfg.PerpetualBasisHalfLife.Seconds())
+		state.basisBps = state.basisBps*decay + rand.NormFloat64()*cfg.PerpetualBasisBps*math.Sqrt(1-decay*decay)
+		state.premium += state.basisBps / 10000 * elapsed.Seconds()
+		state.accrued += elapsed
+		state.updatedAt = at
+	}
+
+	if at.Before(state.nextFunding) {
+		return
+	}
+	state.lastRate = e.fundingRate(state)
+	state.lastFunding = state.nextFunding
+	state.premium, state.accrued = 0, 0
+	state.nextFunding = NextFunding(at, perpetual.FundingInterval)
+
+	payer := "longs pay shorts"
+	if state.lastRate < 0 {
+		payer = "shorts pay longs"
+	}
+	e.record(symbol, MarketEvent{
+		Type:   EventFunding,
+		Reason: fmt.Sprintf("funding of %.4f%% settled, %s", state.lastRate*100, payer),
+	})
+}
+
+// fundingRate is the rate the premium accrued so far this interval implies,
+// using the current basis until any time has accrued
+func (e *PriceEngine) fundingRate(state *perpetualState) float64 {
+	cfg := e.service.config
+	premium := state.basisBps / 10000
+	if state.accrued > 0 {
+		premium = state.premium / state.accrued.Seconds()
+	}
+	return FundingRate(premium, cfg.PerpetualInterestRate, cfg.PerpetualFundingCap)
+}
+
+// perpetualTick reports a perpetual priced off its index
+func (e *PriceEngine) perpetualTick(perpetual config.Perpetual, state *perpetualState) *PerpetualTick {
+	index := e.priceOf(perpetual.Index)
+	nextFunding := state.nextFunding
+	if nextFunding.IsZero() {
+		nextFunding = NextFunding(e.clock(), perpetual.FundingInterval)
+	}
+	rate := e.fundingRate(state)
+	return &PerpetualTick{
+		IndexPrice:      index,
+		MarkPrice:       MarkPrice(index, rate, nextFunding.Sub(e.clock()), perpetual.FundingInterval),
+		BasisBps:        state.basisBps,
+		FundingRate:     rate,
+		NextFunding:     nextFunding,
+		LastFundingRate: state.lastRate,
+		LastFunding:     state.lastFunding,
+	}
+}
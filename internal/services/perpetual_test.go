@@ -0,0 +1,87 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+)
+
+func TestFundingRate(t *testing.T) {
+	// Premiums near the interest rate fund at the interest rate
+	assert.InDelta(t, 0.0001, FundingRate(0.0003, 0.0001, 0.0075), 1e-12)
+	assert.InDelta(t, 0.0001, FundingRate(-0.0002, 0.0001, 0.0075), 1e-12)
+
+	// Beyond the clamp the premium dominates
+	assert.InDelta(t, 0.002-0.0005, FundingRate(0.002, 0.0001, 0.0075), 1e-12)
+	assert.InDelta(t, -0.002+0.0005, FundingRate(-0.002, 0.0001, 0.0075), 1e-12)
+
+	// And the result is capped
+	assert.Equal(t, 0.0075, FundingRate(0.05, 0.0001, 0.0075))
+	assert.Equal(t, -0.0075, FundingRate(-0.05, 0.0001, 0.0075))
+}
+
+func TestNextFunding(t *testing.T) {
+	at := time.Date(2024, 3, 1, 9, 15, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2024, 3, 1, 16, 0, 0, 0, time.UTC), NextFunding(at, 8*time.Hour))
+	assert.Equal(t, time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC), NextFunding(at, time.Hour))
+
+	// A funding time itself looks ahead to the next one
+	assert.Equal(t, time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC), NextFunding(time.Date(2024, 3, 1, 16, 0, 0, 0, time.UTC), 8*time.Hour))
+
+	// Other zones are converted to UTC
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	assert.True(t, NextFunding(at.In(berlin), 8*time.Hour).Equal(time.Date(2024, 3, 1, 16, 0, 0, 0, time.UTC)))
+
+	// Intervals that do not divide a day count from midnight and restart there
+	assert.Equal(t, time.Date(2024, 3, 1, 14, 0, 0, 0, time.UTC), NextFunding(at, 7*time.Hour))
+	assert.Equal(t, time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC), NextFunding(time.Date(2024, 3, 1, 22, 0, 0, 0, time.UTC), 7*time.Hour))
+	assert.Equal(t, time.Date(2024, 3, 1, 9, 20, 0, 0, time.UTC), NextFunding(at, 40*time.Minute))
+}
+
+func TestMarkPrice(t *testing.T) {
+	assert.Equal(t, 100.0, MarkPrice(100, 0.001, 0, 8*time.Hour))
+	assert.InDelta(t, 100.05, MarkPrice(100, 0.001, 4*time.Hour, 8*time.Hour), 1e-9)
+	assert.InDelta(t, 99.9, MarkPrice(100, -0.001, 8*time.Hour, 8*time.Hour), 1e-9)
+}
+
+func TestMarketDataService_Perpetuals(t *testing.T) {
+	cfg := &config.Config{
+		Symbols: []string{"BTC-USD"},
+		CompositeSymbols: []config.CompositeSymbol{
+			{Symbol: "IDX", Kind: config.CompositeBasket, Legs: []config.CompositeLeg{{Symbol: "BTC-USD", Weight: 1}}},
+		},
+		Perpetuals: []config.Perpetual{
+			{Symbol: "BTC-PERP", Index: "BTC-USD", FundingInterval: 8 * time.Hour},
+			{Symbol: "IDX-PERP", Index: "IDX", FundingInterval: 8 * time.Hour},
+		},
+	}
+	service := NewMarketDataService(cfg, logrus.New())
+
+	perpetual, exists := service.Perpetual("BTC-PERP")
+	require.True(t, exists)
+	assert.Equal(t, "BTC-USD", perpetual.Index)
+	assert.True(t, service.Universe().Contains("BTC-PERP"))
+
+	// Perpetuals take their index's increments
+	instrument, exists := service.Instrument("BTC-PERP")
+	require.True(t, exists)
+	assert.Equal(t, "perpetual", instrument.AssetClass)
+	assert.Equal(t, "USD", instrument.QuoteCurrency)
+	assert.Equal(t, 0.01, instrument.TickSize)
+
+	price, err := service.GetPrice("BTC-PERP")
+	require.NoError(t, err)
+	assert.Equal(t, 100.0, price)
+
+	// Composite indices are not supported
+	_, exists = service.Perpetual("IDX-PERP")
+	assert.False(t, exists)
+	_, exists = service.Instrument("IDX-PERP")
+	assert.False(t, exists)
+}
@@ -0,0 +1,142 @@
+package services
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+)
+
+// bandHistoryResolution is how many reference points are kept per window
+const bandHistoryResolution = 100
+
+// TradingStatus is a symbol's trading status; values match the API's
+type TradingStatus int32
+
+const (
+	StatusTrading   TradingStatus = 0
+	StatusHalted    TradingStatus = 1
+	StatusLimitUp   TradingStatus = 2
+	StatusLimitDown TradingStatus = 3
+)
+
+// bandState enforces a limit-up/limit-down rule on one symbol's prices
+type bandState struct {
+	rule      config.PriceBandRule
+	history   []pricePoint // Oldest first, thinned to the history resolution
+	status    TradingStatus
+	haltUntil time.Time
+}
+
+type pricePoint struct {
+	at    time.Time
+	price float64
+}
+
+// bandRule returns the first rule covering the symbol
+func bandRule(rules []config.PriceBandRule, symbol string) (config.PriceBandRule, bool) {
+	for _, rule := range rules {
+		if MatchSymbol(rule.Symbol, symbol) {
+			return rule, true
+		}
+	}
+	return config.PriceBandRule{}, false
+}
+
+// halted reports whether the symbol is halted at the given time. When a halt
+// has just expired it returns the resume event instead.
+func (s *bandState) halted(price float64, at time.Time) (bool, *MarketEvent) {
+	if s == nil || s.status != StatusHalted {
+		return false, nil
+	}
+	if at.Before(s.haltUntil) {
+		return true, nil
+	}
+
+	// Trading resumes with a fresh window anchored at the halt price
+	s.status = StatusTrading
+	s.history = []pricePoint{{at: at, price: price}}
+	low, high := s.band(price)
+	return false, &MarketEvent{
+		Type:           EventResume,
+		Status:         StatusTrading,
+		Reason:         "halt period ended",
+		Price:          price,
+		ReferencePrice: price,
+		BandLow:        low,
+		BandHigh:       high,
+	}
+}
+
+// enforce checks a new price against the band. It returns the price to print
+// (clamped at the band on a breach), the resulting trading status and an
+// event when the status changed.
+func (s *bandState) enforce(previous, price float64, at time.Time) (float64, TradingStatus, *MarketEvent) {
+	if s == nil {
+		return price, StatusTrading, nil
+	}
+
+	reference := s.reference(previous, at)
+	low, high := s.band(reference)
+
+	status := StatusTrading
+	switch {
+	case price > high:
+		price, status = high, StatusLimitUp
+	case price < low:
+		price, status = low, StatusLimitDown
+	}
+	event := &MarketEvent{Status: status, Price: price, ReferencePrice: reference, BandLow: low, BandHigh: high}
+
+	if status != StatusTrading && s.rule.Halt {
+		status = StatusHalted
+		s.haltUntil = at.Add(s.rule.HaltDuration)
+		event.Type = EventHalt
+		event.Status = status
+		event.Reason = fmt.Sprintf("moved more than %.2f%% within %v", s.rule.MovePercent, s.rule.Window)
+		event.ResumeAt = s.haltUntil
+	}
+
+	previousStatus := s.status
+	s.status = status
+	s.record(price, at)
+
+	switch {
+	case event.Type == EventHalt:
+		return price, status, event
+	case status != StatusTrading && previousStatus != status:
+		event.Type = EventLimitReached
+		event.Reason = fmt.Sprintf("price held at the %.2f%% band", s.rule.MovePercent)
+		return price, status, event
+	case status == StatusTrading && previousStatus != StatusTrading:
+		event.Type = EventLimitReleased
+		event.Reason = "price back within band"
+		return price, status, event
+	}
+	return price, status, nil
+}
+
+// reference is the price at the start of the rolling window
+func (s *bandState) reference(previous float64, at time.Time) float64 {
+	cutoff := at.Add(-s.rule.Window)
+	for len(s.history) > 1 && s.history[1].at.Before(cutoff) {
+		s.history = s.history[1:]
+	}
+	if len(s.history) == 0 {
+		s.history = append(s.history, pricePoint{at: at, price: previous})
+	}
+	return s.history[0].price
+}
+
+func (s *bandState) band(reference float64) (float64, float64) {
+	move := reference * s.rule.MovePercent / 100
+	return reference - move, reference + move
+}
+
+func (s *bandState) record(price float64, at time.Time) {
+	step := s.rule.Window / bandHistoryResolution
+	if n := len(s.history); n > 0 && at.Sub(s.history[n-1].at) < step {
+		return
+	}
+	s.history = append(s.history, pricePoint{at: at, price: price})
+}
@@ -0,0 +1,101 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+)
+
+func newBand(rule config.PriceBandRule) *bandState {
+	return &bandState{rule: rule}
+}
+
+func TestPriceBands_NoRule(t *testing.T) {
+	var band *bandState
+
+	price, status, event := band.enforce(100, 150, time.Now())
+	assert.Equal(t, 150.0, price)
+	assert.Equal(t, StatusTrading, status)
+	assert.Nil(t, event)
+
+	halted, resume := band.halted(100, time.Now())
+	assert.False(t, halted)
+	assert.Nil(t, resume)
+
+	_, exists := bandRule([]config.PriceBandRule{{Symbol: "*-USD"}}, "ETH-BTC")
+	assert.False(t, exists, "symbols without a matching rule are unrestricted")
+}
+
+func TestPriceBands_Clamp(t *testing.T) {
+	rule, exists := bandRule([]config.PriceBandRule{{Symbol: "*-USD", MovePercent: 5, Window: time.Minute}}, "BTC-USD")
+	require.True(t, exists)
+	band := newBand(rule)
+	start := time.Now()
+
+	price, status, event := band.enforce(100, 110, start)
+	assert.Equal(t, 105.0, price)
+	assert.Equal(t, StatusLimitUp, status)
+	require.NotNil(t, event)
+	assert.Equal(t, EventLimitReached, event.Type)
+	assert.Equal(t, 100.0, event.ReferencePrice)
+	assert.Equal(t, 95.0, event.BandLow)
+
+	// Still pinned: no new event
+	price, status, event = band.enforce(105, 107, start.Add(time.Second))
+	assert.Equal(t, 105.0, price)
+	assert.Equal(t, StatusLimitUp, status)
+	assert.Nil(t, event)
+
+	price, status, event = band.enforce(105, 101, start.Add(2*time.Second))
+	assert.Equal(t, 101.0, price)
+	assert.Equal(t, StatusTrading, status)
+	require.NotNil(t, event)
+	assert.Equal(t, EventLimitReleased, event.Type)
+
+	price, status, _ = band.enforce(101, 80, start.Add(3*time.Second))
+	assert.Equal(t, 95.0, price)
+	assert.Equal(t, StatusLimitDown, status)
+}
+
+func TestPriceBands_RollingWindow(t *testing.T) {
+	band := newBand(config.PriceBandRule{Symbol: "BTC-USD", MovePercent: 5, Window: time.Minute})
+	start := time.Now()
+
+	band.enforce(100, 104, start)
+	band.enforce(104, 104, start.Add(30*time.Second))
+
+	// Once the window has moved past the 100 reference, 104 anchors the band
+	price, status, _ := band.enforce(104, 108, start.Add(91*time.Second))
+	assert.Equal(t, 108.0, price)
+	assert.Equal(t, StatusTrading, status)
+}
+
+func TestPriceBands_Halt(t *testing.T) {
+	band := newBand(config.PriceBandRule{Symbol: "BTC-USD", MovePercent: 5, Window: time.Minute, Halt: true, HaltDuration: time.Minute})
+	start := time.Now()
+
+	price, status, event := band.enforce(100, 90, start)
+	assert.Equal(t, 95.0, price)
+	assert.Equal(t, StatusHalted, status)
+	require.NotNil(t, event)
+	assert.Equal(t, EventHalt, event.Type)
+	assert.True(t, start.Add(time.Minute).Equal(event.ResumeAt))
+
+	halted, resume := band.halted(price, start.Add(30*time.Second))
+	assert.True(t, halted)
+	assert.Nil(t, resume)
+
+	halted, resume = band.halted(price, start.Add(time.Minute))
+	assert.False(t, halted)
+	require.NotNil(t, resume)
+	assert.Equal(t, EventResume, resume.Type)
+	assert.Equal(t, 95.0, resume.ReferencePrice)
+
+	// The band is re-anchored at the halt price
+	_, status, _ = band.enforce(95, 92, start.Add(61*time.Second))
+	assert.Equal(t, StatusTrading, status)
+}
@@ -0,0 +1,528 @@
+package services
+
+import (
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+)
+
+const (
+	// engineStep is the tick the random walk is calibrated to: up to ±0.5%
+	// per step, scaled by the square root of the time actually elapsed
+	engineStep = 100 * time.Millisecond
+
+	// maxEngineGap caps the time one advance simulates, so the market does
+	// not jump when the engine resumes after sitting idle
+	maxEngineGap = time.Second
+
+	// eventLogSize bounds the market events kept for streams to catch up on
+	eventLogSize = 4096
+)
+
+// MarketEventType identifies a market event; values match the API's
+type MarketEventType int32
+
+const (
+	EventHalt          MarketEventType = 1
+	EventResume        MarketEventType = 2
+	EventLimitReached  MarketEventType = 3
+	EventLimitReleased MarketEventType = 4
+	EventFunding       MarketEventType = 6
+	EventSettlement    MarketEventType = 7
+	EventRateShock     MarketEventType = 8
+)
+
+// MarketEvent is a change in the market that streams report once
+type MarketEvent struct {
+	Sequence       uint64
+	Symbol         string // Empty for curve-wide events
+	Type           MarketEventType
+	Reason         string
+	At             time.Time
+	Status         TradingStatus // After the event
+	Price          float64
+	ReferencePrice float64 // Band events only
+	BandLow        float64
+	BandHigh       float64
+	ResumeAt       time.Time // Halts only
+}
+
+// MarketTick is one symbol's state in a snapshot
+type MarketTick struct {
+	Price     float64
+	Status    TradingStatus
+	Scheduled bool // Trades to a schedule rather than around the clock
+	Phase     TradingPhase
+	PhaseEnd  time.Time
+	Auction   *AuctionState // While an auction runs
+	Uncrossed float64       // Volume matched at the last uncross
+	Perpetual *PerpetualTick
+	Future    *FutureTick
+	Cross     *CrossTick
+	Bond      *BondTick
+	Option    *OptionTick
+	VenueBps  map[string]float64 // Each venue's mid relative to the price; nil without venues
+}
+
+// MarketSnapshot is the market at the engine's clock
+type MarketSnapshot struct {
+	At       time.Time
+	Ticks    map[string]MarketTick
+	Events   []MarketEvent // Recorded after the sequence asked for, oldest first
+	Sequence uint64        // Of the latest event recorded
+	Curve    CurveFactors  // The yield curve bonds are priced off
+}
+
+// Price returns a symbol's price in the snapshot
+func (m MarketSnapshot) Price(symbol string) float64 {
+	return m.Ticks[symbol].Price
+}
+
+// EventsOf returns the snapshot's events of a type for a symbol; curve-wide
+// events have no symbol
+func (m MarketSnapshot) EventsOf(symbol string, eventType MarketEventType) []MarketEvent {
+	var events []MarketEvent
+	for _, event := range m.Events {
+		if event.Symbol == symbol && event.Type == eventType {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+// PriceEngine is the one simulated market every stream and unary RPC reads.
+// It walks each symbol it has been asked about, prices derived symbols off
+// their inputs and records market events. Any number of callers advance it;
+// it only ever moves forward.
+type PriceEngine struct {
+	mu       sync.Mutex
+	service  *MarketDataService
+	now      time.Time // Zero until the first advance
+	symbols  map[string]*symbolState
+	order    []string // Tracked symbols, each after what it is priced off
+	curve    *curveState
+	watchers map[string]int // Venue streams and requests quoting each symbol's venues
+	events   []MarketEvent  // Oldest first
+	sequence uint64         // Of the latest event
+}
+
+// symbolState is one tracked symbol. Regular symbols random-walk; the rest
+// are priced off their inputs.
+type symbolState struct {
+	symbol     string
+	price      float64
+	impactSeen float64 // Market impact already reflected in price
+	status     TradingStatus
+	inputs     []string
+
+	band     *bandState              // nil when no rule covers the symbol
+	schedule *config.TradingSchedule // nil for 24/7 symbols
+	phase    TradingPhase
+	phaseEnd time.Time
+	auction  *AuctionState
+	uncross  float64
+	venues   *venueState // nil unless venues are configured and watched
+
+	composite   *config.CompositeSymbol
+	perpetual   *config.Perpetual
+	funding     *perpetualState
+	contract    *FutureContract
+	future      *futureState
+	cross       *FXCross
+	dislocation *crossState
+	bond        *config.Bond
+	option      *OptionContract
+}
+
+func (s *symbolState) regular() bool {
+	return s.composite == nil && s.perpetual == nil && s.contract == nil && s.cross == nil && s.bond == nil && s.option == nil
+}
+
+func newPriceEngine(service *MarketDataService) *PriceEngine {
+	return &PriceEngine{
+		service:  service,
+		symbols:  make(map[string]*symbolState),
+		curve:    newCurveState(service.config.YieldCurve),
+		watchers: make(map[string]int),
+	}
+}
+
+// Advance moves the market to the given time. Times at or before the
+// engine's clock are ignored, so streams ticking on their own grids share
+// one path. The first advance starts the clock without moving prices.
+func (e *PriceEngine) Advance(at time.Time) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	var elapsed time.Duration
+	if !e.now.IsZero() {
+		if !at.After(e.now) {
+			return false
+		}
+		elapsed = at.Sub(e.now)
+	}
+	e.now = at
+	steps := float64(min(elapsed, maxEngineGap)) / float64(engineStep)
+
+	e.stepCurve(at)
+	for _, symbol := range e.order {
+		e.step(symbol, e.symbols[symbol], at, steps)
+	}
+	return true
+}
+
+// Snapshot returns the given symbols, and what they are priced off, at the
+// engine's clock along with the events recorded after the given sequence
+func (e *PriceEngine) Snapshot(symbols []string, after uint64) MarketSnapshot {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	market := MarketSnapshot{
+		At:       e.clock(),
+		Ticks:    make(map[string]MarketTick, len(symbols)),
+		Sequence: e.sequence,
+		Curve:    e.curveFactors(),
+	}
+	for _, symbol := range symbols {
+		e.collect(symbol, market.Ticks)
+	}
+	for _, event := range e.events {
+		if event.Sequence > after {
+			market.Events = append(market.Events, event)
+		}
+	}
+	return market
+}
+
+// Price returns a symbol's current price
+func (e *PriceEngine) Price(symbol string) float64 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	ticks := make(map[string]MarketTick)
+	e.collect(symbol, ticks)
+	return ticks[symbol].Price
+}
+
+// Sequence returns the sequence of the latest event, from which a new
+// stream starts following events
+func (e *PriceEngine) Sequence() uint64 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.sequence
+}
+
+// Now is the engine's clock, or the wall clock before the first advance
+func (e *PriceEngine) Now() time.Time {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.clock()
+}
+
+// clock is the engine's time, or the wall clock before the first advance
+func (e *PriceEngine) clock() time.Time {
+	if e.now.IsZero() {
+		return time.Now()
+	}
+	return e.now
+}
+
+// track starts simulating a symbol, after what it is priced off
+func (e *PriceEngine) track(symbol string) *symbolState {
+	if state, exists := e.symbols[symbol]; exists {
+		return state
+	}
+
+	service, cfg := e.service, e.service.config
+	state := &symbolState{symbol: symbol}
+	e.symbols[symbol] = state
+
+	if composite, exists := service.Composite(symbol); exists {
+		state.composite = &composite
+		for _, leg := range composite.Legs {
+			state.inputs = append(state.inputs, leg.Symbol)
+		}
+	} else if perpetual, exists := service.Perpetual(symbol); exists {
+		state.perpetual = &perpetual
+		state.funding = &perpetualState{basisBps: rand.NormFloat64() * cfg.PerpetualBasisBps}
+		state.inputs = []string{perpetual.Index}
+	} else if contract, exists := service.Future(symbol); exists {
+		state.contract = &contract
+		state.future = &futureState{noiseBps: rand.NormFloat64() * cfg.FutureBasisNoiseBps}
+		state.inputs = []string{contract.Series.Underlying}
+	} else if cross, exists := service.Cross(symbol); exists {
+		state.cross = &cross
+		state.dislocation = &crossState{}
+		if cfg.FXDislocationBps > 0 {
+			state.dislocation.dislocationBps = rand.NormFloat64() * cfg.FXDislocationBps
+		}
+		state.inputs = cross.Factors()
+	} else if bond, exists := service.Bond(symbol); exists {
+		state.bond = &bond
+	} else if option, exists := service.Option(symbol); exists {
+		state.option = &option
+		state.inputs = []string{option.Series.Underlying}
+	} else {
+		state.price = seedPrice(symbol)
+		if rule, exists := bandRule(cfg.PriceBands, symbol); exists {
+			state.band = &bandState{rule: rule}
+		}
+		if state.schedule = tradingSchedule(cfg.TradingSchedules, symbol); state.schedule != nil {
+			state.phase, state.phaseEnd = PhaseAt(state.schedule, e.clock())
+		}
+	}
+
+	for _, input := range state.inputs {
+		e.track(input)
+	}
+	e.order = append(e.order, symbol)
+	if !state.regular() {
+		state.price = e.derivedPrice(state)
+	}
+	return state
+}
+
+// Untrack stops simulating symbols, keeping any another tracked symbol is
+// still priced off. Expired contracts are untracked so the cost of an
+// advance does not grow with every contract ever listed.
+func (e *PriceEngine) Untrack(symbols []string) {
+	if len(symbols) == 0 {
+		return
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	untracked := make(map[string]bool, len(symbols))
+	for _, symbol := range symbols {
+		if _, exists := e.symbols[symbol]; exists {
+			untracked[symbol] = true
+		}
+	}
+	for symbol, state := range e.symbols {
+		if untracked[symbol] {
+			continue
+		}
+		for _, input := range state.inputs {
+			delete(untracked, input)
+		}
+	}
+	if len(untracked) == 0 {
+		return
+	}
+
+	order := e.order[:0]
+	for _, symbol := range e.order {
+		if !untracked[symbol] {
+			order = append(order, symbol)
+		}
+	}
+	e.order = order
+	for symbol := range untracked {
+		delete(e.symbols, symbol)
+		delete(e.watchers, symbol)
+	}
+}
+
+// WatchVenues starts simulating the venues quoting each symbol, or keeps them
+// going for one more watcher. Venues cost every advance, so only watched
+// symbols have them; derived symbols are not quoted per venue.
+func (e *PriceEngine) WatchVenues(symbols []string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for _, symbol := range symbols {
+		e.watchers[symbol]++
+		state := e.track(symbol)
+		if state.venues == nil && state.regular() {
+			state.venues = newVenueState(e.service.config)
+			state.venues.step(state.price, e.clock(), 0)
+		}
+	}
+}
+
+// UnwatchVenues stops simulating the venues of symbols no one else watches
+func (e *PriceEngine) UnwatchVenues(symbols []string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for _, symbol := range symbols {
+		if e.watchers[symbol]--; e.watchers[symbol] > 0 {
+			continue
+		}
+		delete(e.watchers, symbol)
+		if state, exists := e.symbols[symbol]; exists {
+			state.venues = nil
+		}
+	}
+}
+
+// collect adds a symbol's tick, and those of what it is priced off, to ticks
+func (e *PriceEngine) collect(symbol string, ticks map[string]MarketTick) {
+	if _, collected := ticks[symbol]; collected {
+		return
+	}
+	state := e.track(symbol)
+	for _, input := range state.inputs {
+		e.collect(input, ticks)
+	}
+	ticks[symbol] = e.tick(state)
+}
+
+// tick reports a symbol's state, first carrying over market impact from
+// trades reported since the last step
+func (e *PriceEngine) tick(state *symbolState) MarketTick {
+	if state.regular() && state.status != StatusHalted {
+		state.price *= e.pendingImpact(state.symbol, state)
+	}
+
+	tick := MarketTick{
+		Price:     state.price,
+		Status:    state.status,
+		Scheduled: state.schedule != nil,
+		Phase:     state.phase,
+		PhaseEnd:  state.phaseEnd,
+		Uncrossed: state.uncross,
+		VenueBps:  state.venues.snapshot(),
+	}
+	if state.auction != nil {
+		auction := *state.auction
+		tick.Auction = &auction
+	}
+	switch {
+	case state.perpetual != nil:
+		tick.Perpetual = e.perpetualTick(*state.perpetual, state.funding)
+	case state.contract != nil:
+		tick.Future = e.futureTick(*state.contract, state.future, state.price)
+	case state.cross != nil:
+		tick.Cross = &CrossTick{
+			TriangulatedPrice: CrossRate(*state.cross, e.priceOf),
+			DislocationBps:    state.dislocation.dislocationBps,
+		}
+	case state.bond != nil:
+		factors := e.curveFactors()
+		tick.Bond = &BondTick{Valuation: ValueBond(*state.bond, factors, e.service.config.YieldCurve.Lambda), Factors: factors}
+	case state.option != nil:
+		tick.Option = e.optionTick(*state.option)
+	}
+	return tick
+}
+
+// step moves one symbol to the given time
+func (e *PriceEngine) step(symbol string, state *symbolState, at time.Time, steps float64) {
+	switch {
+	case state.regular():
+		e.stepRegular(symbol, state, at, steps)
+	case state.perpetual != nil:
+		e.stepPerpetual(symbol, *state.perpetual, state.funding, at)
+	case state.contract != nil:
+		e.stepFuture(symbol, *state.contract, state.future, at)
+	case state.cross != nil:
+		e.stepCross(state.dislocation, at)
+	}
+	if !state.regular() {
+		state.price = e.derivedPrice(state)
+	}
+	state.venues.step(state.price, at, steps)
+}
+
+// stepRegular walks a regular symbol through its trading phases, price band
+// and any market impact reported since the last step
+func (e *PriceEngine) stepRegular(symbol string, state *symbolState, at time.Time, steps float64) {
+	if state.schedule != nil {
+		phase, phaseEnd := PhaseAt(state.schedule, at)
+		if phase != state.phase && state.auction != nil {
+			state.uncross = state.auction.Matched
+			state.auction = nil
+		}
+		state.phase, state.phaseEnd = phase, phaseEnd
+
+		switch {
+		case phase == PhaseClosed || phase == PhasePreOpen:
+			return
+		case phase.IsAuction():
+			if state.auction == nil {
+				state.auction = &AuctionState{}
+			}
+			state.price = state.auction.advance(state.price, steps)
+			return
+		}
+	}
+
+	halted, resume := state.band.halted(state.price, at)
+	if resume != nil {
+		state.status = StatusTrading
+		e.record(symbol, *resume)
+	}
+	if halted {
+		return
+	}
+
+	previous := state.price
+	price := previous * (1 + (rand.Float64()-0.5)*0.01*stepScale(steps))
+	price *= e.pendingImpact(symbol, state)
+
+	price, status, event := state.band.enforce(previous, price, at)
+	state.price, state.status = price, status
+	if event != nil {
+		e.record(symbol, *event)
+	}
+}
+
+// derivedPrice prices a derived symbol off its inputs' current prices
+func (e *PriceEngine) derivedPrice(state *symbolState) float64 {
+	switch {
+	case state.composite != nil:
+		return CompositePrice(*state.composite, e.priceOf)
+	case state.perpetual != nil:
+		return e.priceOf(state.perpetual.Index) * (1 + state.funding.basisBps/10000)
+	case state.contract != nil:
+		return e.futurePrice(*state.contract, state.future)
+	case state.cross != nil:
+		return CrossRate(*state.cross, e.priceOf) * (1 + state.dislocation.dislocationBps/10000)
+	case state.bond != nil:
+		return ValueBond(*state.bond, e.curveFactors(), e.service.config.YieldCurve.Lambda).Price
+	case state.option != nil:
+		return e.optionTick(*state.option).Mark
+	}
+	return state.price
+}
+
+func (e *PriceEngine) priceOf(symbol string) float64 {
+	if state, exists := e.symbols[symbol]; exists {
+		return state.price
+	}
+	return 0
+}
+
+// pendingImpact returns the price multiple of market impact accrued since
+// the symbol last took it on, marking it taken
+func (e *PriceEngine) pendingImpact(symbol string, state *symbolState) float64 {
+	impact := e.service.ImpactLevel(symbol)
+	if impact == state.impactSeen {
+		return 1
+	}
+	pending := math.Exp(impact - state.impactSeen)
+	state.impactSeen = impact
+	return pending
+}
+
+// record appends an event to the log, dropping the oldest once it holds
+// twice the log size
+func (e *PriceEngine) record(symbol string, event MarketEvent) {
+	e.sequence++
+	event.Sequence = e.sequence
+	event.Symbol = symbol
+	event.At = e.clock()
+	e.events = append(e.events, event)
+	if len(e.events) > 2*eventLogSize {
+		e.events = append([]MarketEvent(nil), e.events[eventLogSize:]...)
+	}
+}
+
+// stepScale scales a regular step's move to the number of steps elapsed
+func stepScale(steps float64) float64 {
+	return math.Sqrt(steps)
+}
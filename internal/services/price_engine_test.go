@@ -0,0 +1,63 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+)
+
+func TestPriceEngine_SharedPath(t *testing.T) {
+	cfg := &config.Config{
+		Symbols: []string{"BTC-USD", "ETH-USD"},
+		CompositeSymbols: []config.CompositeSymbol{
+			{Symbol: "IDX", Kind: config.CompositeBasket, Legs: []config.CompositeLeg{
+				{Symbol: "BTC-USD", Weight: 1}, {Symbol: "ETH-USD", Weight: 1},
+			}},
+		},
+	}
+	service := NewMarketDataService(cfg, logrus.New())
+
+	start := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	service.Advance(start)
+	for i := 1; i <= 20; i++ {
+		service.Advance(start.Add(time.Duration(i) * engineStep))
+	}
+
+	// Snapshots and unary reads see the same prices
+	market := service.Snapshot([]string{"IDX"}, 0)
+	assert.Equal(t, start.Add(20*engineStep), market.At)
+	for _, symbol := range []string{"BTC-USD", "ETH-USD", "IDX"} {
+		price, err := service.GetPrice(symbol)
+		require.NoError(t, err)
+		assert.Equal(t, market.Price(symbol), price, symbol)
+	}
+	assert.InDelta(t, market.Price("BTC-USD")+market.Price("ETH-USD"), market.Price("IDX"), 1e-9)
+
+	// Times already passed leave the market where it is
+	service.Advance(start.Add(5 * engineStep))
+	again := service.Snapshot([]string{"IDX"}, 0)
+	assert.Equal(t, market.At, again.At)
+	assert.Equal(t, market.Price("IDX"), again.Price("IDX"))
+}
+
+func TestPriceEngine_EventsAfterSequence(t *testing.T) {
+	service := NewMarketDataService(&config.Config{Symbols: []string{"BTC-USD"}}, logrus.New())
+	engine := service.engine
+
+	engine.record("BTC-USD", MarketEvent{Type: EventHalt})
+	after := engine.Sequence()
+	engine.record("BTC-USD", MarketEvent{Type: EventResume})
+	engine.record("", MarketEvent{Type: EventRateShock})
+
+	market := service.Snapshot([]string{"BTC-USD"}, after)
+	require.Len(t, market.Events, 2)
+	assert.Equal(t, after+2, market.Sequence)
+	assert.Len(t, market.EventsOf("BTC-USD", EventResume), 1)
+	assert.Empty(t, market.EventsOf("BTC-USD", EventHalt))
+	assert.Len(t, market.EventsOf("", EventRateShock), 1)
+}
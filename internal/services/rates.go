@@ -0,0 +1,155 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+)
+
+// couponsPerYear is the coupon frequency of simulated bonds
+const couponsPerYear = 2
+
+// CurveFactors are the level, slope and curvature of a Nelson-Siegel curve,
+// as fractions
+type CurveFactors struct {
+	Level     float64
+	Slope     float64
+	Curvature float64
+}
+
+// FactorsOf returns the factors a curve is configured with
+func FactorsOf(curve config.YieldCurve) CurveFactors {
+	return CurveFactors{Level: curve.Level, Slope: curve.Slope, Curvature: curve.Curvature}
+}
+
+// ZeroRate is the continuously compounded zero rate at a maturity in years;
+// at zero it is the short rate. Lambda must be positive.
+func (f CurveFactors) ZeroRate(maturity, lambda float64) float64 {
+	if maturity <= 0 {
+		return f.Level + f.Slope
+	}
+	x := maturity / lambda
+	loading := (1 - math.Exp(-x)) / x
+	return f.Level + f.Slope*loading + f.Curvature*(loading-math.Exp(-x))
+}
+
+// BondValuation is a bond's price per 100 face value off a curve, with its
+// yield to maturity and modified duration under continuous compounding
+type BondValuation struct {
+	Price    float64
+	Yield    float64
+	Duration float64
+}
+
+// ValueBond discounts a bond's coupons and principal along the curve
+func ValueBond(bond config.Bond, factors CurveFactors, lambda float64) BondValuation {
+	flows := bondCashFlows(bond)
+	if len(flows) == 0 {
+		return BondValuation{}
+	}
+	var price float64
+	for _, flow := range flows {
+		price += flow.amount * math.Exp(-factors.ZeroRate(flow.time, lambda)*flow.time)
+	}
+
+	// Newton's method from the zero rate at maturity, which is already close
+	yield := factors.ZeroRate(bond.MaturityYears, lambda)
+	var duration float64
+	for i := 0; i < 20; i++ {
+		var value, slope float64
+		for _, flow := range flows {
+			discounted := flow.amount * math.Exp(-yield*flow.time)
+			value += discounted
+			slope -= flow.time * discounted
+		}
+		duration = -slope / value
+		step := (value - price) / slope
+		yield -= step
+		if math.Abs(step) < 1e-12 {
+			break
+		}
+	}
+	return BondValuation{Price: price, Yield: yield, Duration: duration}
+}
+
+type cashFlow struct {
+	time   float64 // Years
+	amount float64
+}
+
+// bondCashFlows lists coupons back from maturity, so a bond whose maturity
+// is not a whole number of coupon periods has a short first period
+func bondCashFlows(bond config.Bond) []cashFlow {
+	coupon := bond.CouponPercent / couponsPerYear
+	var flows []cashFlow
+	for t := bond.MaturityYears; t > 1e-9; t -= 1.0 / couponsPerYear {
+		flows = append(flows, cashFlow{time: t, amount: coupon})
+	}
+	if len(flows) > 0 {
+		flows[0].amount += 100
+	}
+	return flows
+}
+
+// curveState is the yield curve's deviation from its configured factors
+type curveState struct {
+	deviation CurveFactors
+	updatedAt time.Time // Zero until the engine first steps the curve
+}
+
+// BondTick is a bond's valuation at a tick, with the curve it was priced off
+type BondTick struct {
+	Valuation BondValuation
+	Factors   CurveFactors
+}
+
+func newCurveState(curve config.YieldCurve) *curveState {
+	noise := func() float64 { return rand.NormFloat64() * curve.VolBps / 10000 }
+	return &curveState{deviation: CurveFactors{Level: noise(), Slope: noise(), Curvature: noise()}}
+}
+
+// stepCurve moves the curve. Each factor reverts to its configured value;
+// the noise keeps its spread at VolBps whatever the step. Parallel shocks
+// are recorded as curve-wide events.
+func (e *PriceEngine) stepCurve(at time.Time) {
+	curve, state := e.service.config.YieldCurve, e.curve
+	if state.updatedAt.IsZero() {
+		state.updatedAt = at
+	}
+	elapsed := at.Sub(state.updatedAt)
+	if elapsed <= 0 {
+		return
+	}
+
+	noise := func() float64 { return rand.NormFloat64() * curve.VolBps / 10000 }
+	decay := math.Exp(-math.Ln2 * elapsed.Seconds() / curve.HalfLife.Seconds())
+	spread := math.Sqrt(1 - decay*decay)
+	state.deviation.Level = state.deviation.Level*decay + noise()*spread
+	state.deviation.Slope = state.deviation.Slope*decay + noise()*spread
+	state.deviation.Curvature = state.deviation.Curvature*decay + noise()*spread
+	state.updatedAt = at
+
+	if curve.ShocksPerDay > 0 && rand.Float64() < curve.ShocksPerDay*elapsed.Hours()/24 {
+		shockBps := curve.ShockBps
+		if rand.Intn(2) == 0 {
+			shockBps = -shockBps
+		}
+		state.deviation.Level += shockBps / 10000
+		e.record("", MarketEvent{
+			Type:   EventRateShock,
+			Reason: fmt.Sprintf("%s rates shocked %+.0fbp across the curve", curve.Currency, shockBps),
+		})
+	}
+}
+
+// curveFactors are the curve's current factors
+func (e *PriceEngine) curveFactors() CurveFactors {
+	factors := FactorsOf(e.service.config.YieldCurve)
+	factors.Level += e.curve.deviation.Level
+	factors.Slope += e.curve.deviation.Slope
+	factors.Curvature += e.curve.deviation.Curvature
+	return factors
+}
@@ -0,0 +1,50 @@
+package services
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+)
+
+func TestCurveFactors_ZeroRate(t *testing.T) {
+	factors := CurveFactors{Level: 0.045, Slope: -0.01, Curvature: 0.01}
+
+	// The short end is level plus slope, the long end tends to the level
+	assert.InDelta(t, 0.035, factors.ZeroRate(0, 2), 1e-12)
+	assert.InDelta(t, 0.035, factors.ZeroRate(1e-9, 2), 1e-9)
+	assert.InDelta(t, 0.045, factors.ZeroRate(1000, 2), 0.0002)
+	assert.Less(t, factors.ZeroRate(1, 2), factors.ZeroRate(10, 2), "upward sloping")
+
+	// Curvature humps the middle of the curve without moving the ends
+	humped := factors
+	humped.Curvature = 0.03
+	assert.Greater(t, humped.ZeroRate(2, 2)-factors.ZeroRate(2, 2), humped.ZeroRate(30, 2)-factors.ZeroRate(30, 2))
+	assert.InDelta(t, factors.ZeroRate(0, 2), humped.ZeroRate(0, 2), 1e-12)
+}
+
+func TestValueBond(t *testing.T) {
+	flat := CurveFactors{Level: 0.04}
+	bond := config.Bond{Symbol: "UST-10Y", MaturityYears: 10, CouponPercent: 4}
+
+	valuation := ValueBond(bond, flat, 2)
+	assert.InDelta(t, 0.04, valuation.Yield, 1e-10, "a flat curve yields its level")
+	assert.InDelta(t, 99.6, valuation.Price, 0.5, "near par when the coupon matches the rate")
+	assert.Greater(t, valuation.Duration, 7.0)
+	assert.Less(t, valuation.Duration, 10.0)
+
+	// Duration predicts the price change of a small parallel shift
+	shifted := ValueBond(bond, CurveFactors{Level: 0.0401}, 2)
+	assert.InDelta(t, -valuation.Duration*0.0001, shifted.Price/valuation.Price-1, 1e-6)
+
+	// A zero coupon bond is the discount factor
+	zero := ValueBond(config.Bond{MaturityYears: 5}, flat, 2)
+	assert.InDelta(t, 100*math.Exp(-0.2), zero.Price, 1e-9)
+	assert.InDelta(t, 5, zero.Duration, 1e-9)
+
+	// Odd maturities have a short first coupon period
+	assert.Len(t, bondCashFlows(config.Bond{MaturityYears: 1.75, CouponPercent: 4}), 4)
+	assert.Equal(t, BondValuation{}, ValueBond(config.Bond{}, flat, 2))
+}
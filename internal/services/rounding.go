@@ -0,0 +1,69 @@
+package services
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+)
+
+// stepTolerance absorbs float error in value/increment, so a value already
+// on a tick is not pushed to the neighbouring one by floor or ceil
+const stepTolerance = 1e-9
+
+// RoundPrice rounds a price to the instrument's nearest tick
+func RoundPrice(instrument config.Instrument, price float64) float64 {
+	return roundTo(price, instrument.TickSize, PriceScale(instrument), math.Round)
+}
+
+// RoundBid rounds a bid down to a tick, so rounding never improves it
+func RoundBid(instrument config.Instrument, price float64) float64 {
+	return roundTo(price, instrument.TickSize, PriceScale(instrument), math.Floor)
+}
+
+// RoundAsk rounds an ask up to a tick, so rounding never improves it
+func RoundAsk(instrument config.Instrument, price float64) float64 {
+	return roundTo(price, instrument.TickSize, PriceScale(instrument), math.Ceil)
+}
+
+// RoundSize rounds a quantity to the instrument's nearest lot
+func RoundSize(instrument config.Instrument, size float64) float64 {
+	return roundTo(size, instrument.LotSize, SizeScale(instrument), math.Round)
+}
+
+// roundTo rounds value to a multiple of increment using mode, then trims the
+// float noise the multiplication leaves behind to the given decimal places.
+// A non-positive increment leaves the value untouched.
+func roundTo(value, increment float64, decimals int, mode func(float64) float64) float64 {
+	if increment <= 0 || math.IsNaN(value) || math.IsInf(value, 0) {
+		return value
+	}
+
+	steps := value / increment
+	if nearest := math.Round(steps); math.Abs(steps-nearest) < stepTolerance {
+		steps = nearest
+	}
+	scale := math.Pow10(decimals)
+	return math.Round(mode(steps)*increment*scale) / scale
+}
+
+// PriceScale is the number of decimals rounded prices carry: the configured
+// precision, widened if the tick is finer
+func PriceScale(instrument config.Instrument) int {
+	return max(instrument.PricePrecision, decimalPlaces(instrument.TickSize))
+}
+
+// SizeScale is the number of decimals rounded sizes carry
+func SizeScale(instrument config.Instrument) int {
+	return decimalPlaces(instrument.LotSize)
+}
+
+// decimalPlaces counts the decimals in the shortest representation of x
+func decimalPlaces(x float64) int {
+	s := strconv.FormatFloat(x, 'f', -1, 64)
+	if dot := strings.IndexByte(s, '.'); dot >= 0 {
+		return len(s) - dot - 1
+	}
+	return 0
+}
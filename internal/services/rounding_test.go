@@ -0,0 +1,48 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+)
+
+func TestRoundPrice(t *testing.T) {
+	btc := config.Instrument{TickSize: 0.5, LotSize: 0.001, PricePrecision: 1}
+	assert.Equal(t, 50000.5, RoundPrice(btc, 50000.62))
+	assert.Equal(t, 50000.0, RoundBid(btc, 50000.49))
+	assert.Equal(t, 50000.5, RoundAsk(btc, 50000.01))
+
+	// Values already on a tick stay there despite float error in value/tick
+	fx := config.Instrument{TickSize: 0.00001, LotSize: 1000, PricePrecision: 5}
+	assert.Equal(t, 1.08543, RoundBid(fx, 1.08543))
+	assert.Equal(t, 1.08543, RoundAsk(fx, 1.08543))
+	assert.Equal(t, 1.08543, RoundPrice(fx, 1.085434))
+
+	// Results carry no binary noise beyond the tick's decimals
+	eth := config.Instrument{TickSize: 0.01, LotSize: 0.0001, PricePrecision: 2}
+	assert.Equal(t, 0.3, RoundPrice(eth, 0.1+0.2))
+
+	// A precision finer than the tick does not move prices off the tick
+	quarter := config.Instrument{TickSize: 0.25, PricePrecision: 1}
+	assert.Equal(t, 100.25, RoundPrice(quarter, 100.3))
+
+	// Missing reference data leaves prices untouched
+	assert.Equal(t, 100.123456, RoundPrice(config.Instrument{}, 100.123456))
+}
+
+func TestRoundSize(t *testing.T) {
+	assert.Equal(t, 1.235, RoundSize(config.Instrument{LotSize: 0.001}, 1.23456))
+	assert.Equal(t, 5000.0, RoundSize(config.Instrument{LotSize: 1000}, 4700))
+	assert.Equal(t, 0.0, RoundSize(config.Instrument{LotSize: 1000}, 400))
+	assert.Equal(t, -2.0, RoundSize(config.Instrument{LotSize: 1}, -2.4))
+}
+
+func TestScales(t *testing.T) {
+	assert.Equal(t, 2, PriceScale(config.Instrument{TickSize: 0.01, PricePrecision: 2}))
+	assert.Equal(t, 2, PriceScale(config.Instrument{TickSize: 0.25, PricePrecision: 1}), "the tick's decimals win over a coarser precision")
+	assert.Equal(t, 4, PriceScale(config.Instrument{TickSize: 1, PricePrecision: 4}))
+	assert.Equal(t, 5, SizeScale(config.Instrument{LotSize: 0.00001}))
+	assert.Equal(t, 0, SizeScale(config.Instrument{LotSize: 1000}))
+}
@@ -0,0 +1,185 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// SymbolGroupPrefix marks a subscription entry as a named group, e.g. "@majors"
+const SymbolGroupPrefix = "@"
+
+// SymbolUniverse is the set of symbols the simulator knows about, plus named
+// groups of symbols and patterns. Contracts join as they list and leave once
+// expired; every change bumps Version so pattern subscribers know to
+// re-resolve.
+type SymbolUniverse struct {
+	mu      sync.RWMutex
+	symbols map[string]struct{}
+	groups  map[string][]string
+	version uint64
+}
+
+func NewSymbolUniverse(symbols []string, groups map[string][]string) *SymbolUniverse {
+	u := &SymbolUniverse{
+		symbols: make(map[string]struct{}),
+		groups:  make(map[string][]string),
+	}
+	for _, symbol := range symbols {
+		u.symbols[symbol] = struct{}{}
+	}
+	for name, members := range groups {
+		u.groups[name] = members
+	}
+	return u
+}
+
+// Add registers a symbol and reports whether it was new
+func (u *SymbolUniverse) Add(symbol string) bool {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if _, exists := u.symbols[symbol]; exists {
+		return false
+	}
+	u.symbols[symbol] = struct{}{}
+	u.version++
+	return true
+}
+
+// Remove drops a symbol and reports whether it was known
+func (u *SymbolUniverse) Remove(symbol string) bool {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if _, exists := u.symbols[symbol]; !exists {
+		return false
+	}
+	delete(u.symbols, symbol)
+	u.version++
+	return true
+}
+
+func (u *SymbolUniverse) Contains(symbol string) bool {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+	_, exists := u.symbols[symbol]
+	return exists
+}
+
+// Symbols returns all known symbols in sorted order
+func (u *SymbolUniverse) Symbols() []string {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+	return u.sortedSymbolsLocked()
+}
+
+func (u *SymbolUniverse) Version() uint64 {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+	return u.version
+}
+
+// Resolve expands subscription entries into concrete symbols. Entries may be
+// explicit symbols (kept even if not yet in the universe), wildcard patterns
+// such as "BTC-*" or "*-USD", or "@group" references. Order of first
+// appearance is kept and duplicates are dropped.
+func (u *SymbolUniverse) Resolve(entries []string) ([]string, error) {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+
+	var resolved []string
+	seen := make(map[string]bool)
+	add := func(symbol string) {
+		if !seen[symbol] {
+			seen[symbol] = true
+			resolved = append(resolved, symbol)
+		}
+	}
+
+	var expand func(entries []string, visiting map[string]bool) error
+	expand = func(entries []string, visiting map[string]bool) error {
+		for _, entry := range entries {
+			switch {
+			case strings.HasPrefix(entry, SymbolGroupPrefix):
+				name := strings.TrimPrefix(entry, SymbolGroupPrefix)
+				members, exists := u.groups[name]
+				if !exists {
+					return fmt.Errorf("unknown symbol group %q", name)
+				}
+				if visiting[name] {
+					return fmt.Errorf("symbol group %q references itself", name)
+				}
+				visiting[name] = true
+				if err := expand(members, visiting); err != nil {
+					return err
+				}
+				delete(visiting, name)
+			case IsSymbolPattern(entry):
+				for _, symbol := range u.sortedSymbolsLocked() {
+					if MatchSymbol(entry, symbol) {
+						add(symbol)
+					}
+				}
+			default:
+				add(entry)
+			}
+		}
+		return nil
+	}
+
+	if err := expand(entries, make(map[string]bool)); err != nil {
+		return nil, err
+	}
+	return resolved, nil
+}
+
+func (u *SymbolUniverse) sortedSymbolsLocked() []string {
+	symbols := make([]string, 0, len(u.symbols))
+	for symbol := range u.symbols {
+		symbols = append(symbols, symbol)
+	}
+	sort.Strings(symbols)
+	return symbols
+}
+
+// IsDynamicSubscription reports whether the entries can pick up symbols added
+// to the universe later, i.e. contain a pattern or a group
+func IsDynamicSubscription(entries []string) bool {
+	for _, entry := range entries {
+		if IsSymbolPattern(entry) || strings.HasPrefix(entry, SymbolGroupPrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func IsSymbolPattern(entry string) bool {
+	return strings.Contains(entry, "*")
+}
+
+// MatchSymbol matches a symbol against a pattern where '*' stands for any
+// run of characters (including none). Matching is case-sensitive.
+func MatchSymbol(pattern, symbol string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == symbol
+	}
+
+	if !strings.HasPrefix(symbol, parts[0]) {
+		return false
+	}
+	symbol = symbol[len(parts[0]):]
+
+	last := parts[len(parts)-1]
+	for _, part := range parts[1 : len(parts)-1] {
+		idx := strings.Index(symbol, part)
+		if idx < 0 {
+			return false
+		}
+		symbol = symbol[idx+len(part):]
+	}
+
+	return strings.HasSuffix(symbol, last)
+}
@@ -0,0 +1,83 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchSymbol(t *testing.T) {
+	testCases := []struct {
+		pattern string
+		symbol  string
+		matches bool
+	}{
+		{"BTC-*", "BTC-USD", true},
+		{"BTC-*", "ETH-BTC", false},
+		{"*-USD", "ETH-USD", true},
+		{"*-USD", "ETH-USDT", false},
+		{"*", "ANY", true},
+		{"*-*", "BTC-EUR", true},
+		{"*-*", "BTCEUR", false},
+		{"B*C-U*D", "BTC-USD", true},
+		{"A*A", "A", false},
+		{"BTC-USD", "BTC-USD", true},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.matches, MatchSymbol(tc.pattern, tc.symbol), "pattern %q symbol %q", tc.pattern, tc.symbol)
+	}
+}
+
+func TestSymbolUniverse_Resolve(t *testing.T) {
+	universe := NewSymbolUniverse(
+		[]string{"BTC-USD", "ETH-USD", "ETH-BTC", "BTC-EUR"},
+		map[string][]string{
+			"majors": {"BTC-USD", "ETH-USD"},
+			"usd":    {"*-USD"},
+			"nested": {"@majors", "BTC-EUR"},
+			"loop":   {"@loop"},
+		},
+	)
+
+	symbols, err := universe.Resolve([]string{"BTC-*"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"BTC-EUR", "BTC-USD"}, symbols)
+
+	symbols, err = universe.Resolve([]string{"@usd", "BTC-USD", "SOL-USD"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"BTC-USD", "ETH-USD", "SOL-USD"}, symbols, "duplicates dropped, explicit symbols kept")
+
+	symbols, err = universe.Resolve([]string{"@nested"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"BTC-USD", "ETH-USD", "BTC-EUR"}, symbols)
+
+	_, err = universe.Resolve([]string{"@unknown"})
+	assert.Error(t, err)
+
+	_, err = universe.Resolve([]string{"@loop"})
+	assert.Error(t, err)
+}
+
+func TestSymbolUniverse_Add(t *testing.T) {
+	universe := NewSymbolUniverse([]string{"BTC-USD"}, nil)
+	version := universe.Version()
+
+	assert.False(t, universe.Add("BTC-USD"))
+	assert.Equal(t, version, universe.Version())
+
+	assert.True(t, universe.Add("SOL-USD"))
+	assert.Greater(t, universe.Version(), version)
+	assert.True(t, universe.Contains("SOL-USD"))
+
+	symbols, err := universe.Resolve([]string{"*-USD"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"BTC-USD", "SOL-USD"}, symbols)
+}
+
+func TestIsDynamicSubscription(t *testing.T) {
+	assert.False(t, IsDynamicSubscription([]string{"BTC-USD", "ETH-USD"}))
+	assert.True(t, IsDynamicSubscription([]string{"BTC-USD", "*-USD"}))
+	assert.True(t, IsDynamicSubscription([]string{"@majors"}))
+}
@@ -0,0 +1,80 @@
+package services
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+)
+
+// TradingPhase is a scheduled symbol's session phase; values match the API's
+type TradingPhase int32
+
+const (
+	PhaseContinuous     TradingPhase = 0
+	PhasePreOpen        TradingPhase = 1
+	PhaseOpeningAuction TradingPhase = 2
+	PhaseClosingAuction TradingPhase = 3
+	PhaseClosed         TradingPhase = 4
+)
+
+// IsAuction reports whether orders are being collected for an uncross
+func (p TradingPhase) IsAuction() bool {
+	return p == PhaseOpeningAuction || p == PhaseClosingAuction
+}
+
+// AuctionState is a running auction's simulated book
+type AuctionState struct {
+	Matched   float64
+	Imbalance float64
+}
+
+// tradingSchedule returns the symbol's schedule, or nil for 24/7 symbols
+func tradingSchedule(schedules []config.TradingSchedule, symbol string) *config.TradingSchedule {
+	for i := range schedules {
+		if MatchSymbol(schedules[i].Symbol, symbol) {
+			return &schedules[i]
+		}
+	}
+	return nil
+}
+
+// PhaseAt returns the trading phase at the given time and, except when
+// closed, the time that phase ends
+func PhaseAt(schedule *config.TradingSchedule, at time.Time) (TradingPhase, time.Time) {
+	local := at.In(schedule.Location)
+	if weekday := local.Weekday(); weekday == time.Saturday || weekday == time.Sunday {
+		return PhaseClosed, time.Time{}
+	}
+
+	// Build boundaries from wall-clock times so DST changes are respected
+	boundary := func(offset time.Duration) time.Time {
+		hour, minute := int(offset/time.Hour), int(offset%time.Hour/time.Minute)
+		return time.Date(local.Year(), local.Month(), local.Day(), hour, minute, 0, 0, schedule.Location)
+	}
+
+	switch {
+	case local.Before(boundary(schedule.PreOpen)):
+		return PhaseClosed, time.Time{}
+	case local.Before(boundary(schedule.OpeningAuction)):
+		return PhasePreOpen, boundary(schedule.OpeningAuction)
+	case local.Before(boundary(schedule.Continuous)):
+		return PhaseOpeningAuction, boundary(schedule.Continuous)
+	case local.Before(boundary(schedule.ClosingAuction)):
+		return PhaseContinuous, boundary(schedule.ClosingAuction)
+	case local.Before(boundary(schedule.Close)):
+		return PhaseClosingAuction, boundary(schedule.Close)
+	default:
+		return PhaseClosed, time.Time{}
+	}
+}
+
+// advance lets orders keep arriving over the given number of regular steps
+// while the imbalance wanders, and returns the indicative price, pulled the
+// imbalance's way
+func (a *AuctionState) advance(indicative, steps float64) float64 {
+	a.Matched += rand.Float64() * 500 * steps
+	a.Imbalance = a.Imbalance*0.9 + (rand.Float64()-0.5)*200
+	pressure := a.Imbalance / (a.Matched + 1000)
+	return indicative * (1 + (rand.Float64()-0.5)*0.002*stepScale(steps) + pressure*0.001)
+}
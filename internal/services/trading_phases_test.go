@@ -0,0 +1,64 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+)
+
+func testSchedule(location *time.Location) config.TradingSchedule {
+	return config.TradingSchedule{
+		Symbol:         "BTC-EUR",
+		Location:       location,
+		PreOpen:        7*time.Hour + 30*time.Minute,
+		OpeningAuction: 7*time.Hour + 50*time.Minute,
+		Continuous:     8 * time.Hour,
+		ClosingAuction: 16*time.Hour + 30*time.Minute,
+		Close:          16*time.Hour + 35*time.Minute,
+	}
+}
+
+func TestPhaseAt(t *testing.T) {
+	schedule := testSchedule(time.UTC)
+	monday := func(hour, minute int) time.Time {
+		return time.Date(2026, time.October, 19, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		at    time.Time
+		phase TradingPhase
+		end   time.Time
+	}{
+		{monday(6, 0), PhaseClosed, time.Time{}},
+		{monday(7, 30), PhasePreOpen, monday(7, 50)},
+		{monday(7, 55), PhaseOpeningAuction, monday(8, 0)},
+		{monday(12, 0), PhaseContinuous, monday(16, 30)},
+		{monday(16, 31), PhaseClosingAuction, monday(16, 35)},
+		{monday(16, 35), PhaseClosed, time.Time{}},
+		{monday(12, 0).AddDate(0, 0, -1), PhaseClosed, time.Time{}},
+	}
+
+	for _, tt := range tests {
+		phase, end := PhaseAt(&schedule, tt.at)
+		assert.Equal(t, tt.phase, phase, tt.at.String())
+		assert.True(t, tt.end.Equal(end), tt.at.String())
+	}
+}
+
+func TestPhaseAt_DaylightSaving(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	schedule := testSchedule(berlin)
+
+	// 08:00 in Berlin is 07:00 UTC in winter and 06:00 UTC in summer
+	phase, _ := PhaseAt(&schedule, time.Date(2026, time.March, 27, 7, 0, 0, 0, time.UTC))
+	assert.Equal(t, PhaseContinuous, phase)
+	phase, _ = PhaseAt(&schedule, time.Date(2026, time.March, 30, 5, 55, 0, 0, time.UTC))
+	assert.Equal(t, PhaseOpeningAuction, phase)
+	phase, _ = PhaseAt(&schedule, time.Date(2026, time.March, 30, 6, 0, 0, 0, time.UTC))
+	assert.Equal(t, PhaseContinuous, phase)
+}
@@ -0,0 +1,121 @@
+package services
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+)
+
+const (
+	// venueReversion is the share of a venue's deviation from fair value that
+	// survives each step; arbitrageurs close the rest
+	venueReversion = 0.9
+
+	// dislocationProbability is the per-step chance of a venue dislocating
+	dislocationProbability = 0.002
+)
+
+// venueState simulates every configured venue's mid around one symbol's fair
+// value, so all streams and unary RPCs see the same venues
+type venueState struct {
+	venues         []config.Venue
+	maxDislocation float64 // Bps between any two venue mids
+	maxLatency     time.Duration
+	fairHistory    []fairPoint        // Recent fair values, oldest first
+	deviations     map[string]float64 // Bps from the lagged fair value, per venue
+	offsets        map[string]float64 // Bps of each venue mid from the current fair value
+}
+
+type fairPoint struct {
+	at    time.Time
+	price float64
+}
+
+// newVenueState returns nil when no venues are configured. Deviations start
+// at their long-run spread, so a symbol's venues quote as if always watched.
+func newVenueState(cfg *config.Config) *venueState {
+	if len(cfg.Venues) == 0 {
+		return nil
+	}
+	state := &venueState{
+		venues:         cfg.Venues,
+		maxDislocation: cfg.VenueMaxDislocationBps,
+		deviations:     make(map[string]float64),
+		offsets:        make(map[string]float64),
+	}
+	bound := state.maxDislocation / 2
+	for _, venue := range cfg.Venues {
+		state.maxLatency = max(state.maxLatency, venue.Latency)
+		state.deviations[venue.Name] = math.Max(-bound, math.Min(bound, rand.NormFloat64()*venue.NoiseBps))
+	}
+	return state
+}
+
+// step records the fair value and moves every venue's deviation over the
+// given number of steps: mean reversion, venue noise and the occasional
+// dislocation, applied to the fair value as the venue saw it one latency
+// ago. Arbitrage keeps every mid within half the maximum dislocation of the
+// current fair value, so no two venues drift further apart than the maximum.
+func (s *venueState) step(fair float64, at time.Time, steps float64) {
+	if s == nil {
+		return
+	}
+	s.record(fair, at)
+
+	reversion := math.Pow(venueReversion, steps)
+	dislocation := 1 - math.Pow(1-dislocationProbability, steps)
+	bound := s.maxDislocation / 2
+	for _, venue := range s.venues {
+		deviation := s.deviations[venue.Name]*reversion + rand.NormFloat64()*venue.NoiseBps*math.Sqrt(1-reversion*reversion)
+		if rand.Float64() < dislocation {
+			direction := 1.0
+			if rand.Float64() < 0.5 {
+				direction = -1
+			}
+			deviation += direction * s.maxDislocation * (0.5 + rand.Float64()*0.5)
+		}
+		deviation = math.Max(-bound, math.Min(bound, deviation))
+		s.deviations[venue.Name] = deviation
+
+		mid := s.laggedFair(at.Add(-venue.Latency)) * (1 + deviation/10000)
+		s.offsets[venue.Name] = math.Max(-bound, math.Min(bound, (mid/fair-1)*10000))
+	}
+}
+
+// record adds the fair value, keeping enough history to look back the
+// slowest venue's latency
+func (s *venueState) record(fair float64, at time.Time) {
+	s.fairHistory = append(s.fairHistory, fairPoint{at: at, price: fair})
+	// Keep the latest point at or before the cutoff; it is the fair value then
+	if stale := s.seenBy(at.Add(-s.maxLatency)) - 1; stale > 0 {
+		s.fairHistory = s.fairHistory[stale:]
+	}
+}
+
+// laggedFair is the latest fair value recorded at or before the given time,
+// or the oldest recorded
+func (s *venueState) laggedFair(seen time.Time) float64 {
+	return s.fairHistory[max(s.seenBy(seen)-1, 0)].price
+}
+
+// seenBy counts the recorded fair values at or before the given time
+func (s *venueState) seenBy(seen time.Time) int {
+	return sort.Search(len(s.fairHistory), func(i int) bool {
+		return s.fairHistory[i].at.After(seen)
+	})
+}
+
+// snapshot copies the venue offsets for a tick
+func (s *venueState) snapshot() map[string]float64 {
+	if s == nil {
+		return nil
+	}
+	offsets := make(map[string]float64, len(s.offsets))
+	for venue, offset := range s.offsets {
+		offsets[venue] = offset
+	}
+	return offsets
+}
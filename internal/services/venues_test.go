@@ -0,0 +1,127 @@
+package services
+
+import (
+	"math"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quantfidential/trading-ecosystem/market-data-simulator-go/internal/config"
+)
+
+func testVenueConfig() *config.Config {
+	return &config.Config{
+		Venues: []config.Venue{
+			{Name: "fast", NoiseBps: 1, Latency: 0, SpreadBps: 2},
+			{Name: "slow", NoiseBps: 3, Latency: 100 * time.Millisecond, SpreadBps: 4},
+			{Name: "wide", NoiseBps: 2, Latency: 50 * time.Millisecond, SpreadBps: 8},
+		},
+		VenueMaxDislocationBps: 20,
+	}
+}
+
+func TestNewVenueState(t *testing.T) {
+	assert.Nil(t, newVenueState(&config.Config{}))
+
+	state := newVenueState(testVenueConfig())
+	require.NotNil(t, state)
+	assert.Equal(t, 100*time.Millisecond, state.maxLatency)
+}
+
+func TestVenueState_TiedToFairValue(t *testing.T) {
+	state := newVenueState(testVenueConfig())
+
+	start := time.Now()
+	fair := 100.0
+	for i := 0; i < 2000; i++ {
+		at := start.Add(time.Duration(i) * 10 * time.Millisecond)
+		fair *= 1 + 0.0005*math.Sin(float64(i)/50)
+		state.step(fair, at, 1)
+
+		offsets := state.snapshot()
+		require.Len(t, offsets, 3)
+		var mids []float64
+		for _, offset := range offsets {
+			assert.LessOrEqual(t, math.Abs(offset), 10.0+1e-9, "venue mids stay within half the dislocation bound")
+			mids = append(mids, fair*(1+offset/10000))
+		}
+		spread := (slices.Max(mids) - slices.Min(mids)) / fair * 10000
+		assert.LessOrEqual(t, spread, 20.0+1e-6)
+	}
+}
+
+func TestVenueState_Latency(t *testing.T) {
+	cfg := testVenueConfig()
+	for i := range cfg.Venues {
+		cfg.Venues[i].NoiseBps = 0
+	}
+	cfg.VenueMaxDislocationBps = 1000
+	state := newVenueState(cfg)
+	mid := func(venue string, fair float64) float64 {
+		return fair * (1 + state.offsets[venue]/10000)
+	}
+
+	// Zero steps leave the deviations alone, so only the latency moves the mids
+	start := time.Now()
+	state.step(100, start, 0)
+
+	// The fast venue reprices at once, the slow one still shows the old fair value
+	state.step(101, start.Add(50*time.Millisecond), 0)
+	assert.InDelta(t, 101, mid("fast", 101), 0.2)
+	assert.InDelta(t, 100, mid("slow", 101), 0.2)
+
+	state.step(101, start.Add(150*time.Millisecond), 0)
+	assert.InDelta(t, 101, mid("slow", 101), 0.2)
+}
+
+func TestVenueState_FairHistory(t *testing.T) {
+	state := newVenueState(testVenueConfig())
+
+	start := time.Now()
+	for i := 0; i <= 1000; i++ {
+		state.record(float64(i), start.Add(time.Duration(i)*100*time.Microsecond))
+	}
+
+	// History reaches back the slowest venue's latency and no further
+	assert.Len(t, state.fairHistory, 1001)
+	state.record(1001, start.Add(100100*time.Microsecond))
+	assert.Len(t, state.fairHistory, 1001)
+	assert.Equal(t, 1.0, state.fairHistory[0].price)
+
+	assert.Equal(t, 501.0, state.laggedFair(start.Add(50150*time.Microsecond)))
+	assert.Equal(t, 1.0, state.laggedFair(start), "before the history, the oldest value")
+	assert.Equal(t, 1001.0, state.laggedFair(start.Add(time.Second)))
+}
+
+func TestPriceEngine_VenueQuotes(t *testing.T) {
+	cfg := testVenueConfig()
+	cfg.Symbols = []string{"BTC-USD"}
+	service := NewMarketDataService(cfg, logrus.New())
+	service.WatchVenues([]string{"BTC-USD"})
+
+	start := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	for i := 0; i <= 20; i++ {
+		service.Advance(start.Add(time.Duration(i) * engineStep))
+	}
+
+	// Every reader sees the same venue mids
+	first := service.Snapshot([]string{"BTC-USD"}, 0).Ticks["BTC-USD"]
+	second := service.Snapshot([]string{"BTC-USD"}, 0).Ticks["BTC-USD"]
+	require.Len(t, first.VenueBps, 3)
+	assert.Equal(t, first.VenueBps, second.VenueBps)
+
+	// Venues are simulated only while watched
+	service.WatchVenues([]string{"BTC-USD"})
+	service.UnwatchVenues([]string{"BTC-USD"})
+	assert.Len(t, service.Snapshot([]string{"BTC-USD"}, 0).Ticks["BTC-USD"].VenueBps, 3, "another watcher remains")
+	service.UnwatchVenues([]string{"BTC-USD"})
+	assert.Nil(t, service.Snapshot([]string{"BTC-USD"}, 0).Ticks["BTC-USD"].VenueBps)
+
+	// Without venues nothing is simulated
+	plain := NewMarketDataService(&config.Config{Symbols: []string{"BTC-USD"}}, logrus.New())
+	assert.Nil(t, plain.Snapshot([]string{"BTC-USD"}, 0).Ticks["BTC-USD"].VenueBps)
+}
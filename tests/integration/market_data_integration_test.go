@@ -36,6 +36,7 @@ func setupIntegrationTest(t *testing.T) (*IntegrationTestSuite, func()) {
 		HTTPPort:       8080,
 		LogLevel:       "info",
 		RedisURL:       "redis://localhost:6379",
+		Symbols:        []string{"BTC/USD", "ETH/USD", "ADA/BTC", "SOL/USD", "DOT/USD"},
 	}
 
 	logger := logrus.New()